@@ -0,0 +1,201 @@
+package ast
+
+import (
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// Annotation is an `@name(arguments...)` attached to a declaration.
+type Annotation struct {
+	Name      string
+	Arguments []Literal
+
+	Span Span
+}
+
+func AnnotationFromNode(n *sitter.Node, input []byte) (Annotation, error) {
+	var a Annotation
+	var err error
+	a.Span = SpanFromNode(n)
+	a.Name, err = nameOf(n, input)
+	if err != nil {
+		return Annotation{}, err
+	}
+
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		child := n.NamedChild(i)
+		switch child.Type() {
+		case "literal":
+			lit, err := LiteralFromNode(child, input)
+			if err != nil {
+				return Annotation{}, err
+			}
+			a.Arguments = append(a.Arguments, lit)
+		default:
+			continue
+		}
+	}
+
+	return a, nil
+}
+
+// AnnotationsFromNode collects the annotations attached directly to the
+// declaration n.
+func AnnotationsFromNode(n *sitter.Node, input []byte) ([]Annotation, error) {
+	var ret []Annotation
+
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		child := n.NamedChild(i)
+		if child.Type() == "annotation" {
+			annotation, err := AnnotationFromNode(child, input)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, annotation)
+		}
+	}
+
+	return ret, nil
+}
+
+type Literal interface {
+	isLiteral()
+	GetSpan() Span
+}
+
+func LiteralFromNode(n *sitter.Node, input []byte) (Literal, error) {
+	if n.Type() == "literal" {
+		if n.NamedChildCount() == 0 {
+			return nil, SyntaxError{SpanFromNode(n), "expected a value"}
+		}
+		n = n.NamedChild(0)
+	}
+
+	switch n.Type() {
+	case "string":
+		return LiteralString{stringFromNode(n, input), SpanFromNode(n)}, nil
+	case "number":
+		return LiteralNumber{n.Content(input), SpanFromNode(n)}, nil
+	case "bool":
+		return LiteralBool{n.Content(input) == "Yes", SpanFromNode(n)}, nil
+	case "list":
+		var l LiteralList
+		l.Span = SpanFromNode(n)
+		for i := 0; i < int(n.NamedChildCount()); i++ {
+			child := n.NamedChild(i)
+			if child.Type() == "literal" {
+				lit, err := LiteralFromNode(child, input)
+				if err != nil {
+					return nil, err
+				}
+				l.Values = append(l.Values, lit)
+			}
+		}
+		return l, nil
+	case "dictionary":
+		var d LiteralDictionary
+		d.Span = SpanFromNode(n)
+		for i := 0; i < int(n.NamedChildCount()); i++ {
+			child := n.NamedChild(i)
+			switch child.Type() {
+			case "identifier":
+				d.Entries = append(d.Entries, DictionaryEntry{Key: child.Content(input)})
+			case "literal":
+				if len(d.Entries) == 0 {
+					return nil, SyntaxError{SpanFromNode(child), "expected a key before this value"}
+				}
+				lit, err := LiteralFromNode(child, input)
+				if err != nil {
+					return nil, err
+				}
+				d.Entries[len(d.Entries)-1].Value = lit
+			}
+		}
+		return d, nil
+	default:
+		return nil, unexpected(n, input)
+	}
+}
+
+// stringFromNode returns the contents of a string node, with its escape
+// sequences resolved.
+func stringFromNode(n *sitter.Node, input []byte) string {
+	var sb strings.Builder
+
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		child := n.NamedChild(i)
+		switch child.Type() {
+		case "string_fragment":
+			sb.WriteString(child.Content(input))
+		case "escape_sequence":
+			esc := child.Content(input)
+			switch esc {
+			case `\'`:
+				sb.WriteString("'")
+			case `\"`:
+				sb.WriteString(`"`)
+			default:
+				unquoted, err := strconv.Unquote(`"` + esc + `"`)
+				if err != nil {
+					unquoted = strings.TrimPrefix(esc, `\`)
+				}
+				sb.WriteString(unquoted)
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+type LiteralString struct {
+	Value string
+
+	Span Span
+}
+
+func (l LiteralString) GetSpan() Span { return l.Span }
+func (LiteralString) isLiteral()      {}
+
+type LiteralNumber struct {
+	// Value is the number as written in the source.
+	Value string
+
+	Span Span
+}
+
+func (l LiteralNumber) GetSpan() Span { return l.Span }
+func (LiteralNumber) isLiteral()      {}
+
+type LiteralBool struct {
+	Value bool
+
+	Span Span
+}
+
+func (l LiteralBool) GetSpan() Span { return l.Span }
+func (LiteralBool) isLiteral()      {}
+
+type LiteralList struct {
+	Values []Literal
+
+	Span Span
+}
+
+func (l LiteralList) GetSpan() Span { return l.Span }
+func (LiteralList) isLiteral()      {}
+
+type DictionaryEntry struct {
+	Key   string
+	Value Literal
+}
+
+type LiteralDictionary struct {
+	Entries []DictionaryEntry
+
+	Span Span
+}
+
+func (l LiteralDictionary) GetSpan() Span { return l.Span }
+func (LiteralDictionary) isLiteral()      {}
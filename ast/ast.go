@@ -21,13 +21,18 @@ func SpanFromNode(n *sitter.Node) Span {
 }
 
 type File struct {
-	Imports  []Import
-	Funcs    []Function
-	Streams  []Stream
-	Structs  []Struct
-	Enums    []Enum
-	Flagsets []Flagset
-	Span     Span
+	// Path is where the file was loaded from, if anywhere.
+	Path string
+
+	Imports     []Import
+	Funcs       []Function
+	Streams     []Stream
+	Structs     []Struct
+	Enums       []Enum
+	Flagsets    []Flagset
+	TypeAliases []TypeAlias
+	Constants   []Constant
+	Span        Span
 }
 
 var (
@@ -127,40 +132,85 @@ func CombineFiles(files ...*File) *File {
 		for _, S := range file.Streams {
 			ret.Streams = append(ret.Streams, S)
 		}
+		for _, T := range file.TypeAliases {
+			ret.TypeAliases = append(ret.TypeAliases, T)
+		}
+		for _, C := range file.Constants {
+			ret.Constants = append(ret.Constants, C)
+		}
 	}
 
 	return ret
 }
 
-func FileFromNode(n *sitter.Node, input []byte) File {
+func FileFromNode(n *sitter.Node, input []byte) (File, error) {
 	var f File
 	f.Span = SpanFromNode(n)
 	for i := 0; i < int(n.NamedChildCount()); i++ {
 		if n.NamedChild(i).Type() == "comment" {
 			continue
 		}
+		if n.NamedChild(i).Type() != "statement" || n.NamedChild(i).ChildCount() == 0 {
+			return File{}, unexpected(n.NamedChild(i), input)
+		}
 
 		child := n.NamedChild(i).Child(0)
 		switch child.Type() {
 		case "import":
-			f.Imports = append(f.Imports, ImportFromNode(child, input))
+			item, err := ImportFromNode(child, input)
+			if err != nil {
+				return File{}, err
+			}
+			f.Imports = append(f.Imports, item)
 		case "func_declaration":
-			f.Funcs = append(f.Funcs, FunctionFromNode(child, input))
+			item, err := FunctionFromNode(child, input)
+			if err != nil {
+				return File{}, err
+			}
+			f.Funcs = append(f.Funcs, item)
 		case "struct_declaration":
-			f.Structs = append(f.Structs, StructFromNode(child, input))
+			item, err := StructFromNode(child, input)
+			if err != nil {
+				return File{}, err
+			}
+			f.Structs = append(f.Structs, item)
 		case "enum_declaration":
-			f.Enums = append(f.Enums, EnumFromNode(child, input))
+			item, err := EnumFromNode(child, input)
+			if err != nil {
+				return File{}, err
+			}
+			f.Enums = append(f.Enums, item)
 		case "stream_declaration":
-			f.Streams = append(f.Streams, StreamFromNode(child, input))
+			item, err := StreamFromNode(child, input)
+			if err != nil {
+				return File{}, err
+			}
+			f.Streams = append(f.Streams, item)
 		case "flagset_declaration":
-			f.Flagsets = append(f.Flagsets, FlagsetFromNode(child, input))
+			item, err := FlagsetFromNode(child, input)
+			if err != nil {
+				return File{}, err
+			}
+			f.Flagsets = append(f.Flagsets, item)
+		case "typealias_declaration":
+			item, err := TypeAliasFromNode(child, input)
+			if err != nil {
+				return File{}, err
+			}
+			f.TypeAliases = append(f.TypeAliases, item)
+		case "const_declaration":
+			item, err := ConstantFromNode(child, input)
+			if err != nil {
+				return File{}, err
+			}
+			f.Constants = append(f.Constants, item)
 		case "comment":
 			continue
 		default:
-			panic("Unhandled " + child.Type())
+			return File{}, unexpected(child, input)
 		}
 	}
-	return f
+	return f, nil
 }
 
 type Import struct {
@@ -170,51 +220,79 @@ type Import struct {
 	Span Span
 }
 
-func ImportFromNode(n *sitter.Node, input []byte) Import {
+func ImportFromNode(n *sitter.Node, input []byte) (Import, error) {
 	var i Import
 	i.Span = SpanFromNode(n)
-	i.Path = n.ChildByFieldName("path").Content(input)
+
+	path, err := childByFieldName(n, "path")
+	if err != nil {
+		return Import{}, err
+	}
+	i.Path = path.Content(input)
 	i.Path = strings.TrimPrefix(strings.TrimSuffix(i.Path, `"`), `"`)
-	i.As = n.ChildByFieldName("alias").Content(input)
 
-	return i
+	alias, err := childByFieldName(n, "alias")
+	if err != nil {
+		return Import{}, err
+	}
+	i.As = alias.Content(input)
+
+	return i, nil
 }
 
 type Stream struct {
 	Name          string
 	Documentation *ItemDocumentation
+	Annotations   []Annotation
 
 	Events  []Event
 	Signals []Signal
 	Span    Span
 }
 
-func StreamFromNode(n *sitter.Node, input []byte) Stream {
+func StreamFromNode(n *sitter.Node, input []byte) (Stream, error) {
 	var s Stream
+	var err error
 	s.Span = SpanFromNode(n)
-	s.Name = n.ChildByFieldName("name").Content(input)
+	s.Name, err = nameOf(n, input)
+	if err != nil {
+		return Stream{}, err
+	}
 	s.Documentation = DocumentationFromNode(n, input)
+	s.Annotations, err = AnnotationsFromNode(n, input)
+	if err != nil {
+		return Stream{}, err
+	}
 
 	for i := 0; i < int(n.NamedChildCount()); i++ {
 		child := n.NamedChild(i)
 		switch child.Type() {
 		case "event_declaration":
-			s.Events = append(s.Events, EventFromNode(child, input))
+			ev, err := EventFromNode(child, input)
+			if err != nil {
+				return Stream{}, err
+			}
+			s.Events = append(s.Events, ev)
 		case "signal_declaration":
-			s.Signals = append(s.Signals, SignalFromNode(child, input))
-		case "identifier", "comment":
+			sig, err := SignalFromNode(child, input)
+			if err != nil {
+				return Stream{}, err
+			}
+			s.Signals = append(s.Signals, sig)
+		case "identifier", "comment", "annotation":
 			continue
 		default:
-			panic("Unhandled " + child.Type())
+			return Stream{}, unexpected(child, input)
 		}
 	}
 
-	return s
+	return s, nil
 }
 
 type Function struct {
 	Name          string
 	Documentation *ItemDocumentation
+	Annotations   []Annotation
 
 	Arguments []Argument
 
@@ -223,218 +301,360 @@ type Function struct {
 	Span    Span
 }
 
-func FunctionFromNode(n *sitter.Node, input []byte) Function {
+func FunctionFromNode(n *sitter.Node, input []byte) (Function, error) {
 	var f Function
+	var err error
 	f.Span = SpanFromNode(n)
 
-	f.Name = n.ChildByFieldName("name").Content(input)
+	f.Name, err = nameOf(n, input)
+	if err != nil {
+		return Function{}, err
+	}
 	f.Documentation = DocumentationFromNode(n, input)
-
-	for i := 0; i < int(n.NamedChildCount()); i++ {
-		child := n.NamedChild(i)
-		switch child.Type() {
-		case "arg":
-			f.Arguments = append(f.Arguments, ArgumentFromNode(child, input))
-		default:
-			continue
-		}
+	f.Annotations, err = AnnotationsFromNode(n, input)
+	if err != nil {
+		return Function{}, err
+	}
+	f.Arguments, err = argumentsOf(n, input)
+	if err != nil {
+		return Function{}, err
 	}
 
 	if child := n.ChildByFieldName("returns"); child != nil {
-		f.Returns = TypeFromNode(child, input)
+		f.Returns, err = TypeFromNode(child, input)
+		if err != nil {
+			return Function{}, err
+		}
 	}
 
 	if child := n.ChildByFieldName("throws"); child != nil {
-		f.Throws = TypeFromNode(child, input)
+		f.Throws, err = TypeFromNode(child, input)
+		if err != nil {
+			return Function{}, err
+		}
 	}
 
-	return f
+	return f, nil
 }
 
 type Argument struct {
-	Name string
+	Name        string
+	Annotations []Annotation
+
 	Type Type
-	Span Span
+	// Default is the value used when the argument isn't given, or nil if
+	// the argument must always be given.
+	Default Literal
+	Span    Span
 }
 
-func ArgumentFromNode(n *sitter.Node, input []byte) Argument {
+func ArgumentFromNode(n *sitter.Node, input []byte) (Argument, error) {
 	var a Argument
+	var err error
 	a.Span = SpanFromNode(n)
 
-	a.Name = n.ChildByFieldName("name").Content(input)
-	a.Type = TypeFromNode(n.ChildByFieldName("type"), input)
+	a.Name, err = nameOf(n, input)
+	if err != nil {
+		return Argument{}, err
+	}
+	a.Annotations, err = AnnotationsFromNode(n, input)
+	if err != nil {
+		return Argument{}, err
+	}
+	a.Type, err = typeOf(n, input)
+	if err != nil {
+		return Argument{}, err
+	}
+	a.Default, err = defaultOf(n, input)
+	if err != nil {
+		return Argument{}, err
+	}
 
-	return a
+	return a, nil
 }
 
-type Event struct {
-	Name          string
-	Documentation *ItemDocumentation
+// nameOf returns the content of the name of the declaration n.
+func nameOf(n *sitter.Node, input []byte) (string, error) {
+	name, err := childByFieldName(n, "name")
+	if err != nil {
+		return "", err
+	}
+	return name.Content(input), nil
+}
 
-	Arguments []Argument
-	Span      Span
+// typeOf returns the type of the field or argument n.
+func typeOf(n *sitter.Node, input []byte) (Type, error) {
+	typ, err := childByFieldName(n, "type")
+	if err != nil {
+		return nil, err
+	}
+	return TypeFromNode(typ, input)
 }
 
-func EventFromNode(n *sitter.Node, input []byte) Event {
-	var e Event
-	e.Span = SpanFromNode(n)
-	e.Documentation = DocumentationFromNode(n, input)
+// defaultOf returns the default value of the field or argument n, or nil if
+// it doesn't have one.
+func defaultOf(n *sitter.Node, input []byte) (Literal, error) {
+	value := n.ChildByFieldName("default")
+	if value == nil {
+		return nil, nil
+	}
+	return LiteralFromNode(value, input)
+}
 
-	e.Name = n.ChildByFieldName("name").Content(input)
+// argumentsOf returns the arguments of the function-like declaration n.
+func argumentsOf(n *sitter.Node, input []byte) ([]Argument, error) {
+	var ret []Argument
 
 	for i := 0; i < int(n.NamedChildCount()); i++ {
 		child := n.NamedChild(i)
 		switch child.Type() {
 		case "arg":
-			e.Arguments = append(e.Arguments, ArgumentFromNode(child, input))
+			arg, err := ArgumentFromNode(child, input)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, arg)
 		default:
 			continue
 		}
 	}
 
-	return e
+	return ret, nil
+}
+
+type Event struct {
+	Name          string
+	Documentation *ItemDocumentation
+	Annotations   []Annotation
+
+	Arguments []Argument
+	Span      Span
+}
+
+func EventFromNode(n *sitter.Node, input []byte) (Event, error) {
+	var e Event
+	var err error
+	e.Span = SpanFromNode(n)
+	e.Documentation = DocumentationFromNode(n, input)
+	e.Annotations, err = AnnotationsFromNode(n, input)
+	if err != nil {
+		return Event{}, err
+	}
+
+	e.Name, err = nameOf(n, input)
+	if err != nil {
+		return Event{}, err
+	}
+	e.Arguments, err = argumentsOf(n, input)
+	if err != nil {
+		return Event{}, err
+	}
+
+	return e, nil
 }
 
 type Signal struct {
 	Name          string
 	Documentation *ItemDocumentation
+	Annotations   []Annotation
 
 	Arguments []Argument
 	Span      Span
 }
 
-func SignalFromNode(n *sitter.Node, input []byte) Signal {
+func SignalFromNode(n *sitter.Node, input []byte) (Signal, error) {
 	var s Signal
+	var err error
 	s.Span = SpanFromNode(n)
 	s.Documentation = DocumentationFromNode(n, input)
+	s.Annotations, err = AnnotationsFromNode(n, input)
+	if err != nil {
+		return Signal{}, err
+	}
 
-	s.Name = n.ChildByFieldName("name").Content(input)
-
-	for i := 0; i < int(n.NamedChildCount()); i++ {
-		child := n.NamedChild(i)
-		switch child.Type() {
-		case "arg":
-			s.Arguments = append(s.Arguments, ArgumentFromNode(child, input))
-		default:
-			continue
-		}
+	s.Name, err = nameOf(n, input)
+	if err != nil {
+		return Signal{}, err
+	}
+	s.Arguments, err = argumentsOf(n, input)
+	if err != nil {
+		return Signal{}, err
 	}
 
-	return s
+	return s, nil
 }
 
 type Struct struct {
 	Name          string
 	Documentation *ItemDocumentation
+	Annotations   []Annotation
 
 	Fields []Field
 	Span   Span
 }
 
-func StructFromNode(n *sitter.Node, input []byte) Struct {
+func StructFromNode(n *sitter.Node, input []byte) (Struct, error) {
 	var s Struct
+	var err error
 	s.Span = SpanFromNode(n)
 	s.Documentation = DocumentationFromNode(n, input)
+	s.Annotations, err = AnnotationsFromNode(n, input)
+	if err != nil {
+		return Struct{}, err
+	}
 
-	s.Name = n.ChildByFieldName("name").Content(input)
+	s.Name, err = nameOf(n, input)
+	if err != nil {
+		return Struct{}, err
+	}
 
 	for i := 0; i < int(n.NamedChildCount()); i++ {
 		child := n.NamedChild(i)
 		switch child.Type() {
 		case "field_declaration":
-			s.Fields = append(s.Fields, FieldFromNode(child, input))
-		case "identifier", "comment":
+			field, err := FieldFromNode(child, input)
+			if err != nil {
+				return Struct{}, err
+			}
+			s.Fields = append(s.Fields, field)
+		case "identifier", "comment", "annotation":
 			continue
 		default:
-			panic("Unhandled " + child.Type())
+			return Struct{}, unexpected(child, input)
 		}
 	}
 
-	return s
+	return s, nil
 }
 
 type Field struct {
 	Name          string
 	Documentation *ItemDocumentation
+	Annotations   []Annotation
 
 	Type Type
-	Span Span
+	// Default is the value used when the field is missing, or nil if the
+	// field must always be present.
+	Default Literal
+	Span    Span
 }
 
-func FieldFromNode(n *sitter.Node, input []byte) Field {
+func FieldFromNode(n *sitter.Node, input []byte) (Field, error) {
 	var f Field
+	var err error
 	f.Span = SpanFromNode(n)
 	f.Documentation = DocumentationFromNode(n, input)
+	f.Annotations, err = AnnotationsFromNode(n, input)
+	if err != nil {
+		return Field{}, err
+	}
 
-	f.Name = n.ChildByFieldName("name").Content(input)
-	f.Type = TypeFromNode(n.ChildByFieldName("type"), input)
+	f.Name, err = nameOf(n, input)
+	if err != nil {
+		return Field{}, err
+	}
+	f.Type, err = typeOf(n, input)
+	if err != nil {
+		return Field{}, err
+	}
+	f.Default, err = defaultOf(n, input)
+	if err != nil {
+		return Field{}, err
+	}
 
-	return f
+	return f, nil
 }
 
 type Enum struct {
 	Name          string
 	Documentation *ItemDocumentation
+	Annotations   []Annotation
 
 	Cases []Case
 	Span  Span
 }
 
-func EnumFromNode(n *sitter.Node, input []byte) Enum {
+func EnumFromNode(n *sitter.Node, input []byte) (Enum, error) {
 	var e Enum
+	var err error
 	e.Span = SpanFromNode(n)
 	e.Documentation = DocumentationFromNode(n, input)
+	e.Annotations, err = AnnotationsFromNode(n, input)
+	if err != nil {
+		return Enum{}, err
+	}
 
-	e.Name = n.ChildByFieldName("name").Content(input)
+	e.Name, err = nameOf(n, input)
+	if err != nil {
+		return Enum{}, err
+	}
 
 	for i := 0; i < int(n.NamedChildCount()); i++ {
 		child := n.NamedChild(i)
 		switch child.Type() {
 		case "case_declaration":
-			e.Cases = append(e.Cases, CaseFromNode(child, input))
-		case "identifier", "comment":
+			cas, err := CaseFromNode(child, input)
+			if err != nil {
+				return Enum{}, err
+			}
+			e.Cases = append(e.Cases, cas)
+		case "identifier", "comment", "annotation":
 			continue
 		default:
-			panic("Unhandled " + child.Type())
+			return Enum{}, unexpected(child, input)
 		}
 	}
 
-	return e
+	return e, nil
 }
 
 type Case struct {
 	Name          string
 	Documentation *ItemDocumentation
+	Annotations   []Annotation
 
 	Values []Argument
 	Span   Span
 }
 
-func CaseFromNode(n *sitter.Node, input []byte) Case {
+func CaseFromNode(n *sitter.Node, input []byte) (Case, error) {
 	var c Case
+	var err error
 	c.Span = SpanFromNode(n)
 	c.Documentation = DocumentationFromNode(n, input)
+	c.Annotations, err = AnnotationsFromNode(n, input)
+	if err != nil {
+		return Case{}, err
+	}
 
-	c.Name = n.ChildByFieldName("name").Content(input)
+	c.Name, err = nameOf(n, input)
+	if err != nil {
+		return Case{}, err
+	}
 
 	for i := 0; i < int(n.NamedChildCount()); i++ {
 		child := n.NamedChild(i)
 		switch child.Type() {
 		case "arg":
-			c.Values = append(c.Values, ArgumentFromNode(child, input))
-		case "identifier", "comment":
+			arg, err := ArgumentFromNode(child, input)
+			if err != nil {
+				return Case{}, err
+			}
+			c.Values = append(c.Values, arg)
+		case "identifier", "comment", "annotation":
 			continue
 		default:
-			panic("Unhandled " + child.Type())
+			return Case{}, unexpected(child, input)
 		}
 	}
 
-	return c
+	return c, nil
 }
 
 type Flagset struct {
 	Name          string
 	Documentation *ItemDocumentation
+	Annotations   []Annotation
 
 	Optional bool
 	Flags    []Flag
@@ -445,15 +665,24 @@ type Flagset struct {
 type Flag struct {
 	Name          string
 	Documentation *ItemDocumentation
+	Annotations   []Annotation
 
 	Span Span
 }
 
-func FlagsetFromNode(n *sitter.Node, input []byte) Flagset {
+func FlagsetFromNode(n *sitter.Node, input []byte) (Flagset, error) {
 	var f Flagset
-	f.Name = n.ChildByFieldName("name").Content(input)
+	var err error
+	f.Name, err = nameOf(n, input)
+	if err != nil {
+		return Flagset{}, err
+	}
 	f.Span = SpanFromNode(n)
 	f.Documentation = DocumentationFromNode(n, input)
+	f.Annotations, err = AnnotationsFromNode(n, input)
+	if err != nil {
+		return Flagset{}, err
+	}
 
 	if n.ChildByFieldName("optional") != nil {
 		f.Optional = true
@@ -462,16 +691,103 @@ func FlagsetFromNode(n *sitter.Node, input []byte) Flagset {
 	for i := 0; i < int(n.NamedChildCount()); i++ {
 		child := n.NamedChild(i)
 		switch child.Type() {
-		case "identifier", "optional", "comment":
+		case "identifier", "optional", "comment", "annotation":
 			continue
 		case "flag_declaration":
-			f.Flags = append(f.Flags, Flag{child.ChildByFieldName("name").Content(input), DocumentationFromNode(child, input), SpanFromNode(child)})
+			name, err := nameOf(child, input)
+			if err != nil {
+				return Flagset{}, err
+			}
+			annotations, err := AnnotationsFromNode(child, input)
+			if err != nil {
+				return Flagset{}, err
+			}
+			f.Flags = append(f.Flags, Flag{name, DocumentationFromNode(child, input), annotations, SpanFromNode(child)})
 		default:
-			panic("Unhandled node " + child.Type())
+			return Flagset{}, unexpected(child, input)
 		}
 	}
 
-	return f
+	return f, nil
+}
+
+type TypeAlias struct {
+	Name          string
+	Documentation *ItemDocumentation
+	Annotations   []Annotation
+
+	// Newtype is whether the alias was declared with `newtype`, making it a
+	// distinct type instead of another name for the type it stands for.
+	Newtype bool
+	Type    Type
+	Span    Span
+}
+
+func TypeAliasFromNode(n *sitter.Node, input []byte) (TypeAlias, error) {
+	var t TypeAlias
+	var err error
+	t.Span = SpanFromNode(n)
+	t.Documentation = DocumentationFromNode(n, input)
+	t.Annotations, err = AnnotationsFromNode(n, input)
+	if err != nil {
+		return TypeAlias{}, err
+	}
+
+	t.Name, err = nameOf(n, input)
+	if err != nil {
+		return TypeAlias{}, err
+	}
+	kind, err := childByFieldName(n, "kind")
+	if err != nil {
+		return TypeAlias{}, err
+	}
+	t.Newtype = kind.Type() == "newtype"
+	t.Type, err = typeOf(n, input)
+	if err != nil {
+		return TypeAlias{}, err
+	}
+
+	return t, nil
+}
+
+type Constant struct {
+	Name          string
+	Documentation *ItemDocumentation
+	Annotations   []Annotation
+
+	Type  Type
+	Value Literal
+	Span  Span
+}
+
+func ConstantFromNode(n *sitter.Node, input []byte) (Constant, error) {
+	var c Constant
+	var err error
+	c.Span = SpanFromNode(n)
+	c.Documentation = DocumentationFromNode(n, input)
+	c.Annotations, err = AnnotationsFromNode(n, input)
+	if err != nil {
+		return Constant{}, err
+	}
+
+	c.Name, err = nameOf(n, input)
+	if err != nil {
+		return Constant{}, err
+	}
+	c.Type, err = typeOf(n, input)
+	if err != nil {
+		return Constant{}, err
+	}
+	value, err := childByFieldName(n, "value")
+	if err != nil {
+		return Constant{}, err
+	}
+	c.Value, err = LiteralFromNode(value, input)
+	if err != nil {
+		return Constant{}, err
+	}
+
+	return c, nil
 }
 
 type Type interface {
@@ -479,22 +795,42 @@ type Type interface {
 	GetSpan() Span
 }
 
-func TypeFromNode(n *sitter.Node, input []byte) Type {
+func TypeFromNode(n *sitter.Node, input []byte) (Type, error) {
 	switch n.ChildCount() {
 	case 1: // ident
-		return TypeIdent{n.Child(0).Content(input), SpanFromNode(n)}
+		return TypeIdent{n.Child(0).Content(input), SpanFromNode(n)}, nil
 	case 3: // array or subscript
 		if n.Child(1).Type() == "." { // subscript
-			return TypeSubscript{TypeFromNode(n.Child(0), input), n.Child(2).Content(input), SpanFromNode(n)}
+			inner, err := TypeFromNode(n.Child(0), input)
+			if err != nil {
+				return nil, err
+			}
+			return TypeSubscript{inner, n.Child(2).Content(input), SpanFromNode(n)}, nil
 		} else { // array
-			return TypeArray{TypeFromNode(n.Child(1), input), SpanFromNode(n)}
+			inner, err := TypeFromNode(n.Child(1), input)
+			if err != nil {
+				return nil, err
+			}
+			return TypeArray{inner, SpanFromNode(n)}, nil
 		}
 	case 5: // dict
-		return TypeDictionary{TypeFromNode(n.Child(1), input), TypeFromNode(n.Child(3), input), SpanFromNode(n)}
+		key, err := TypeFromNode(n.Child(1), input)
+		if err != nil {
+			return nil, err
+		}
+		value, err := TypeFromNode(n.Child(3), input)
+		if err != nil {
+			return nil, err
+		}
+		return TypeDictionary{key, value, SpanFromNode(n)}, nil
 	case 2: // optional
-		return TypeOptional{TypeFromNode(n.Child(0), input), SpanFromNode(n)}
+		inner, err := TypeFromNode(n.Child(0), input)
+		if err != nil {
+			return nil, err
+		}
+		return TypeOptional{inner, SpanFromNode(n)}, nil
 	default:
-		panic("Unhandled " + n.String())
+		return nil, SyntaxError{SpanFromNode(n), "malformed type; expected " + expectations["type"]}
 	}
 }
 
@@ -0,0 +1,184 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	lugma "lugmac/parser"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// SyntaxError is a problem with how a file is written, found while parsing it.
+type SyntaxError struct {
+	Span    Span
+	Message string
+}
+
+func (s SyntaxError) Error() string {
+	return fmt.Sprintf("%s: %s", s.Span, s.Message)
+}
+
+// SyntaxErrors is every syntax error found in a file.
+type SyntaxErrors []SyntaxError
+
+func (s SyntaxErrors) Error() string {
+	var lines []string
+	for _, err := range s {
+		lines = append(lines, err.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// shapes describes how each kind of declaration is written, for hinting at
+// what was expected when one is malformed. They're keyed by the keyword that
+// starts the declaration.
+var shapes = map[string]string{
+	"import":    "`import \"path\" as name`",
+	"struct":    "`struct Name { ... }`",
+	"let":       "`let name: Type`",
+	"enum":      "`enum Name { ... }`",
+	"case":      "`case name` or `case name(value: Type)`",
+	"flagset":   "`flagset Name { ... }`",
+	"flag":      "`flag name`",
+	"func":      "`func name(argument: Type) throws Error -> Result`",
+	"stream":    "`stream Name { ... }`",
+	"event":     "`event name(argument: Type)`",
+	"signal":    "`signal name(argument: Type)`",
+	"typealias": "`typealias Name = Type`",
+	"newtype":   "`newtype Name = Type`",
+	"const":     "`const NAME: Type = value`",
+	"@":         "`@name(arguments...)`",
+}
+
+// nouns names the declarations whose keyword doesn't already name them.
+var nouns = map[string]string{
+	"let":       "field",
+	"func":      "function",
+	"typealias": "type alias",
+	"const":     "constant",
+	"@":         "annotation",
+}
+
+// expectations describes what may appear inside each kind of node, for
+// hinting at what was expected when something else was found there.
+var expectations = map[string]string{
+	"file":                "a declaration such as `struct`, `enum`, `flagset`, `typealias`, `newtype`, `const`, `func`, `stream` or `import`",
+	"struct_declaration":  "a field like " + shapes["let"],
+	"enum_declaration":    "a case like " + shapes["case"],
+	"flagset_declaration": "a flag like " + shapes["flag"],
+	"stream_declaration":  "an event like " + shapes["event"] + " or a signal like " + shapes["signal"],
+	"type":                "a type like `Name`, `[Element]`, `[Key: Value]` or `Type?`",
+	"annotation":          "an annotation like " + shapes["@"],
+}
+
+// firstLeaf returns the first token inside n.
+func firstLeaf(n *sitter.Node) *sitter.Node {
+	for n.ChildCount() > 0 {
+		n = n.Child(0)
+	}
+	return n
+}
+
+func describeToken(n *sitter.Node, input []byte) string {
+	content := n.Content(input)
+	if i := strings.IndexAny(content, " \t\r\n"); i != -1 {
+		content = content[:i]
+	}
+	if len(content) > 20 {
+		content = content[:20] + "…"
+	}
+	if content == "" {
+		return "end of file"
+	}
+	return "`" + content + "`"
+}
+
+func syntaxErrorFor(n *sitter.Node, input []byte) SyntaxError {
+	span := SpanFromNode(n)
+
+	if n.IsMissing() {
+		kind := n.Type()
+		if n.IsNamed() {
+			kind = strings.ReplaceAll(kind, "_", " ")
+		} else {
+			kind = "`" + kind + "`"
+		}
+		return SyntaxError{span, fmt.Sprintf("expected %s", kind)}
+	}
+
+	first := firstLeaf(n)
+	if shape, ok := shapes[first.Type()]; ok && !first.IsNamed() {
+		name := first.Type()
+		if noun, ok := nouns[name]; ok {
+			name = noun
+		}
+		return SyntaxError{span, fmt.Sprintf("malformed %s; it should look like %s", name, shape)}
+	}
+
+	return unexpectedAt(n, first, input)
+}
+
+// SyntaxErrorsIn finds every ERROR and MISSING node in the tree rooted at n.
+func SyntaxErrorsIn(n *sitter.Node, input []byte) SyntaxErrors {
+	var ret SyntaxErrors
+
+	if !n.HasError() && !n.IsMissing() {
+		return nil
+	}
+	if n.IsError() || n.IsMissing() {
+		return SyntaxErrors{syntaxErrorFor(n, input)}
+	}
+
+	for i := 0; i < int(n.ChildCount()); i++ {
+		ret = append(ret, SyntaxErrorsIn(n.Child(i), input)...)
+	}
+
+	return ret
+}
+
+// Parse parses input into a File. If input has any syntax errors, they are
+// returned as SyntaxErrors.
+func Parse(input []byte) (*File, error) {
+	parser := sitter.NewParser()
+	parser.SetLanguage(lugma.GetLanguage())
+
+	tree := parser.Parse(nil, input)
+
+	if errs := SyntaxErrorsIn(tree.RootNode(), input); len(errs) > 0 {
+		return nil, errs
+	}
+
+	file, err := FileFromNode(tree.RootNode(), input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &file, nil
+}
+
+// unexpectedAt reports that n, starting with token, isn't something that was
+// expected where it was found.
+func unexpectedAt(n *sitter.Node, token *sitter.Node, input []byte) SyntaxError {
+	msg := fmt.Sprintf("unexpected %s", describeToken(token, input))
+	if parent := n.Parent(); parent != nil {
+		if expected, ok := expectations[parent.Type()]; ok {
+			msg += fmt.Sprintf("; expected %s", expected)
+		}
+	}
+	return SyntaxError{SpanFromNode(n), msg}
+}
+
+func unexpected(n *sitter.Node, input []byte) error {
+	return unexpectedAt(n, firstLeaf(n), input)
+}
+
+// childByFieldName is like n.ChildByFieldName, but returns an error if there
+// isn't a child by that name.
+func childByFieldName(n *sitter.Node, name string) (*sitter.Node, error) {
+	child := n.ChildByFieldName(name)
+	if child == nil {
+		return nil, SyntaxError{SpanFromNode(n), fmt.Sprintf("%s is missing its %s", strings.ReplaceAll(n.Type(), "_", " "), name)}
+	}
+	return child, nil
+}
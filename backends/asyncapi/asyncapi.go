@@ -0,0 +1,192 @@
+package asyncapi
+
+import (
+	"encoding/json"
+	"io/fs"
+	"io/ioutil"
+	"lugmac/ast"
+	"lugmac/backends"
+	"lugmac/modules"
+	"lugmac/typechecking"
+	"os"
+	"path"
+
+	"github.com/urfave/cli/v2"
+)
+
+// AsyncAPIBackend describes the streams of modules, which are served as
+// WebSockets, as AsyncAPI 2.6 documents. The documents describe a client of
+// the streams, so events, which it consumes, are publish operations, and
+// signals, which it produces, are subscribe operations.
+type AsyncAPIBackend struct {
+}
+
+var _ backends.Backend = AsyncAPIBackend{}
+
+func init() {
+	backends.RegisterBackend(AsyncAPIBackend{})
+}
+
+// handshake describes the initial payload every stream starts with.
+const handshake = "The client sends an initial payload before anything else: an object of the headers its request would have had, mapping their names to their values as strings. " +
+	"Every frame after it is an object whose \"type\" names an event or signal, and whose \"content\" holds its arguments. " +
+	"If the initial payload is sent as a binary frame of CBOR rather than as text, every frame is sent that way."
+
+func (aa AsyncAPIBackend) GenerateCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "asyncapi",
+		Usage: "Generate AsyncAPI documents describing the streams of Lugma modules",
+		Flags: backends.StandardFlags,
+		Action: func(cCtx *cli.Context) error {
+			w, err := modules.LoadWorkspaceFrom(cCtx.String("workspace"))
+			if err != nil {
+				return err
+			}
+			err = w.GenerateModules()
+			if err != nil {
+				return err
+			}
+
+			outdir := cCtx.String("outdir")
+			err = os.MkdirAll(path.Join(outdir), 0750)
+			if err != nil {
+				return err
+			}
+
+			for _, prod := range w.Module.Products {
+				mod := w.KnownModules[prod.Name]
+
+				result, err := aa.GenerateDocument(mod, w.Module.Version, w.Context)
+				if err != nil {
+					return err
+				}
+
+				err = ioutil.WriteFile(path.Join(outdir, mod.Name+".asyncapi.json"), result, fs.ModePerm)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// message returns the message sending an event or signal named name with
+// arguments.
+func message(schemas *backends.Schemas, name string, docs *ast.ItemDocumentation, arguments []*typechecking.Field) backends.Schema {
+	content := schemas.Fields(arguments)
+	if docs != nil {
+		// arguments are documented in their event's or signal's
+		// documentation unless they have their own
+		properties := content["properties"].(backends.Properties)
+		for name, node := range docs.Parameters {
+			property := properties.Get(name)
+			if property == nil {
+				continue
+			}
+			if _, ok := property["description"]; !ok {
+				property["description"] = backends.DocText(node, docs.Source)
+			}
+		}
+	}
+
+	msg := backends.Schema{
+		"name":        name,
+		"contentType": "application/json",
+		"payload": backends.Schema{
+			"type": "object",
+			"properties": backends.Schema{
+				"type":    backends.Schema{"const": name},
+				"content": content,
+			},
+			"required": []string{"type", "content"},
+		},
+	}
+	if docs != nil {
+		if summary := backends.DocText(docs.Summary, docs.Source); summary != "" {
+			msg["summary"] = summary
+		}
+	}
+	if description := backends.Description(docs); description != "" {
+		msg["description"] = description
+	}
+	return msg
+}
+
+// messages returns what an operation sending msgs refers to them with.
+func messages(msgs []backends.Schema) backends.Schema {
+	if len(msgs) == 1 {
+		return msgs[0]
+	}
+	return backends.Schema{"oneOf": msgs}
+}
+
+// GenerateDocument generates the AsyncAPI document of mod, whose workspace
+// is at version.
+func (aa AsyncAPIBackend) GenerateDocument(mod *typechecking.Module, version string, in *typechecking.Context) ([]byte, error) {
+	schemas := backends.NewSchemas(func(typ typechecking.Type) string {
+		return "#/components/schemas/" + backends.ComponentName(typ, mod)
+	})
+
+	channels := backends.Schema{}
+	for _, stream := range mod.Streams {
+		var events []backends.Schema
+		for _, ev := range stream.Events {
+			events = append(events, message(schemas, ev.ObjectName(), ev.Documentation, ev.Arguments))
+		}
+
+		signals := []backends.Schema{{
+			"name":        "initialPayload",
+			"summary":     "Starts the stream.",
+			"description": handshake,
+			"contentType": "application/json",
+			"payload": backends.Schema{
+				"type":                 "object",
+				"additionalProperties": backends.Schema{"type": "string"},
+			},
+		}}
+		for _, sig := range stream.Signals {
+			signals = append(signals, message(schemas, sig.ObjectName(), sig.Documentation, sig.Arguments))
+		}
+
+		channel := backends.Schema{
+			"bindings": backends.Schema{
+				"ws": backends.Schema{"method": "GET"},
+			},
+			"subscribe": backends.Schema{
+				"operationId": "send" + stream.ObjectName() + "Signals",
+				"message":     messages(signals),
+			},
+		}
+		if len(events) > 0 {
+			channel["publish"] = backends.Schema{
+				"operationId": "receive" + stream.ObjectName() + "Events",
+				"message":     messages(events),
+			}
+		}
+		if description := backends.Description(stream.Documentation); description != "" {
+			channel["description"] = description
+		}
+		channels["/"+stream.Path().String()] = channel
+	}
+
+	document := backends.Schema{
+		"asyncapi": "2.6.0",
+		"info": backends.Schema{
+			"title":   mod.Name,
+			"version": version,
+		},
+		"defaultContentType": "application/json",
+		"channels":           channels,
+		"components": backends.Schema{
+			"schemas": schemas.Components(mod),
+		},
+	}
+
+	data, err := json.MarshalIndent(document, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
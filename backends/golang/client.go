@@ -0,0 +1,139 @@
+package golang
+
+import (
+	"lugmac/typechecking"
+)
+
+func (g GoBackend) GenerateClient(mod *typechecking.Module, opts Options, in *typechecking.Context) (string, error) {
+	build := newGoFile(mod, opts)
+
+	lugma := build.runtime()
+
+	for _, stream := range mod.Streams {
+		name := stream.ObjectName() + "ClientStream"
+
+		build.docComment(name, summaryOf(stream.Documentation), stream.Annotations)
+		build.AddI("type %s struct {", name)
+		build.Add("%s.Stream", lugma)
+		build.AddD("}")
+		build.AddNL()
+
+		for _, ev := range stream.Events {
+			params, err := build.parameters(ev.Arguments)
+			if err != nil {
+				return "", err
+			}
+
+			build.use("encoding/json")
+			build.docComment("On"+exported(ev.ObjectName()), summaryOf(ev.Documentation), ev.Annotations)
+			build.AddI("func (s %s) On%s(callback func(%s)) int {", name, exported(ev.ObjectName()), params)
+			build.AddI(`return s.Stream.On("%s", func(content json.RawMessage) {`, ev.ObjectName())
+			build.AddE("var args ")
+			err = build.payload(ev.Arguments)
+			if err != nil {
+				return "", err
+			}
+			build.AddNL()
+			build.AddI("if err := json.Unmarshal(content, &args); err != nil {")
+			build.Add("return")
+			build.AddD("}")
+			build.fillDefaults("args", "content", ev.Arguments, "return")
+			build.Add("callback(%s)", argsOf(ev.Arguments))
+			build.AddD("})")
+			build.AddD("}")
+			build.AddNL()
+		}
+		for _, sig := range stream.Signals {
+			params, err := build.parameters(sig.Arguments)
+			if err != nil {
+				return "", err
+			}
+
+			build.docComment(exported(sig.ObjectName()), summaryOf(sig.Documentation), sig.Annotations)
+			build.AddI("func (s %s) %s(%s) error {", name, exported(sig.ObjectName()), params)
+			build.AddE(`return s.Stream.Send("%s", `, sig.ObjectName())
+			err = build.payloadValue(sig.Arguments)
+			if err != nil {
+				return "", err
+			}
+			build.AddK(")\n")
+			build.AddD("}")
+			build.AddNL()
+		}
+	}
+
+	build.Add("// Client calls the functions of %s through a Transport.", mod.Name)
+	build.AddI("type Client[T any] struct {")
+	build.Add("Transport %s.Transport[T]", lugma)
+	build.AddD("}")
+	build.AddNL()
+
+	build.AddI("func NewClient[T any](transport %s.Transport[T]) *Client[T] {", lugma)
+	build.Add("return &Client[T]{transport}")
+	build.AddD("}")
+	build.AddNL()
+
+	for _, stream := range mod.Streams {
+		name := stream.ObjectName() + "ClientStream"
+
+		build.use("context")
+		build.Add("// Open%s opens the %s stream.", stream.ObjectName(), stream.ObjectName())
+		build.AddI("func (c *Client[T]) Open%s(ctx context.Context, extra T) (%s, error) {", stream.ObjectName(), name)
+		build.Add(`stream, err := c.Transport.OpenStream(ctx, "%s", extra)`, stream.Path().String())
+		build.AddI("if err != nil {")
+		build.Add("return %s{}, err", name)
+		build.AddD("}")
+		build.Add("return %s{stream}, nil", name)
+		build.AddD("}")
+		build.AddNL()
+	}
+
+	for _, fn := range mod.Funcs {
+		params, err := build.parameters(fn.Arguments)
+		if err != nil {
+			return "", err
+		}
+		if params != "" {
+			params += ", "
+		}
+
+		ret := "struct{}"
+		if fn.Returns != nil {
+			ret, err = build.GoTypeOf(fn.Returns)
+			if err != nil {
+				return "", err
+			}
+		}
+		fai := "json.RawMessage"
+		if fn.Throws == nil {
+			build.use("encoding/json")
+		} else {
+			fai, err = build.GoTypeOf(fn.Throws)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		build.use("context")
+		build.docComment(exported(fn.ObjectName()), summaryOf(fn.Documentation), fn.Annotations)
+		if fn.Returns != nil {
+			build.AddI("func (c *Client[T]) %s(ctx context.Context, %sextra T) (%s, error) {", exported(fn.ObjectName()), params, ret)
+			build.AddE("return %s.Call[T, %s, %s](ctx, c.Transport, \"%s\", ", lugma, ret, fai, fn.Path().String())
+		} else {
+			build.AddI("func (c *Client[T]) %s(ctx context.Context, %sextra T) error {", exported(fn.ObjectName()), params)
+			build.AddE("_, err := %s.Call[T, %s, %s](ctx, c.Transport, \"%s\", ", lugma, ret, fai, fn.Path().String())
+		}
+		err = build.payloadValue(fn.Arguments)
+		if err != nil {
+			return "", err
+		}
+		build.AddK(", extra)\n")
+		if fn.Returns == nil {
+			build.Add("return err")
+		}
+		build.AddD("}")
+		build.AddNL()
+	}
+
+	return build.finish()
+}
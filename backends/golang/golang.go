@@ -0,0 +1,502 @@
+package golang
+
+import (
+	"fmt"
+	"go/format"
+	"go/token"
+	"io/fs"
+	"io/ioutil"
+	"lugmac/backends"
+	"lugmac/modules"
+	"lugmac/typechecking"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/urfave/cli/v2"
+)
+
+type GoBackend struct {
+}
+
+var _ backends.Backend = GoBackend{}
+
+func init() {
+	backends.RegisterBackend(GoBackend{})
+}
+
+func contains[T comparable](a []T, b T) bool {
+	for _, item := range a {
+		if item == b {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultRuntime is the import path of the package generated code uses to
+// talk to its transports.
+const DefaultRuntime = "github.com/pontaoski/lugma/other/lugma-go-helpers"
+
+// Options controls where generated packages find each other and their
+// runtime.
+type Options struct {
+	// ImportPrefix is the import path of the output directory.
+	ImportPrefix string
+	// Runtime is the import path of the runtime package.
+	Runtime string
+}
+
+// goFile accumulates a single generated Go source file, keeping track of the
+// packages it needs to import.
+type goFile struct {
+	backends.Filebuilder
+
+	mod     *typechecking.Module
+	opts    Options
+	imports map[string]string
+}
+
+func newGoFile(mod *typechecking.Module, opts Options) *goFile {
+	return &goFile{mod: mod, opts: opts, imports: map[string]string{}}
+}
+
+func (g *goFile) use(pkg string) {
+	g.imports[pkg] = ""
+}
+
+// runtime imports the runtime package, returning the name it's imported as.
+func (g *goFile) runtime() string {
+	g.imports[g.opts.Runtime] = "lugma"
+	return "lugma"
+}
+
+func (g *goFile) finish() (string, error) {
+	var out strings.Builder
+
+	out.WriteString("// Code generated by lugmac. DO NOT EDIT.\n\n")
+	out.WriteString(fmt.Sprintf("package %s\n\n", PackageName(g.mod)))
+
+	if len(g.imports) > 0 {
+		var pkgs []string
+		for pkg := range g.imports {
+			pkgs = append(pkgs, pkg)
+		}
+		sort.Strings(pkgs)
+
+		// standard library packages first, then everything else
+		sort.SliceStable(pkgs, func(i, j int) bool {
+			return !isThirdParty(pkgs[i]) && isThirdParty(pkgs[j])
+		})
+
+		out.WriteString("import (\n")
+		for idx, pkg := range pkgs {
+			if idx > 0 && isThirdParty(pkg) && !isThirdParty(pkgs[idx-1]) {
+				out.WriteString("\n")
+			}
+			if as := g.imports[pkg]; as != "" {
+				out.WriteString(fmt.Sprintf("\t%s %q\n", as, pkg))
+			} else {
+				out.WriteString(fmt.Sprintf("\t%q\n", pkg))
+			}
+		}
+		out.WriteString(")\n\n")
+	}
+
+	out.WriteString(g.String())
+
+	formatted, err := format.Source([]byte(out.String()))
+	if err != nil {
+		return "", fmt.Errorf("failed to format generated code for %s: %w", g.mod.Name, err)
+	}
+
+	return string(formatted), nil
+}
+
+func isThirdParty(pkg string) bool {
+	return strings.Contains(strings.Split(pkg, "/")[0], ".")
+}
+
+// PackageName is the name of the Go package generated for a module.
+func PackageName(mod *typechecking.Module) string {
+	return strings.ToLower(strcase.ToSnake(mod.Name))
+}
+
+// reservedLocals are the identifiers that parameters can't be given: the
+// predeclared ones generated code relies on, and the names it gives its own
+// variables and imports where parameters are in scope.
+var reservedLocals = map[string]struct{}{
+	"bool": {}, "byte": {}, "error": {}, "string": {}, "any": {},
+	"int8": {}, "int16": {}, "int32": {}, "int64": {},
+	"uint8": {}, "uint16": {}, "uint32": {}, "uint64": {},
+	"float32": {}, "float64": {},
+	"true": {}, "false": {}, "nil": {}, "len": {}, "make": {}, "new": {},
+	"c": {}, "s": {}, "ctx": {}, "extra": {}, "callback": {}, "args": {}, "ret": {}, "err": {}, "present": {},
+	"lugma": {}, "json": {}, "time": {}, "context": {},
+}
+
+// initialisms are the words Go writes in one case, such as ID in UserID,
+// following the list used by golint.
+var initialisms = map[string]struct{}{
+	"ACL": {}, "API": {}, "ASCII": {}, "CPU": {}, "CSS": {}, "DNS": {},
+	"EOF": {}, "GUID": {}, "HTML": {}, "HTTP": {}, "HTTPS": {}, "ID": {},
+	"IP": {}, "JSON": {}, "LHS": {}, "QPS": {}, "RAM": {}, "RHS": {},
+	"RPC": {}, "SLA": {}, "SMTP": {}, "SQL": {}, "SSH": {}, "TCP": {},
+	"TLS": {}, "TTL": {}, "UDP": {}, "UI": {}, "UID": {}, "UUID": {},
+	"URI": {}, "URL": {}, "UTF8": {}, "VM": {}, "XML": {}, "XMPP": {},
+	"XSRF": {}, "XSS": {},
+}
+
+// goWords splits a Lugma name into words written the way Go writes them.
+func goWords(name string) []string {
+	var words []string
+	for _, word := range strings.Split(strcase.ToSnake(name), "_") {
+		if word == "" {
+			continue
+		}
+		if _, ok := initialisms[strings.ToUpper(word)]; ok {
+			words = append(words, strings.ToUpper(word))
+		} else {
+			words = append(words, strings.ToUpper(word[:1])+word[1:])
+		}
+	}
+	return words
+}
+
+// exported turns a Lugma name into an exported Go identifier.
+func exported(name string) string {
+	return strings.Join(goWords(name), "")
+}
+
+// local turns a Lugma name into a Go identifier that is safe to use as a
+// parameter name in generated code.
+func (g *goFile) local(name string) string {
+	words := goWords(name)
+	if len(words) > 0 {
+		words[0] = strings.ToLower(words[0])
+	}
+	ident := strings.Join(words, "")
+	if _, ok := reservedLocals[ident]; ok || token.IsKeyword(ident) {
+		return ident + "_"
+	}
+	if g.mod.InWorkspace != nil {
+		for _, mod := range g.mod.InWorkspace.Modules {
+			if PackageName(mod) == ident {
+				return ident + "_"
+			}
+		}
+	}
+	return ident
+}
+
+func (g *goFile) qualified(obj typechecking.Object) (string, error) {
+	if obj.Path().ModulePath == g.mod.Path().ModulePath {
+		return obj.ObjectName(), nil
+	}
+
+	mod, ok := obj.Parent().(*typechecking.Module)
+	if !ok {
+		return "", fmt.Errorf("%s is not declared at the top level of a module", obj.ObjectName())
+	}
+	if g.opts.ImportPrefix == "" {
+		return "", fmt.Errorf("%s refers to %s from module %s, which requires --import-prefix", g.mod.Name, obj.ObjectName(), mod.Name)
+	}
+
+	g.use(path.Join(g.opts.ImportPrefix, PackageName(mod)))
+
+	return PackageName(mod) + "." + obj.ObjectName(), nil
+}
+
+func (g *goFile) GoTypeOf(lugma typechecking.Type) (string, error) {
+	switch k := lugma.(type) {
+	case typechecking.PrimitiveType:
+		switch k {
+		case typechecking.UInt8:
+			return "uint8", nil
+		case typechecking.UInt16:
+			return "uint16", nil
+		case typechecking.UInt32:
+			return "uint32", nil
+		case typechecking.Int8:
+			return "int8", nil
+		case typechecking.Int16:
+			return "int16", nil
+		case typechecking.Int32:
+			return "int32", nil
+		case typechecking.Int64:
+			return g.runtime() + ".Int64", nil
+		case typechecking.UInt64:
+			return g.runtime() + ".UInt64", nil
+		case typechecking.Float32:
+			return "float32", nil
+		case typechecking.Float64:
+			return "float64", nil
+		case typechecking.String:
+			return "string", nil
+		case typechecking.Bytes:
+			return "[]byte", nil
+		case typechecking.Bool:
+			return "bool", nil
+		case typechecking.Timestamp:
+			g.use("time")
+			return "time.Time", nil
+		case typechecking.Duration:
+			return g.runtime() + ".Duration", nil
+		case typechecking.UUID:
+			return g.runtime() + ".UUID", nil
+		default:
+			panic("unhandled primitive " + k.String())
+		}
+	case typechecking.ArrayType:
+		elem, err := g.GoTypeOf(k.Element)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	case typechecking.DictionaryType:
+		key, err := g.GoTypeOf(k.Key)
+		if err != nil {
+			return "", err
+		}
+		if typechecking.Resolve(k.Key) == typechecking.Bytes {
+			// slices can't be map keys, so bytes are held by a key that's
+			// sent as base64 the way they are
+			key = g.runtime() + ".BytesKey"
+		}
+		elem, err := g.GoTypeOf(k.Element)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("map[%s]%s", key, elem), nil
+	case typechecking.OptionalType:
+		elem, err := g.GoTypeOf(k.Element)
+		if err != nil {
+			return "", err
+		}
+		return "*" + elem, nil
+	case *typechecking.Struct, *typechecking.Enum, *typechecking.Flagset, *typechecking.TypeAlias:
+		return g.qualified(k)
+	default:
+		panic("unhandled " + k.String())
+	}
+}
+
+func (g *goFile) docComment(name string, summary string, annotations typechecking.Annotations) {
+	deprecated := annotations.Get("deprecated")
+	if summary == "" && deprecated == nil {
+		return
+	}
+	if summary != "" {
+		g.Add("// %s %s", name, strings.ReplaceAll(strings.TrimSpace(summary), "\n", "\n// "))
+	}
+	if deprecated != nil {
+		if summary != "" {
+			g.Add("//")
+		}
+		reason := "This should no longer be used."
+		if v, ok := deprecated.Argument(0).(typechecking.StringValue); ok {
+			reason = strings.ReplaceAll(strings.TrimSpace(string(v)), "\n", "\n// ")
+		}
+		g.Add("// Deprecated: %s", reason)
+	}
+}
+
+// payload adds an anonymous struct type whose fields are the given
+// arguments, matching the object that carries them on the wire.
+func (g *goFile) payload(args []*typechecking.Field) error {
+	if len(args) == 0 {
+		g.AddK("struct{}")
+		return nil
+	}
+
+	g.AddK("struct {\n")
+	g.Einzug++
+	for _, arg := range args {
+		typ, err := g.GoTypeOf(arg.Type)
+		if err != nil {
+			return err
+		}
+		g.Add("%s %s `json:\"%s\"`", exported(arg.ObjectName()), typ, arg.ObjectName())
+	}
+	g.Einzug--
+	g.AddE("}")
+	return nil
+}
+
+// parameters renders arguments as a Go parameter list, without parentheses.
+func (g *goFile) parameters(args []*typechecking.Field) (string, error) {
+	var params []string
+	for _, arg := range args {
+		typ, err := g.GoTypeOf(arg.Type)
+		if err != nil {
+			return "", err
+		}
+		params = append(params, fmt.Sprintf("%s %s", g.local(arg.ObjectName()), typ))
+	}
+	return strings.Join(params, ", "), nil
+}
+
+// payloadValue adds a composite literal of payload's type from parameters
+// named as in parameters.
+func (g *goFile) payloadValue(args []*typechecking.Field) error {
+	err := g.payload(args)
+	if err != nil {
+		return err
+	}
+	var values []string
+	for _, arg := range args {
+		values = append(values, g.local(arg.ObjectName()))
+	}
+	g.AddK("{%s}", strings.Join(values, ", "))
+	return nil
+}
+
+// fillDefaults adds statements setting the fields of value that are missing
+// from the JSON object data to their defaults, running onError if that fails
+// with err.
+func (g *goFile) fillDefaults(value, data string, fields []*typechecking.Field, onError string) {
+	if !hasDefaults(fields) {
+		return
+	}
+
+	g.use("encoding/json")
+	g.Add("var present map[string]json.RawMessage")
+	g.AddI("if err := json.Unmarshal(%s, &present); err != nil {", data)
+	g.Add(onError)
+	g.AddD("}")
+	for _, field := range fields {
+		if field.Default == nil {
+			continue
+		}
+		g.AddI(`if _, ok := present["%s"]; !ok {`, field.ObjectName())
+		g.AddI("if err := json.Unmarshal([]byte(%s), &%s.%s); err != nil {", strconv.Quote(backends.WireJSON(field.Default, field.Type)), value, exported(field.ObjectName()))
+		g.Add(onError)
+		g.AddD("}")
+		g.AddD("}")
+	}
+}
+
+// defaultsUnmarshaler adds an UnmarshalJSON method to the struct type name
+// that fills in missing fields with their defaults, if any of them have one.
+func (g *goFile) defaultsUnmarshaler(name string, fields []*typechecking.Field) {
+	if !hasDefaults(fields) {
+		return
+	}
+
+	g.use("encoding/json")
+	g.AddI("func (s *%s) UnmarshalJSON(data []byte) error {", name)
+	g.Add("type plain %s", name)
+	g.AddI("if err := json.Unmarshal(data, (*plain)(s)); err != nil {")
+	g.Add("return err")
+	g.AddD("}")
+	g.fillDefaults("s", "data", fields, "return err")
+	g.Add("return nil")
+	g.AddD("}")
+	g.AddNL()
+}
+
+func hasDefaults(fields []*typechecking.Field) bool {
+	for _, field := range fields {
+		if field.Default != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func argsOf(args []*typechecking.Field) string {
+	var values []string
+	for _, arg := range args {
+		values = append(values, "args."+exported(arg.ObjectName()))
+	}
+	return strings.Join(values, ", ")
+}
+
+func (g GoBackend) GenerateCommand() *cli.Command {
+	possible := []string{"server", "client"}
+	return &cli.Command{
+		Name:    "go",
+		Aliases: []string{"golang"},
+		Usage:   "Generate Go packages for Lugma",
+		Flags: append(backends.StandardFlags, []cli.Flag{
+			&cli.StringSliceFlag{
+				Name:  "types",
+				Usage: "The types of code to generate",
+				Value: cli.NewStringSlice(possible...),
+			},
+			&cli.StringFlag{
+				Name:  "import-prefix",
+				Usage: "The import path of the output directory, used for references between modules",
+			},
+			&cli.StringFlag{
+				Name:  "runtime",
+				Usage: "The import path of the Lugma runtime package",
+				Value: DefaultRuntime,
+			},
+		}...),
+		Action: func(cCtx *cli.Context) error {
+			w, err := modules.LoadWorkspaceFrom(cCtx.String("workspace"))
+			if err != nil {
+				return err
+			}
+			err = w.GenerateModules()
+			if err != nil {
+				return err
+			}
+
+			opts := Options{
+				ImportPrefix: cCtx.String("import-prefix"),
+				Runtime:      cCtx.String("runtime"),
+			}
+			return g.generatePackages(w, cCtx.String("outdir"), opts, cCtx.StringSlice("types"))
+		},
+	}
+}
+
+// generatePackages writes a package for each module code is generated for
+// from w, which must have been checked, into outdir, with the types of code
+// in types.
+func (g GoBackend) generatePackages(w *modules.Workspace, outdir string, opts Options, types []string) error {
+	generated, err := backends.GeneratedModules(w)
+	if err != nil {
+		return err
+	}
+	for _, mod := range generated.All() {
+		pkgdir := path.Join(outdir, PackageName(mod))
+		err = os.MkdirAll(pkgdir, 0750)
+		if err != nil {
+			return err
+		}
+
+		write := func(name string, generate func(*typechecking.Module, Options, *typechecking.Context) (string, error)) error {
+			result, err := generate(mod, opts, w.Context)
+			if err != nil {
+				return err
+			}
+
+			return ioutil.WriteFile(path.Join(pkgdir, name), []byte(result), fs.ModePerm)
+		}
+
+		err = write("types.go", g.GenerateTypes)
+		if err != nil {
+			return err
+		}
+		if contains(types, "client") {
+			err = write("client.go", g.GenerateClient)
+			if err != nil {
+				return err
+			}
+		}
+		if contains(types, "server") {
+			err = write("server.go", g.GenerateServer)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package golang
+
+import (
+	"go/parser"
+	"go/token"
+	"lugmac/modules"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestExported(t *testing.T) {
+	cases := map[string]string{
+		"name":       "Name",
+		"id":         "ID",
+		"userId":     "UserID",
+		"uid":        "UID",
+		"avatarUrl":  "AvatarURL",
+		"httpServer": "HTTPServer",
+		"URL":        "URL",
+		"user_id":    "UserID",
+		"apiKey":     "APIKey",
+		"ids":        "Ids",
+		"identity":   "Identity",
+	}
+	for name, want := range cases {
+		if got := exported(name); got != want {
+			t.Errorf("exported(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+// workspaces are the workspaces whose generated code is checked.
+var workspaces = []string{
+	filepath.Join("..", "..", "examples", "package"),
+	filepath.Join("testdata", "Everything"),
+}
+
+// generate writes the packages generated for the workspace at dir into a new
+// module, returning its directory.
+func generate(t *testing.T, dir string) string {
+	t.Helper()
+	w, err := modules.LoadWorkspaceFrom(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.GenerateModules()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := t.TempDir()
+	opts := Options{ImportPrefix: "generated", Runtime: DefaultRuntime}
+	err = GoBackend{}.generatePackages(w, out, opts, []string{"client", "server"})
+	if err != nil {
+		t.Fatalf("failed to generate: %s", err)
+	}
+	return out
+}
+
+// TestGeneratedCode generates the packages of each of workspaces, checking
+// that they parse and, if there's a go command to do it with, that they build
+// and pass go vet against the runtime package in this repository.
+func TestGeneratedCode(t *testing.T) {
+	runtime, err := filepath.Abs(filepath.Join("..", "..", "other", "lugma-go-helpers"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sums, err := os.ReadFile(filepath.Join(runtime, "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dir := range workspaces {
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			out := generate(t, dir)
+
+			files, err := filepath.Glob(filepath.Join(out, "*", "*.go"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(files) == 0 {
+				t.Fatal("no code was generated")
+			}
+			for _, file := range files {
+				_, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.AllErrors)
+				if err != nil {
+					t.Errorf("generated invalid Go: %s", err)
+				}
+			}
+
+			gocmd, err := exec.LookPath("go")
+			if err != nil || testing.Short() {
+				t.Skip("not vetting the generated code without the go command")
+			}
+			mod := "module generated\n\ngo 1.18\n\n" +
+				"require " + DefaultRuntime + " v0.0.0\n\n" +
+				"replace " + DefaultRuntime + " => " + filepath.ToSlash(runtime) + "\n"
+			err = os.WriteFile(filepath.Join(out, "go.mod"), []byte(mod), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = os.WriteFile(filepath.Join(out, "go.sum"), sums, 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cmd := exec.Command(gocmd, "vet", "./...")
+			cmd.Dir = out
+			cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod")
+			if output, err := cmd.CombinedOutput(); err != nil {
+				t.Errorf("go vet failed: %s\n%s", err, output)
+			}
+		})
+	}
+}
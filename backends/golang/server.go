@@ -0,0 +1,132 @@
+package golang
+
+import (
+	"fmt"
+	"lugmac/typechecking"
+)
+
+func (g GoBackend) GenerateServer(mod *typechecking.Module, opts Options, in *typechecking.Context) (string, error) {
+	build := newGoFile(mod, opts)
+
+	lugma := build.runtime()
+
+	for _, stream := range mod.Streams {
+		name := stream.ObjectName() + "ServerStream"
+
+		build.docComment(name, summaryOf(stream.Documentation), stream.Annotations)
+		build.AddI("type %s[T any] struct {", name)
+		build.Add("%s.ServerStream[T]", lugma)
+		build.AddD("}")
+		build.AddNL()
+
+		for _, sig := range stream.Signals {
+			params, err := build.parameters(sig.Arguments)
+			if err != nil {
+				return "", err
+			}
+
+			build.use("encoding/json")
+			build.docComment("On"+exported(sig.ObjectName()), summaryOf(sig.Documentation), sig.Annotations)
+			build.AddI("func (s %s[T]) On%s(callback func(%s)) int {", name, exported(sig.ObjectName()), params)
+			build.AddI(`return s.ServerStream.On("%s", func(content json.RawMessage) {`, sig.ObjectName())
+			build.AddE("var args ")
+			err = build.payload(sig.Arguments)
+			if err != nil {
+				return "", err
+			}
+			build.AddNL()
+			build.AddI("if err := json.Unmarshal(content, &args); err != nil {")
+			build.Add("return")
+			build.AddD("}")
+			build.fillDefaults("args", "content", sig.Arguments, "return")
+			build.Add("callback(%s)", argsOf(sig.Arguments))
+			build.AddD("})")
+			build.AddD("}")
+			build.AddNL()
+		}
+		for _, ev := range stream.Events {
+			params, err := build.parameters(ev.Arguments)
+			if err != nil {
+				return "", err
+			}
+
+			build.docComment(exported(ev.ObjectName()), summaryOf(ev.Documentation), ev.Annotations)
+			build.AddI("func (s %s[T]) %s(%s) error {", name, exported(ev.ObjectName()), params)
+			build.AddE(`return s.ServerStream.Send("%s", `, ev.ObjectName())
+			err = build.payloadValue(ev.Arguments)
+			if err != nil {
+				return "", err
+			}
+			build.AddK(")\n")
+			build.AddD("}")
+			build.AddNL()
+		}
+
+		build.Add("// Bind%sToTransport calls slot with every %s stream opened through transport.", stream.ObjectName(), stream.ObjectName())
+		build.AddI("func Bind%sToTransport[T any](transport %s.ServerTransport[T], slot func(stream %s[T])) {", stream.ObjectName(), lugma, name)
+		build.AddI(`transport.BindStream("%s", func(stream %s.ServerStream[T]) {`, stream.Path().String(), lugma)
+		build.Add("slot(%s[T]{stream})", name)
+		build.AddD("})")
+		build.AddD("}")
+		build.AddNL()
+	}
+
+	build.Add("// Server implements the functions of %s.", mod.Name)
+	build.AddI("type Server[T any] interface {")
+	for _, fn := range mod.Funcs {
+		params, err := build.parameters(fn.Arguments)
+		if err != nil {
+			return "", err
+		}
+		if params != "" {
+			params += ", "
+		}
+
+		build.use("context")
+		build.docComment(exported(fn.ObjectName()), summaryOf(fn.Documentation), fn.Annotations)
+		if fn.Returns != nil {
+			ret, err := build.GoTypeOf(fn.Returns)
+			if err != nil {
+				return "", err
+			}
+			build.Add("%s(ctx context.Context, %sextra T) (%s, error)", exported(fn.ObjectName()), params, ret)
+		} else {
+			build.Add("%s(ctx context.Context, %sextra T) error", exported(fn.ObjectName()), params)
+		}
+	}
+	build.AddD("}")
+	build.AddNL()
+
+	build.Add("// BindServerToTransport routes requests for the functions of %s to impl.", mod.Name)
+	build.AddI("func BindServerToTransport[T any](impl Server[T], transport %s.ServerTransport[T]) {", lugma)
+	for _, fn := range mod.Funcs {
+		build.use("encoding/json")
+		build.AddI(`transport.BindMethod("%s", func(ctx context.Context, content json.RawMessage, extra T) (interface{}, error) {`, fn.Path().String())
+		build.AddE("var args ")
+		err := build.payload(fn.Arguments)
+		if err != nil {
+			return "", err
+		}
+		build.AddNL()
+		build.AddI("if err := json.Unmarshal(content, &args); err != nil {")
+		build.Add("return nil, &%s.BadRequest{Err: err}", lugma)
+		build.AddD("}")
+		build.fillDefaults("args", "content", fn.Arguments, fmt.Sprintf("return nil, &%s.BadRequest{Err: err}", lugma))
+
+		call := exported(fn.ObjectName()) + "(ctx, "
+		if len(fn.Arguments) > 0 {
+			call += argsOf(fn.Arguments) + ", "
+		}
+		call += "extra)"
+
+		if fn.Returns != nil {
+			build.Add("return impl.%s", call)
+		} else {
+			build.Add("return nil, impl.%s", call)
+		}
+		build.AddD("})")
+	}
+	build.AddD("}")
+
+	return build.finish()
+}
@@ -0,0 +1,293 @@
+package golang
+
+import (
+	"lugmac/ast"
+	"lugmac/typechecking"
+	"strings"
+)
+
+func summaryOf(docs *ast.ItemDocumentation) string {
+	if docs == nil || docs.Summary == nil {
+		return ""
+	}
+	return string(docs.Summary.Text(docs.Source))
+}
+
+func (g GoBackend) GenerateTypes(mod *typechecking.Module, opts Options, in *typechecking.Context) (string, error) {
+	build := newGoFile(mod, opts)
+
+	for _, item := range mod.TypeAliases {
+		err := build.typeAlias(item)
+		if err != nil {
+			return "", err
+		}
+	}
+	for _, item := range mod.Structs {
+		build.docComment(item.ObjectName(), summaryOf(item.Documentation), item.Annotations)
+		build.AddI("type %s struct {", item.ObjectName())
+		for _, field := range item.Fields {
+			typ, err := build.GoTypeOf(field.Type)
+			if err != nil {
+				return "", err
+			}
+			tag := field.ObjectName()
+			if _, ok := typechecking.Resolve(field.Type).(typechecking.OptionalType); ok {
+				tag += ",omitempty"
+			}
+			build.docComment(exported(field.ObjectName()), summaryOf(field.Documentation), field.Annotations)
+			build.Add("%s %s `json:\"%s\"`", exported(field.ObjectName()), typ, tag)
+		}
+		build.AddD("}")
+		build.AddNL()
+
+		build.defaultsUnmarshaler(item.ObjectName(), item.Fields)
+	}
+	for _, item := range mod.Enums {
+		var err error
+		if item.Simple() {
+			err = build.simpleEnum(item)
+		} else {
+			err = build.enum(item)
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	for _, item := range mod.Flagsets {
+		build.flagset(item)
+	}
+
+	return build.finish()
+}
+
+func (build *goFile) typeAlias(item *typechecking.TypeAlias) error {
+	name := item.ObjectName()
+
+	typ, err := build.GoTypeOf(item.Underlying)
+	if err != nil {
+		return err
+	}
+
+	build.docComment(name, summaryOf(item.Documentation), item.Annotations)
+
+	// methods can't be declared on pointer types, so optional newtypes have
+	// to be plain aliases
+	_, optional := typechecking.Resolve(item.Underlying).(typechecking.OptionalType)
+	if !item.Newtype || optional {
+		build.Add("type %s = %s", name, typ)
+		build.AddNL()
+		return nil
+	}
+
+	build.Add("type %s %s", name, typ)
+	build.AddNL()
+
+	// a defined type doesn't have the methods of its underlying type, so it
+	// has to be encoded as that type to look the same on the wire
+	switch typechecking.Resolve(item.Underlying) {
+	case typechecking.Duration, typechecking.UUID:
+		// these are encoded as text, so that they can be dictionary keys
+		build.AddI("func (t %s) MarshalText() ([]byte, error) {", name)
+		build.Add("return %s(t).MarshalText()", typ)
+		build.AddD("}")
+		build.AddNL()
+
+		build.AddI("func (t *%s) UnmarshalText(data []byte) error {", name)
+		build.Add("return (*%s)(t).UnmarshalText(data)", typ)
+		build.AddD("}")
+		build.AddNL()
+	default:
+		build.use("encoding/json")
+
+		build.AddI("func (t %s) MarshalJSON() ([]byte, error) {", name)
+		build.Add("return json.Marshal(%s(t))", typ)
+		build.AddD("}")
+		build.AddNL()
+
+		build.AddI("func (t *%s) UnmarshalJSON(data []byte) error {", name)
+		build.Add("return json.Unmarshal(data, (*%s)(t))", typ)
+		build.AddD("}")
+		build.AddNL()
+	}
+
+	return nil
+}
+
+func (build *goFile) simpleEnum(item *typechecking.Enum) error {
+	name := item.ObjectName()
+
+	build.use("encoding/json")
+	build.use("fmt")
+
+	build.docComment(name, summaryOf(item.Documentation), item.Annotations)
+	build.Add("type %s string", name)
+	build.AddNL()
+
+	build.AddI("const (")
+	for _, esac := range item.Cases {
+		build.docComment(name+exported(esac.ObjectName()), summaryOf(esac.Documentation), esac.Annotations)
+		build.Add(`%s%s %s = "%s"`, name, exported(esac.ObjectName()), name, esac.ObjectName())
+	}
+	build.AddD(")")
+	build.AddNL()
+
+	build.AddI("func (e *%s) UnmarshalJSON(data []byte) error {", name)
+	build.Add("var s string")
+	build.AddI("if err := json.Unmarshal(data, &s); err != nil {")
+	build.Add("return err")
+	build.AddD("}")
+	build.AddI("switch %s(s) {", name)
+	var cases []string
+	for _, esac := range item.Cases {
+		cases = append(cases, name+exported(esac.ObjectName()))
+	}
+	build.Add("case %s:", strings.Join(cases, ", "))
+	build.Einzug++
+	build.Add("*e = %s(s)", name)
+	build.Add("return nil")
+	build.AddD("default:")
+	build.Einzug++
+	build.Add(`return fmt.Errorf("%s: unknown case %%q", s)`, name)
+	build.Einzug--
+	build.AddD("}")
+	build.AddD("}")
+	build.AddNL()
+
+	return nil
+}
+
+func (build *goFile) enum(item *typechecking.Enum) error {
+	name := item.ObjectName()
+
+	build.use("encoding/json")
+	build.use("fmt")
+
+	build.docComment(name, summaryOf(item.Documentation), item.Annotations)
+	build.AddI("type %s struct {", name)
+	build.Add("Case %sCase", name)
+	build.AddD("}")
+	build.AddNL()
+
+	build.Add("// %sCase is implemented by every case of %s.", name, name)
+	build.AddI("type %sCase interface {", name)
+	build.Add("is%s()", name)
+	build.AddD("}")
+	build.AddNL()
+
+	for _, esac := range item.Cases {
+		caseName := name + exported(esac.ObjectName())
+
+		build.docComment(caseName, summaryOf(esac.Documentation), esac.Annotations)
+		build.AddE("type %s ", caseName)
+		err := build.payload(esac.Fields)
+		if err != nil {
+			return err
+		}
+		build.AddNL()
+		build.AddNL()
+		build.Add("func (%s) is%s() {}", caseName, name)
+		build.AddNL()
+
+		build.defaultsUnmarshaler(caseName, esac.Fields)
+	}
+
+	build.AddI("func (e %s) MarshalJSON() ([]byte, error) {", name)
+	build.AddI("switch c := e.Case.(type) {")
+	for _, esac := range item.Cases {
+		caseName := name + exported(esac.ObjectName())
+
+		build.AddD("case %s:", caseName)
+		build.Einzug++
+		build.Add(`return json.Marshal(map[string]%s{"%s": c})`, caseName, esac.ObjectName())
+	}
+	build.AddD("default:")
+	build.Einzug++
+	build.Add(`return nil, fmt.Errorf("%s: unknown case %%T", e.Case)`, name)
+	build.Einzug--
+	build.Add("}")
+	build.AddD("}")
+	build.AddNL()
+
+	build.AddI("func (e *%s) UnmarshalJSON(data []byte) error {", name)
+	build.Add("var raw map[string]json.RawMessage")
+	build.AddI("if err := json.Unmarshal(data, &raw); err != nil {")
+	build.Add("return err")
+	build.AddD("}")
+	build.AddI("if len(raw) != 1 {")
+	build.Add(`return fmt.Errorf("%s: expected exactly one case, got %%d", len(raw))`, name)
+	build.AddD("}")
+	build.AddI("for kind, content := range raw {")
+	build.AddI("switch kind {")
+	for _, esac := range item.Cases {
+		caseName := name + exported(esac.ObjectName())
+
+		build.AddD(`case "%s":`, esac.ObjectName())
+		build.Einzug++
+		build.Add("var c %s", caseName)
+		build.AddI("if err := json.Unmarshal(content, &c); err != nil {")
+		build.Add("return err")
+		build.AddD("}")
+		build.Add("e.Case = c")
+	}
+	build.AddD("default:")
+	build.Einzug++
+	build.Add(`return fmt.Errorf("%s: unknown case %%q", kind)`, name)
+	build.Einzug--
+	build.Add("}")
+	build.AddD("}")
+	build.Add("return nil")
+	build.AddD("}")
+	build.AddNL()
+
+	return nil
+}
+
+func (build *goFile) flagset(item *typechecking.Flagset) {
+	name := item.ObjectName()
+
+	build.use("encoding/json")
+	build.use("strconv")
+
+	build.docComment(name, summaryOf(item.Documentation), item.Annotations)
+	build.Add("type %s uint64", name)
+	build.AddNL()
+
+	if len(item.Flags) > 0 {
+		build.AddI("const (")
+		for idx, flag := range item.Flags {
+			build.docComment(name+exported(flag.ObjectName()), summaryOf(flag.Documentation), flag.Annotations)
+			if idx == 0 {
+				build.Add("%s%s %s = 1 << iota", name, exported(flag.ObjectName()), name)
+			} else {
+				build.Add("%s%s", name, exported(flag.ObjectName()))
+			}
+		}
+		build.AddD(")")
+		build.AddNL()
+	}
+
+	build.Add("// Has reports whether every flag in flags is set.")
+	build.AddI("func (f %s) Has(flags %s) bool {", name, name)
+	build.Add("return f&flags == flags")
+	build.AddD("}")
+	build.AddNL()
+
+	build.AddI("func (f %s) MarshalJSON() ([]byte, error) {", name)
+	build.Add("return json.Marshal(strconv.FormatUint(uint64(f), 10))")
+	build.AddD("}")
+	build.AddNL()
+
+	build.AddI("func (f *%s) UnmarshalJSON(data []byte) error {", name)
+	build.Add("var s string")
+	build.AddI("if err := json.Unmarshal(data, &s); err != nil {")
+	build.Add("return err")
+	build.AddD("}")
+	build.Add("v, err := strconv.ParseUint(s, 10, 64)")
+	build.AddI("if err != nil {")
+	build.Add("return err")
+	build.AddD("}")
+	build.Add("*f = %s(v)", name)
+	build.Add("return nil")
+	build.AddD("}")
+	build.AddNL()
+}
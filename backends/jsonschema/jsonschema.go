@@ -0,0 +1,117 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"io/fs"
+	"io/ioutil"
+	"lugmac/backends"
+	"lugmac/modules"
+	"lugmac/typechecking"
+	"os"
+	"path"
+
+	"github.com/urfave/cli/v2"
+)
+
+// JSONSchemaBackend describes the types declared in modules, as they're sent
+// in JSON, with a JSON Schema (draft 2020-12) per module. Each declaration is
+// defined in the schema's $defs, and declarations from other modules are
+// referred to in their modules' schemas, which are expected to sit alongside.
+type JSONSchemaBackend struct {
+}
+
+var _ backends.Backend = JSONSchemaBackend{}
+
+func init() {
+	backends.RegisterBackend(JSONSchemaBackend{})
+}
+
+func (js JSONSchemaBackend) GenerateCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "jsonschema",
+		Usage: "Generate JSON Schemas describing the types of Lugma modules",
+		Flags: backends.StandardFlags,
+		Action: func(cCtx *cli.Context) error {
+			w, err := modules.LoadWorkspaceFrom(cCtx.String("workspace"))
+			if err != nil {
+				return err
+			}
+			err = w.GenerateModules()
+			if err != nil {
+				return err
+			}
+
+			outdir := cCtx.String("outdir")
+			err = os.MkdirAll(path.Join(outdir), 0750)
+			if err != nil {
+				return err
+			}
+
+			generated, err := backends.GeneratedModules(w)
+			if err != nil {
+				return err
+			}
+			for _, mod := range generated.All() {
+				result, err := js.GenerateSchema(mod, w.Context)
+				if err != nil {
+					return err
+				}
+
+				err = ioutil.WriteFile(path.Join(outdir, fileName(mod)), result, fs.ModePerm)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func fileName(mod *typechecking.Module) string {
+	return mod.Name + ".schema.json"
+}
+
+// GenerateSchema generates the JSON Schema of the types declared in mod.
+func (js JSONSchemaBackend) GenerateSchema(mod *typechecking.Module, in *typechecking.Context) ([]byte, error) {
+	schemas := backends.NewSchemas(func(typ typechecking.Type) string {
+		from := typ.Parent().(*typechecking.Module)
+		if from.Path().ModulePath == mod.Path().ModulePath {
+			return "#/$defs/" + typ.ObjectName()
+		}
+		return fileName(from) + "#/$defs/" + typ.ObjectName()
+	})
+
+	defs := backends.Schema{}
+	define := func(typ typechecking.Type) {
+		defs[typ.ObjectName()] = schemas.Declaration(typ)
+	}
+	for _, item := range mod.TypeAliases {
+		define(item)
+	}
+	for _, item := range mod.Structs {
+		define(item)
+	}
+	for _, item := range mod.Enums {
+		define(item)
+	}
+	for _, item := range mod.Flagsets {
+		define(item)
+	}
+
+	document := backends.Schema{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"$id":     fileName(mod),
+		"title":   mod.Name,
+		"$defs":   defs,
+	}
+	if description := backends.Description(mod.Documentation); description != "" {
+		document["description"] = description
+	}
+
+	data, err := json.MarshalIndent(document, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
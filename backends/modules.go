@@ -0,0 +1,93 @@
+package backends
+
+import (
+	"fmt"
+	"lugmac/modules"
+	"lugmac/typechecking"
+	"sort"
+)
+
+// Generated is what code is generated for from a workspace.
+type Generated struct {
+	// Products are the workspace's own modules, in the order its lugma.yaml
+	// lists them.
+	Products []*typechecking.Module
+	// Dependencies are the modules of the workspaces it depends on that the
+	// products import, directly or not, which the code generated for them
+	// refers to alongside it.
+	Dependencies []*typechecking.Module
+}
+
+// All returns the products followed by the dependencies.
+func (g *Generated) All() []*typechecking.Module {
+	ret := make([]*typechecking.Module, 0, len(g.Products)+len(g.Dependencies))
+	ret = append(ret, g.Products...)
+	return append(ret, g.Dependencies...)
+}
+
+// GeneratedModules returns what code is generated for from w, which must have
+// been checked. Generated code tells modules apart by their names alone, so
+// it's an error for two of them to have the same name.
+func GeneratedModules(w *modules.Workspace) (*Generated, error) {
+	g := &Generated{}
+	seen := map[string]*typechecking.Module{}
+
+	add := func(mod *typechecking.Module) (bool, error) {
+		if other, ok := seen[mod.Name]; ok {
+			if other.Path() == mod.Path() {
+				return false, nil
+			}
+			return false, fmt.Errorf("%s and %s are both named %s, so code can't be generated for them together; rename one of them", other.Path(), mod.Path(), mod.Name)
+		}
+		seen[mod.Name] = mod
+		return true, nil
+	}
+
+	for _, prod := range w.Module.Products {
+		mod, ok := w.KnownModules[prod.Name]
+		if !ok {
+			continue
+		}
+		if _, err := add(mod); err != nil {
+			return nil, err
+		}
+		g.Products = append(g.Products, mod)
+	}
+
+	var visit func(mod *typechecking.Module) error
+	visit = func(mod *typechecking.Module) error {
+		names := make([]string, 0, len(mod.Imports))
+		for name := range mod.Imports {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			imported := mod.Imports[name]
+			if imported.InWorkspace == w.Workspace {
+				continue
+			}
+			added, err := add(imported)
+			if err != nil {
+				return err
+			}
+			if !added {
+				continue
+			}
+			g.Dependencies = append(g.Dependencies, imported)
+			err = visit(imported)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, mod := range g.Products {
+		err := visit(mod)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
@@ -0,0 +1,117 @@
+package backends
+
+import (
+	"lugmac/modules"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeFiles writes files, keyed by their paths relative to dir.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		file := filepath.Join(dir, name)
+		err := os.MkdirAll(filepath.Dir(file), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(file, []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+var library = map[string]string{
+	"lib/lugma.yaml": `name: Lib
+version: 1.0.0
+products:
+  - type: module
+    name: Base
+  - type: module
+    name: L
+    depends: [Base]
+`,
+	"lib/Sources/Base/Base.lugma": "struct ID {\n    let raw: String\n}\n",
+	"lib/Sources/L/L.lugma":       "import \"Base\" as Base\nstruct Thing {\n    let id: Base.ID\n}\n",
+}
+
+func TestGeneratedModules(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, library)
+	writeFiles(t, dir, map[string]string{
+		"app/lugma.yaml": `name: App
+version: 1.0.0
+dependencies:
+  - name: Lib
+    path: ../lib
+products:
+  - type: module
+    name: A
+    depends: [Lib/L]
+`,
+		"app/Sources/A/A.lugma": "import \"Lib/L\" as L\nfunc get() -> L.Thing\n",
+	})
+
+	w, err := modules.LoadWorkspaceFrom(filepath.Join(dir, "app"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.GenerateModules()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generated, err := GeneratedModules(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, mod := range generated.All() {
+		got = append(got, mod.Path().String())
+	}
+	want := []string{"App/A", "Lib/L", "Lib/Base"}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if len(generated.Products) != 1 {
+		t.Errorf("expected only App/A to be a product, got %v", generated.Products)
+	}
+}
+
+func TestGeneratedModulesClash(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, library)
+	writeFiles(t, dir, map[string]string{
+		"app/lugma.yaml": `name: App
+version: 1.0.0
+dependencies:
+  - name: Lib
+    path: ../lib
+products:
+  - type: module
+    name: Base
+  - type: module
+    name: A
+    depends: [Lib/L]
+`,
+		"app/Sources/Base/Base.lugma": "struct Other {\n    let a: String\n}\n",
+		"app/Sources/A/A.lugma":       "import \"Lib/L\" as L\nfunc get() -> L.Thing\n",
+	})
+
+	w, err := modules.LoadWorkspaceFrom(filepath.Join(dir, "app"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.GenerateModules()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = GeneratedModules(w)
+	if err == nil || !strings.Contains(err.Error(), "App/Base and Lib/Base are both named Base") {
+		t.Errorf("expected the modules named Base to clash, got %v", err)
+	}
+}
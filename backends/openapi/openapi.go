@@ -0,0 +1,176 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"io/ioutil"
+	"lugmac/backends"
+	"lugmac/modules"
+	"lugmac/typechecking"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+)
+
+// OpenAPIBackend describes the functions of modules, which are served as
+// POST endpoints taking their arguments as an object, as OpenAPI 3.1
+// documents.
+type OpenAPIBackend struct {
+}
+
+var _ backends.Backend = OpenAPIBackend{}
+
+func init() {
+	backends.RegisterBackend(OpenAPIBackend{})
+}
+
+func (oa OpenAPIBackend) GenerateCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "openapi",
+		Usage: "Generate OpenAPI documents describing the functions of Lugma modules",
+		Flags: backends.StandardFlags,
+		Action: func(cCtx *cli.Context) error {
+			w, err := modules.LoadWorkspaceFrom(cCtx.String("workspace"))
+			if err != nil {
+				return err
+			}
+			err = w.GenerateModules()
+			if err != nil {
+				return err
+			}
+
+			outdir := cCtx.String("outdir")
+			err = os.MkdirAll(path.Join(outdir), 0750)
+			if err != nil {
+				return err
+			}
+
+			for _, prod := range w.Module.Products {
+				mod := w.KnownModules[prod.Name]
+
+				result, err := oa.GenerateDocument(mod, w.Module.Version, w.Context)
+				if err != nil {
+					return err
+				}
+
+				err = ioutil.WriteFile(path.Join(outdir, mod.Name+".openapi.json"), result, fs.ModePerm)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func jsonContent(schema backends.Schema) backends.Schema {
+	return backends.Schema{"application/json": backends.Schema{"schema": schema}}
+}
+
+// GenerateDocument generates the OpenAPI document of mod, whose workspace is
+// at version.
+func (oa OpenAPIBackend) GenerateDocument(mod *typechecking.Module, version string, in *typechecking.Context) ([]byte, error) {
+	schemas := backends.NewSchemas(func(typ typechecking.Type) string {
+		return "#/components/schemas/" + backends.ComponentName(typ, mod)
+	})
+
+	paths := backends.Schema{}
+	for _, fn := range mod.Funcs {
+		operation := backends.Schema{"operationId": fn.ObjectName()}
+		if docs := fn.Documentation; docs != nil {
+			if summary := backends.DocText(docs.Summary, docs.Source); summary != "" {
+				operation["summary"] = summary
+			}
+			var discussion []string
+			for _, node := range docs.Discussion {
+				if text := backends.DocText(node, docs.Source); text != "" {
+					discussion = append(discussion, text)
+				}
+			}
+			if len(discussion) > 0 {
+				operation["description"] = strings.Join(discussion, "\n\n")
+			}
+		}
+
+		arguments := schemas.Fields(fn.Arguments)
+		if docs := fn.Documentation; docs != nil {
+			// arguments are documented in their function's documentation
+			// unless they have their own
+			properties := arguments["properties"].(backends.Properties)
+			for name, node := range docs.Parameters {
+				property := properties.Get(name)
+				if property == nil {
+					continue
+				}
+				if _, ok := property["description"]; !ok {
+					property["description"] = backends.DocText(node, docs.Source)
+				}
+			}
+		}
+		operation["requestBody"] = backends.Schema{
+			"required": true,
+			"content":  jsonContent(arguments),
+		}
+
+		ok := backends.Schema{"description": "The function returned."}
+		if fn.Returns != nil {
+			ok["content"] = jsonContent(schemas.Of(fn.Returns))
+		} else {
+			ok["description"] = "The function returned nothing."
+		}
+		if docs := fn.Documentation; docs != nil {
+			if text := backends.DocText(docs.Returns, docs.Source); text != "" {
+				ok["description"] = text
+			}
+		}
+		// every function answers requests whose content isn't valid with a
+		// bad request, which is told apart from what it throws by its error
+		badRequest := backends.Schema{"$ref": "#/components/schemas/" + backends.BadRequestName}
+		failed := backends.Schema{
+			"description": "The request's content isn't valid.",
+			"content":     jsonContent(badRequest),
+		}
+		if fn.Throws != nil {
+			failed["description"] = "The function threw, or the request's content isn't valid."
+			if docs := fn.Documentation; docs != nil {
+				if text := backends.DocText(docs.Throws, docs.Source); text != "" {
+					failed["description"] = text
+				}
+			}
+			failed["content"] = jsonContent(backends.Schema{
+				"oneOf": []backends.Schema{schemas.Of(fn.Throws), badRequest},
+			})
+		}
+		operation["responses"] = backends.Schema{"200": ok, "400": failed}
+
+		paths["/"+fn.Path().String()] = backends.Schema{"post": operation}
+	}
+
+	components := schemas.Components(mod)
+	if _, ok := components[backends.BadRequestName]; ok {
+		return nil, fmt.Errorf("%s: the schema of bad requests would have the same name as the one for %s", mod.Name, backends.BadRequestName)
+	}
+	components[backends.BadRequestName] = backends.BadRequest()
+
+	document := backends.Schema{
+		"openapi": "3.1.0",
+		"info": backends.Schema{
+			"title":   mod.Name,
+			"version": version,
+		},
+		"paths": paths,
+		"components": backends.Schema{
+			"schemas": components,
+		},
+	}
+
+	data, err := json.MarshalIndent(document, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
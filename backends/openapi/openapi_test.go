@@ -0,0 +1,81 @@
+package openapi
+
+import (
+	"flag"
+	"lugmac/ast"
+	"lugmac/typechecking"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files with what's generated now")
+
+// checked checks source as the module named name of a workspace named Test.
+func checked(t *testing.T, name, source string) (*typechecking.Module, *typechecking.Context) {
+	t.Helper()
+	tree, err := ast.Parse([]byte(source))
+	if err != nil {
+		t.Fatalf("failed to parse:\n%s", err)
+	}
+	tree.Path = name + ".lugma"
+	ctx := typechecking.NewContext(typechecking.FileImportResolver)
+	m, err := ctx.Module(tree, "Test/"+name)
+	if err != nil {
+		t.Fatalf("failed to check:\n%s", err)
+	}
+	return m, ctx
+}
+
+// TestGolden generates the OpenAPI document for every testdata/*.lugma and
+// compares it with the .openapi.json next to it.
+func TestGolden(t *testing.T) {
+	inputs, err := filepath.Glob(filepath.Join("testdata", "*.lugma"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inputs) == 0 {
+		t.Fatal("no golden tests in testdata")
+	}
+
+	for _, input := range inputs {
+		name := strings.TrimSuffix(filepath.Base(input), ".lugma")
+		golden := filepath.Join("testdata", name+".openapi.json")
+
+		t.Run(name, func(t *testing.T) {
+			source, err := os.ReadFile(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			m, ctx := checked(t, name, string(source))
+			got, err := OpenAPIBackend{}.GenerateDocument(m, "1.0.0", ctx)
+			if err != nil {
+				t.Fatalf("failed to generate: %s", err)
+			}
+
+			if *update {
+				err = os.WriteFile(golden, got, 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("generated differently from %s:\n%s", golden, got)
+			}
+		})
+	}
+}
+
+func TestBadRequestClash(t *testing.T) {
+	m, ctx := checked(t, "M", "struct BadRequest {\n let reason: String\n}")
+	_, err := OpenAPIBackend{}.GenerateDocument(m, "1.0.0", ctx)
+	want := "M: the schema of bad requests would have the same name as the one for BadRequest"
+	if err == nil || err.Error() != want {
+		t.Errorf("got %v, want %q", err, want)
+	}
+}
@@ -0,0 +1,612 @@
+package protobuf
+
+import (
+	"fmt"
+	"io/fs"
+	"io/ioutil"
+	"lugmac/ast"
+	"lugmac/backends"
+	"lugmac/modules"
+	"lugmac/typechecking"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/urfave/cli/v2"
+)
+
+// ProtobufBackend converts modules to proto3 files for gRPC. Fields,
+// arguments and cases are numbered by their tags if they have them, and
+// otherwise in order with the lowest numbers that aren't tagged or reserved,
+// so they should be tagged if their numbers have to stay the same.
+//
+// Protobuf can't express everything Lugma can, so:
+//
+//   - type aliases are replaced by the types they stand for
+//   - UUIDs are strings, as they are in JSON
+//   - enums with fields are messages with a oneof of messages of their cases
+//   - flagsets are messages with the bitmask of their flags, and an enum of
+//     the bit each flag is
+//   - arrays and dictionaries in arrays, dictionaries or optionals are
+//     wrapped in messages, as are optionals in arrays and dictionaries
+//   - dictionary keys that aren't integers, strings or booleans are sent as
+//     they would be in JSON
+//   - constants and defaults are left out
+type ProtobufBackend struct {
+}
+
+var _ backends.Backend = ProtobufBackend{}
+
+func init() {
+	backends.RegisterBackend(ProtobufBackend{})
+}
+
+var notIdent = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// Package is the protobuf package of a module.
+func Package(mod *typechecking.Module) string {
+	var parts []string
+	for _, part := range strings.Split(mod.Path().ModulePath, "/") {
+		parts = append(parts, notIdent.ReplaceAllString(strings.ToLower(part), "_"))
+	}
+	return strings.Join(parts, ".")
+}
+
+// FileName is the name of the file generated for a module.
+func FileName(mod *typechecking.Module) string {
+	return mod.Name + ".proto"
+}
+
+func (pb ProtobufBackend) GenerateCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "proto",
+		Aliases: []string{"protobuf"},
+		Usage:   "Generate protobuf files for gRPC from Lugma",
+		Flags:   backends.StandardFlags,
+		Action: func(cCtx *cli.Context) error {
+			w, err := modules.LoadWorkspaceFrom(cCtx.String("workspace"))
+			if err != nil {
+				return err
+			}
+			err = w.GenerateModules()
+			if err != nil {
+				return err
+			}
+
+			outdir := cCtx.String("outdir")
+			err = os.MkdirAll(path.Join(outdir), 0750)
+			if err != nil {
+				return err
+			}
+
+			generated, err := backends.GeneratedModules(w)
+			if err != nil {
+				return err
+			}
+			for _, mod := range generated.All() {
+				result, err := pb.GenerateFile(mod, w.Context)
+				if err != nil {
+					return err
+				}
+
+				err = ioutil.WriteFile(path.Join(outdir, FileName(mod)), []byte(result), fs.ModePerm)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// file is a proto file being generated, keeping track of what it imports and
+// the wrapper messages its types need.
+type file struct {
+	mod *typechecking.Module
+
+	imports  map[string]struct{}
+	wrappers map[string]string
+	// names maps the names of the file's messages and enums to what they're
+	// generated for.
+	names map[string]string
+	// nested is whether messages are being generated inside another, where
+	// their names could hide the module's types.
+	nested bool
+}
+
+func (f *file) claim(name, what string) error {
+	if previous, ok := f.names[name]; ok {
+		return fmt.Errorf("%s: the message generated for %s would have the same name as the one for %s", f.mod.Name, what, previous)
+	}
+	f.names[name] = what
+	return nil
+}
+
+// nameOf returns the name used for typ in the names of wrapper messages.
+func (f *file) nameOf(typ typechecking.Type) string {
+	switch k := typ.(type) {
+	case typechecking.PrimitiveType:
+		return k.String()
+	case typechecking.ArrayType:
+		return f.nameOf(k.Element) + "List"
+	case typechecking.DictionaryType:
+		return f.nameOf(k.Key) + "To" + f.nameOf(k.Element) + "Map"
+	case typechecking.OptionalType:
+		return "Optional" + f.nameOf(k.Element)
+	default:
+		if k.Path().ModulePath == f.mod.Path().ModulePath {
+			return k.ObjectName()
+		}
+		return strcase.ToCamel(k.Parent().(*typechecking.Module).Name) + k.ObjectName()
+	}
+}
+
+// wrap returns the name of a message with a single field of typ, which is an
+// array, dictionary or optional.
+func (f *file) wrap(typ typechecking.Type) string {
+	name := f.nameOf(typ)
+	if _, ok := f.wrappers[name]; ok {
+		return name
+	}
+	// the wrapper is reserved before its field is generated, since that can
+	// add more wrappers
+	f.wrappers[name] = ""
+
+	field := "value"
+	switch typ.(type) {
+	case typechecking.ArrayType:
+		field = "items"
+	case typechecking.DictionaryType:
+		field = "entries"
+	}
+	f.wrappers[name] = fmt.Sprintf("message %s {\n\t%s %s = 1;\n}\n", name, f.fieldType(typ), field)
+	return name
+}
+
+// messageType returns the type of a value of typ in a field that holds one,
+// such as in a repeated field or a map.
+func (f *file) messageType(typ typechecking.Type) string {
+	switch k := typ.(type) {
+	case typechecking.PrimitiveType:
+		switch k {
+		case typechecking.UInt8, typechecking.UInt16, typechecking.UInt32:
+			return "uint32"
+		case typechecking.Int8, typechecking.Int16, typechecking.Int32:
+			return "int32"
+		case typechecking.UInt64:
+			return "uint64"
+		case typechecking.Int64:
+			return "int64"
+		case typechecking.Float32:
+			return "float"
+		case typechecking.Float64:
+			return "double"
+		case typechecking.String, typechecking.UUID:
+			return "string"
+		case typechecking.Bytes:
+			return "bytes"
+		case typechecking.Bool:
+			return "bool"
+		case typechecking.Timestamp:
+			f.imports["google/protobuf/timestamp.proto"] = struct{}{}
+			return "google.protobuf.Timestamp"
+		case typechecking.Duration:
+			f.imports["google/protobuf/duration.proto"] = struct{}{}
+			return "google.protobuf.Duration"
+		default:
+			panic("unhandled primitive " + k.String())
+		}
+	case *typechecking.TypeAlias:
+		return f.messageType(k.Underlying)
+	case typechecking.ArrayType, typechecking.DictionaryType, typechecking.OptionalType:
+		return f.wrap(k)
+	case *typechecking.Struct, *typechecking.Enum, *typechecking.Flagset:
+		if k.Path().ModulePath == f.mod.Path().ModulePath {
+			if f.nested {
+				return fmt.Sprintf(".%s.%s", Package(f.mod), k.ObjectName())
+			}
+			return k.ObjectName()
+		}
+		from := k.Parent().(*typechecking.Module)
+		f.imports[FileName(from)] = struct{}{}
+		return fmt.Sprintf(".%s.%s", Package(from), k.ObjectName())
+	default:
+		panic("unhandled " + k.String())
+	}
+}
+
+// keyType returns the type of a map key of typ.
+func (f *file) keyType(typ typechecking.Type) string {
+	switch k := typechecking.Resolve(typ).(type) {
+	case typechecking.PrimitiveType:
+		if k == typechecking.Bytes {
+			// bytes can't be keys, so they're sent as base64
+			return "string"
+		}
+		return f.messageType(k)
+	default:
+		return "string"
+	}
+}
+
+// fieldType returns the label and type of a field of typ.
+func (f *file) fieldType(typ typechecking.Type) string {
+	switch k := typ.(type) {
+	case *typechecking.TypeAlias:
+		return f.fieldType(k.Underlying)
+	case typechecking.ArrayType:
+		return "repeated " + f.messageType(k.Element)
+	case typechecking.DictionaryType:
+		return fmt.Sprintf("map<%s, %s>", f.keyType(k.Key), f.messageType(k.Element))
+	case typechecking.OptionalType:
+		return "optional " + f.messageType(k.Element)
+	default:
+		return f.messageType(k)
+	}
+}
+
+// comment adds docs as a comment.
+func comment(build *backends.Filebuilder, docs *ast.ItemDocumentation) {
+	if docs == nil || docs.Summary == nil {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(docs.Summary.Text(docs.Source))), "\n") {
+		build.Add("// %s", line)
+	}
+}
+
+// numbered is something that's given a field number.
+type numbered struct {
+	name string
+	tag  int
+}
+
+// firstReserved and lastReserved are the field numbers protobuf keeps for
+// itself.
+const (
+	firstReserved = 19000
+	lastReserved  = 19999
+)
+
+// numberOf returns the number of each of items, which are given their tags
+// if they have them, and otherwise the lowest numbers not used by others or
+// reserved.
+func numberOf(items []numbered, reserved []int, what string) ([]int, error) {
+	used := map[int]struct{}{}
+	for _, tag := range reserved {
+		used[tag] = struct{}{}
+	}
+	for _, item := range items {
+		if item.tag >= firstReserved && item.tag <= lastReserved {
+			return nil, fmt.Errorf("%s of %s is tagged %d, which protobuf doesn't allow, since it reserves %d to %d", item.name, what, item.tag, firstReserved, lastReserved)
+		}
+		used[item.tag] = struct{}{}
+	}
+
+	next := 1
+	var numbers []int
+	for _, item := range items {
+		if item.tag != 0 {
+			numbers = append(numbers, item.tag)
+			continue
+		}
+		for {
+			if next == firstReserved {
+				next = lastReserved + 1
+			}
+			if _, ok := used[next]; !ok {
+				break
+			}
+			next++
+		}
+		used[next] = struct{}{}
+		numbers = append(numbers, next)
+	}
+	return numbers, nil
+}
+
+// reservedIn adds reserved statements for tags and names.
+func reservedIn(build *backends.Filebuilder, tags []int, names []string) {
+	if len(tags) > 0 {
+		sort.Ints(tags)
+		var items []string
+		for _, tag := range tags {
+			items = append(items, fmt.Sprint(tag))
+		}
+		build.Add("reserved %s;", strings.Join(items, ", "))
+	}
+	if len(names) > 0 {
+		var items []string
+		for _, name := range names {
+			items = append(items, fmt.Sprintf("%q", name))
+		}
+		build.Add("reserved %s;", strings.Join(items, ", "))
+	}
+}
+
+// message adds a message named name with fields, whose tags and names can
+// be reserved by annotations.
+func (f *file) message(build *backends.Filebuilder, name string, docs *ast.ItemDocumentation, fields []*typechecking.Field, annotations typechecking.Annotations, what string) error {
+	tags, names := typechecking.Reserved(annotations)
+	var items []numbered
+	for _, field := range fields {
+		items = append(items, numbered{field.ObjectName(), field.Tag})
+	}
+	numbers, err := numberOf(items, tags, what)
+	if err != nil {
+		return err
+	}
+
+	comment(build, docs)
+	if len(fields) == 0 && len(tags) == 0 && len(names) == 0 {
+		build.Add("message %s {}", name)
+		return nil
+	}
+	build.AddI("message %s {", name)
+	var snakeNames []string
+	for _, name := range names {
+		snakeNames = append(snakeNames, strcase.ToSnake(name))
+	}
+	reservedIn(build, tags, snakeNames)
+	for idx, field := range fields {
+		comment(build, field.Documentation)
+		build.Add("%s %s = %d;", f.fieldType(field.Type), strcase.ToSnake(field.ObjectName()), numbers[idx])
+	}
+	build.AddD("}")
+	return nil
+}
+
+// payload is an event or signal.
+type payload struct {
+	name        string
+	docs        *ast.ItemDocumentation
+	arguments   []*typechecking.Field
+	annotations typechecking.Annotations
+}
+
+// union adds a message named name with a message for the arguments of each
+// of items, and a oneof called kind of one of them.
+func (f *file) union(build *backends.Filebuilder, name, kind string, items []payload, stream string) error {
+	if len(items) == 0 {
+		build.Add("message %s {}", name)
+		return nil
+	}
+	build.AddI("message %s {", name)
+	f.nested = true
+	for _, item := range items {
+		err := f.message(build, strcase.ToCamel(item.name), item.docs, item.arguments, item.annotations, stream+"."+item.name)
+		if err != nil {
+			return err
+		}
+	}
+	f.nested = false
+	build.AddI("oneof %s {", kind)
+	for idx, item := range items {
+		build.Add("%s %s = %d;", strcase.ToCamel(item.name), strcase.ToSnake(item.name), idx+1)
+	}
+	build.AddD("}")
+	build.AddD("}")
+	return nil
+}
+
+// GenerateFile generates the proto file of a module.
+func (pb ProtobufBackend) GenerateFile(mod *typechecking.Module, in *typechecking.Context) (string, error) {
+	f := &file{
+		mod:      mod,
+		imports:  map[string]struct{}{},
+		wrappers: map[string]string{},
+		names:    map[string]string{},
+	}
+	body := backends.Filebuilder{}
+
+	for _, item := range mod.Structs {
+		if err := f.claim(item.ObjectName(), item.ObjectName()); err != nil {
+			return "", err
+		}
+		body.AddNL()
+		err := f.message(&body, item.ObjectName(), item.Documentation, item.Fields, item.Annotations, item.ObjectName())
+		if err != nil {
+			return "", err
+		}
+	}
+	for _, item := range mod.Enums {
+		if err := f.claim(item.ObjectName(), item.ObjectName()); err != nil {
+			return "", err
+		}
+		tags, names := typechecking.Reserved(item.Annotations)
+		var items []numbered
+		for _, esac := range item.Cases {
+			items = append(items, numbered{esac.ObjectName(), esac.Tag})
+		}
+		numbers, err := numberOf(items, tags, item.ObjectName())
+		if err != nil {
+			return "", err
+		}
+
+		body.AddNL()
+		if item.Simple() {
+			// enum values are scoped to the package, so they're prefixed
+			// with their enum's name
+			prefix := strcase.ToScreamingSnake(item.ObjectName()) + "_"
+			// protobuf needs a zero value, which no case can share the
+			// name of
+			unspecified := prefix + "UNSPECIFIED"
+			for _, esac := range item.Cases {
+				if prefix+strcase.ToScreamingSnake(esac.ObjectName()) == unspecified {
+					return "", fmt.Errorf("%s: case %s of %s would have the same name as the zero value %s that protobuf needs; rename the case", mod.Name, esac.ObjectName(), item.ObjectName(), unspecified)
+				}
+			}
+			comment(&body, item.Documentation)
+			body.AddI("enum %s {", item.ObjectName())
+			var prefixed []string
+			for _, name := range names {
+				if prefix+strcase.ToScreamingSnake(name) == unspecified {
+					return "", fmt.Errorf("%s: %s reserves the name %s, which the zero value %s that protobuf needs has", mod.Name, item.ObjectName(), name, unspecified)
+				}
+				prefixed = append(prefixed, prefix+strcase.ToScreamingSnake(name))
+			}
+			reservedIn(&body, tags, prefixed)
+			body.Add("%s = 0;", unspecified)
+			for idx, esac := range item.Cases {
+				comment(&body, esac.Documentation)
+				body.Add("%s%s = %d;", prefix, strcase.ToScreamingSnake(esac.ObjectName()), numbers[idx])
+			}
+			body.AddD("}")
+			continue
+		}
+
+		comment(&body, item.Documentation)
+		body.AddI("message %s {", item.ObjectName())
+		f.nested = true
+		for _, esac := range item.Cases {
+			err := f.message(&body, strcase.ToCamel(esac.ObjectName()), esac.Documentation, esac.Fields, esac.Annotations, item.ObjectName()+"."+esac.ObjectName())
+			if err != nil {
+				return "", err
+			}
+		}
+		f.nested = false
+		var snakeNames []string
+		for _, name := range names {
+			snakeNames = append(snakeNames, strcase.ToSnake(name))
+		}
+		reservedIn(&body, tags, snakeNames)
+		body.AddI("oneof value {")
+		for idx, esac := range item.Cases {
+			body.Add("%s %s = %d;", strcase.ToCamel(esac.ObjectName()), strcase.ToSnake(esac.ObjectName()), numbers[idx])
+		}
+		body.AddD("}")
+		body.AddD("}")
+	}
+	for _, item := range mod.Flagsets {
+		if err := f.claim(item.ObjectName(), item.ObjectName()); err != nil {
+			return "", err
+		}
+		body.AddNL()
+		comment(&body, item.Documentation)
+		body.AddI("message %s {", item.ObjectName())
+		if len(item.Flags) > 0 {
+			body.Add("// Flag is the bit of the bitmask each flag is.")
+			body.AddI("enum Flag {")
+			for idx, flag := range item.Flags {
+				comment(&body, flag.Documentation)
+				body.Add("%s = %d;", strcase.ToScreamingSnake(flag.ObjectName()), idx)
+			}
+			body.AddD("}")
+		}
+		body.Add("uint64 bits = 1;")
+		body.AddD("}")
+	}
+
+	for _, fn := range mod.Funcs {
+		name := strcase.ToCamel(fn.ObjectName())
+		if err := f.claim(name+"Request", "the arguments of "+fn.ObjectName()); err != nil {
+			return "", err
+		}
+		if err := f.claim(name+"Response", "the result of "+fn.ObjectName()); err != nil {
+			return "", err
+		}
+		body.AddNL()
+		err := f.message(&body, name+"Request", nil, fn.Arguments, fn.Annotations, fn.ObjectName())
+		if err != nil {
+			return "", err
+		}
+		body.AddNL()
+		switch {
+		case fn.Throws != nil:
+			body.AddI("message %sResponse {", name)
+			body.AddI("oneof result {")
+			if fn.Returns != nil {
+				body.Add("%s value = 1;", f.messageType(fn.Returns))
+			}
+			body.Add("%s error = 2;", f.messageType(fn.Throws))
+			body.AddD("}")
+			body.AddD("}")
+		case fn.Returns != nil:
+			body.AddI("message %sResponse {", name)
+			body.Add("%s value = 1;", f.fieldType(fn.Returns))
+			body.AddD("}")
+		default:
+			body.Add("message %sResponse {}", name)
+		}
+	}
+
+	for _, stream := range mod.Streams {
+		name := strcase.ToCamel(stream.ObjectName())
+		if err := f.claim(name+"Event", "the events of "+stream.ObjectName()); err != nil {
+			return "", err
+		}
+		if err := f.claim(name+"Signal", "the signals of "+stream.ObjectName()); err != nil {
+			return "", err
+		}
+
+		var events, signals []payload
+		for _, ev := range stream.Events {
+			events = append(events, payload{ev.ObjectName(), ev.Documentation, ev.Arguments, ev.Annotations})
+		}
+		for _, sig := range stream.Signals {
+			signals = append(signals, payload{sig.ObjectName(), sig.Documentation, sig.Arguments, sig.Annotations})
+		}
+		body.AddNL()
+		if err := f.union(&body, name+"Event", "event", events, stream.ObjectName()); err != nil {
+			return "", err
+		}
+		body.AddNL()
+		if err := f.union(&body, name+"Signal", "signal", signals, stream.ObjectName()); err != nil {
+			return "", err
+		}
+	}
+
+	if len(mod.Funcs) > 0 || len(mod.Streams) > 0 {
+		body.AddNL()
+		body.AddI("service %s {", strcase.ToCamel(mod.Name))
+		for _, fn := range mod.Funcs {
+			name := strcase.ToCamel(fn.ObjectName())
+			comment(&body, fn.Documentation)
+			body.Add("rpc %s(%sRequest) returns (%sResponse);", name, name, name)
+		}
+		for _, stream := range mod.Streams {
+			name := strcase.ToCamel(stream.ObjectName())
+			comment(&body, stream.Documentation)
+			body.Add("rpc %s(stream %sSignal) returns (stream %sEvent);", name, name, name)
+		}
+		body.AddD("}")
+	}
+
+	var wrappers []string
+	for name := range f.wrappers {
+		if err := f.claim(name, "a wrapper message"); err != nil {
+			return "", err
+		}
+		wrappers = append(wrappers, name)
+	}
+	sort.Strings(wrappers)
+	for _, name := range wrappers {
+		body.AddNL()
+		body.WriteString(f.wrappers[name])
+	}
+
+	build := backends.Filebuilder{}
+	build.Add("// Code generated by lugmac. DO NOT EDIT.")
+	build.AddNL()
+	build.Add(`syntax = "proto3";`)
+	build.AddNL()
+	build.Add("package %s;", Package(mod))
+	if len(f.imports) > 0 {
+		build.AddNL()
+		var imports []string
+		for name := range f.imports {
+			imports = append(imports, name)
+		}
+		sort.Strings(imports)
+		for _, name := range imports {
+			build.Add("import %q;", name)
+		}
+	}
+	build.WriteString(body.String())
+
+	return build.String(), nil
+}
@@ -0,0 +1,119 @@
+package protobuf
+
+import (
+	"flag"
+	"lugmac/ast"
+	"lugmac/typechecking"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files with what's generated now")
+
+// checked checks source as the module named name of a workspace named Test.
+func checked(t *testing.T, name, source string) (*typechecking.Module, *typechecking.Context) {
+	t.Helper()
+	tree, err := ast.Parse([]byte(source))
+	if err != nil {
+		t.Fatalf("failed to parse:\n%s", err)
+	}
+	tree.Path = name + ".lugma"
+	ctx := typechecking.NewContext(typechecking.FileImportResolver)
+	m, err := ctx.Module(tree, "Test/"+name)
+	if err != nil {
+		t.Fatalf("failed to check:\n%s", err)
+	}
+	return m, ctx
+}
+
+// TestGolden generates the proto file for every testdata/*.lugma and compares
+// it with the .proto next to it.
+func TestGolden(t *testing.T) {
+	inputs, err := filepath.Glob(filepath.Join("testdata", "*.lugma"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inputs) == 0 {
+		t.Fatal("no golden tests in testdata")
+	}
+
+	for _, input := range inputs {
+		name := strings.TrimSuffix(filepath.Base(input), ".lugma")
+		golden := filepath.Join("testdata", name+".proto")
+
+		t.Run(name, func(t *testing.T) {
+			source, err := os.ReadFile(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			m, ctx := checked(t, name, string(source))
+			got, err := ProtobufBackend{}.GenerateFile(m, ctx)
+			if err != nil {
+				t.Fatalf("failed to generate: %s", err)
+			}
+
+			if *update {
+				err = os.WriteFile(golden, []byte(got), 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != string(want) {
+				t.Errorf("generated differently from %s:\n%s", golden, got)
+			}
+		})
+	}
+}
+
+func TestGenerateFileErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{
+			name:   "case named unspecified",
+			source: "enum Color {\n case red\n case unspecified\n}",
+			want:   "M: case unspecified of Color would have the same name as the zero value COLOR_UNSPECIFIED that protobuf needs; rename the case",
+		},
+		{
+			name:   "reserved unspecified",
+			source: "@reserved([\"unspecified\"])\nenum Color {\n case red\n}",
+			want:   "M: Color reserves the name unspecified, which the zero value COLOR_UNSPECIFIED that protobuf needs has",
+		},
+		{
+			name:   "request clashing with a struct",
+			source: "struct GetRequest {\n let a: String\n}\nfunc get()",
+			want:   "M: the message generated for the arguments of get would have the same name as the one for GetRequest",
+		},
+		{
+			name:   "event clashing with a struct",
+			source: "struct WatchEvent {\n let a: String\n}\nstream watch {\n event changed()\n}",
+			want:   "M: the message generated for the events of watch would have the same name as the one for WatchEvent",
+		},
+		{
+			name:   "tag in the reserved range",
+			source: "struct S {\n @tag(19000)\n let a: String\n}",
+			want:   "a of S is tagged 19000, which protobuf doesn't allow, since it reserves 19000 to 19999",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m, ctx := checked(t, "M", c.source)
+			_, err := ProtobufBackend{}.GenerateFile(m, ctx)
+			if err == nil {
+				t.Fatalf("generated a file, want %q", c.want)
+			}
+			if err.Error() != c.want {
+				t.Errorf("got %q, want %q", err, c.want)
+			}
+		})
+	}
+}
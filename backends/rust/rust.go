@@ -0,0 +1,399 @@
+package rust
+
+import (
+	"fmt"
+	"io/fs"
+	"io/ioutil"
+	"lugmac/ast"
+	"lugmac/backends"
+	"lugmac/modules"
+	"lugmac/typechecking"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/urfave/cli/v2"
+)
+
+type RustBackend struct {
+}
+
+var _ backends.Backend = RustBackend{}
+
+func init() {
+	backends.RegisterBackend(RustBackend{})
+}
+
+var keywords = map[string]struct{}{
+	"as": {}, "async": {}, "await": {}, "break": {}, "const": {}, "continue": {},
+	"dyn": {}, "else": {}, "enum": {}, "extern": {}, "false": {}, "fn": {},
+	"for": {}, "if": {}, "impl": {}, "in": {}, "let": {}, "loop": {}, "match": {},
+	"mod": {}, "move": {}, "mut": {}, "pub": {}, "ref": {}, "return": {},
+	"static": {}, "struct": {}, "trait": {}, "true": {}, "type": {}, "unsafe": {},
+	"use": {}, "where": {}, "while": {}, "abstract": {}, "become": {}, "box": {},
+	"do": {}, "final": {}, "macro": {}, "override": {}, "priv": {}, "try": {},
+	"typeof": {}, "unsized": {}, "virtual": {}, "yield": {},
+}
+
+// ident makes name usable as a Rust identifier.
+func ident(name string) string {
+	switch name {
+	case "self", "Self", "super", "crate":
+		return name + "_"
+	}
+	if _, ok := keywords[name]; ok {
+		return "r#" + name
+	}
+	return name
+}
+
+func snake(name string) string {
+	return ident(strcase.ToSnake(name))
+}
+
+func camel(name string) string {
+	return ident(strcase.ToCamel(name))
+}
+
+// ModuleName is the name of the Rust module generated for a Lugma module.
+func ModuleName(mod *typechecking.Module) string {
+	return snake(mod.Name)
+}
+
+func (rs RustBackend) RustTypeOf(lugma typechecking.Type, module typechecking.Path, in *typechecking.Context) string {
+	switch k := lugma.(type) {
+	case typechecking.PrimitiveType:
+		switch k {
+		case typechecking.UInt8:
+			return "u8"
+		case typechecking.UInt16:
+			return "u16"
+		case typechecking.UInt32:
+			return "u32"
+		case typechecking.Int8:
+			return "i8"
+		case typechecking.Int16:
+			return "i16"
+		case typechecking.Int32:
+			return "i32"
+		case typechecking.Int64:
+			return "lugma_rs_helpers::Int64"
+		case typechecking.UInt64:
+			return "lugma_rs_helpers::UInt64"
+		case typechecking.Float32:
+			return "f32"
+		case typechecking.Float64:
+			return "f64"
+		case typechecking.String:
+			return "String"
+		case typechecking.Bytes:
+			return "lugma_rs_helpers::Bytes"
+		case typechecking.Bool:
+			return "bool"
+		case typechecking.Timestamp:
+			return "lugma_rs_helpers::Timestamp"
+		case typechecking.Duration:
+			return "lugma_rs_helpers::Duration"
+		case typechecking.UUID:
+			return "lugma_rs_helpers::UUID"
+		default:
+			panic("unhandled primitive " + k.String())
+		}
+	case typechecking.ArrayType:
+		return fmt.Sprintf("Vec<%s>", rs.RustTypeOf(k.Element, module, in))
+	case typechecking.DictionaryType:
+		return fmt.Sprintf("std::collections::HashMap<%s, %s>", rs.RustTypeOf(k.Key, module, in), rs.RustTypeOf(k.Element, module, in))
+	case typechecking.OptionalType:
+		return fmt.Sprintf("Option<%s>", rs.RustTypeOf(k.Element, module, in))
+	case *typechecking.Struct, *typechecking.Enum, *typechecking.Flagset, *typechecking.TypeAlias:
+		if k.Path().ModulePath == module.ModulePath {
+			return camel(k.ObjectName())
+		}
+		return fmt.Sprintf("super::%s::%s", ModuleName(k.Parent().(*typechecking.Module)), camel(k.ObjectName()))
+	default:
+		panic("unhandled " + k.String())
+	}
+}
+
+func docComment(build *backends.Filebuilder, docs *ast.ItemDocumentation) {
+	if docs == nil || docs.Summary == nil {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(docs.Summary.Text(docs.Source))), "\n") {
+		build.Add("/// %s", line)
+	}
+}
+
+// fields adds the fields of a struct, struct variant or payload, renaming
+// them back to their Lugma names on the wire.
+func (rs RustBackend) fields(build *backends.Filebuilder, fields []*typechecking.Field, visibility string, mod *typechecking.Module, in *typechecking.Context) {
+	for _, field := range fields {
+		docComment(build, field.Documentation)
+		if snake(field.ObjectName()) != field.ObjectName() {
+			build.Add(`#[serde(rename = "%s")]`, field.ObjectName())
+		}
+		if _, ok := typechecking.Resolve(field.Type).(typechecking.OptionalType); ok {
+			build.Add(`#[serde(default, skip_serializing_if = "Option::is_none")]`)
+		}
+		build.Add("%s%s: %s,", visibility, snake(field.ObjectName()), rs.RustTypeOf(field.Type, mod.Path(), in))
+	}
+}
+
+func (rs RustBackend) GenerateCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "rust",
+		Aliases: []string{"rs"},
+		Usage:   "Generate Rust modules for Lugma",
+		Flags:   backends.StandardFlags,
+		Action: func(cCtx *cli.Context) error {
+			w, err := modules.LoadWorkspaceFrom(cCtx.String("workspace"))
+			if err != nil {
+				return err
+			}
+			err = w.GenerateModules()
+			if err != nil {
+				return err
+			}
+
+			outdir := cCtx.String("outdir")
+			err = os.MkdirAll(path.Join(outdir), 0750)
+			if err != nil {
+				return err
+			}
+
+			index := backends.Filebuilder{}
+			index.Add("// Code generated by lugmac. DO NOT EDIT.")
+			index.AddNL()
+
+			generated, err := backends.GeneratedModules(w)
+			if err != nil {
+				return err
+			}
+			for _, mod := range generated.All() {
+				result, err := rs.GenerateModule(mod, w.Context)
+				if err != nil {
+					return err
+				}
+
+				err = ioutil.WriteFile(path.Join(outdir, ModuleName(mod)+".rs"), []byte(result), fs.ModePerm)
+				if err != nil {
+					return err
+				}
+
+				index.Add("pub mod %s;", ModuleName(mod))
+			}
+
+			return ioutil.WriteFile(path.Join(outdir, "mod.rs"), []byte(index.String()), fs.ModePerm)
+		},
+	}
+}
+
+func (rs RustBackend) GenerateModule(mod *typechecking.Module, in *typechecking.Context) (string, error) {
+	build := backends.Filebuilder{}
+
+	build.Add("// Code generated by lugmac. DO NOT EDIT.")
+	build.AddNL()
+	build.Add("#![allow(dead_code, unused_imports, clippy::all)]")
+	build.AddNL()
+	build.Add("use lugma_rs_helpers::{Stream, StreamError, Transport, TransportError};")
+	build.Add("use serde::{Deserialize, Serialize};")
+	build.AddNL()
+
+	rs.generateTypes(&build, mod, in)
+	rs.generateStreams(&build, mod, in)
+	rs.generateClient(&build, mod, in)
+
+	return build.String(), nil
+}
+
+func (rs RustBackend) generateTypes(build *backends.Filebuilder, mod *typechecking.Module, in *typechecking.Context) {
+	for _, item := range mod.TypeAliases {
+		underlying := rs.RustTypeOf(item.Underlying, mod.Path(), in)
+		docComment(build, item.Documentation)
+		// optional fields are recognised by being an Option, so optional
+		// newtypes have to be plain aliases
+		_, optional := typechecking.Resolve(item.Underlying).(typechecking.OptionalType)
+		if !item.Newtype || optional {
+			build.Add("pub type %s = %s;", camel(item.ObjectName()), underlying)
+			build.AddNL()
+			continue
+		}
+		if item.Keyable() {
+			build.Add("#[derive(Debug, Clone, PartialEq, Eq, Hash, Serialize, Deserialize)]")
+		} else {
+			build.Add("#[derive(Debug, Clone, PartialEq, Serialize, Deserialize)]")
+		}
+		build.Add("#[serde(transparent)]")
+		build.Add("pub struct %s(pub %s);", camel(item.ObjectName()), underlying)
+		build.AddNL()
+	}
+	for _, item := range mod.Structs {
+		docComment(build, item.Documentation)
+		build.Add("#[derive(Debug, Clone, PartialEq, Serialize, Deserialize)]")
+		build.AddI("pub struct %s {", camel(item.ObjectName()))
+		rs.fields(build, item.Fields, "pub ", mod, in)
+		build.AddD("}")
+		build.AddNL()
+	}
+	for _, item := range mod.Enums {
+		docComment(build, item.Documentation)
+		build.Add("#[derive(Debug, Clone, PartialEq, Serialize, Deserialize)]")
+		build.AddI("pub enum %s {", camel(item.ObjectName()))
+		simple := item.Simple()
+		for _, esac := range item.Cases {
+			docComment(build, esac.Documentation)
+			build.Add(`#[serde(rename = "%s")]`, esac.ObjectName())
+			if simple {
+				build.Add("%s,", camel(esac.ObjectName()))
+			} else {
+				// struct variants, even empty ones, are encoded as
+				// { "case": { ... } } just like the other backends do
+				build.AddI("%s {", camel(esac.ObjectName()))
+				rs.fields(build, esac.Fields, "", mod, in)
+				build.AddD("},")
+			}
+		}
+		build.AddD("}")
+		build.AddNL()
+	}
+	for _, item := range mod.Flagsets {
+		name := camel(item.ObjectName())
+
+		build.AddI("lugma_rs_helpers::bitflags::bitflags! {")
+		docComment(build, item.Documentation)
+		build.Add("#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash)]")
+		build.AddI("pub struct %s: u64 {", name)
+		for idx, flag := range item.Flags {
+			docComment(build, flag.Documentation)
+			build.Add("const %s = 1 << %d;", strcase.ToScreamingSnake(flag.ObjectName()), idx)
+		}
+		build.AddD("}")
+		build.AddD("}")
+		build.AddNL()
+
+		build.AddI("impl Serialize for %s {", name)
+		build.AddI("fn serialize<S: serde::Serializer>(&self, serializer: S) -> Result<S::Ok, S::Error> {")
+		build.Add("serializer.collect_str(&self.bits())")
+		build.AddD("}")
+		build.AddD("}")
+		build.AddNL()
+
+		build.AddI("impl<'de> Deserialize<'de> for %s {", name)
+		build.AddI("fn deserialize<D: serde::Deserializer<'de>>(deserializer: D) -> Result<Self, D::Error> {")
+		build.Add("let bits = String::deserialize(deserializer)?;")
+		build.Add("let bits: u64 = bits.parse().map_err(serde::de::Error::custom)?;")
+		build.Add("Ok(Self::from_bits_retain(bits))")
+		build.AddD("}")
+		build.AddD("}")
+		build.AddNL()
+	}
+}
+
+func (rs RustBackend) payload(build *backends.Filebuilder, name string, docs *ast.ItemDocumentation, args []*typechecking.Field, mod *typechecking.Module, in *typechecking.Context) {
+	docComment(build, docs)
+	build.Add("#[derive(Debug, Clone, PartialEq, Serialize, Deserialize)]")
+	build.AddI("pub struct %s {", name)
+	rs.fields(build, args, "pub ", mod, in)
+	build.AddD("}")
+	build.AddNL()
+}
+
+func (rs RustBackend) parameters(args []*typechecking.Field, mod *typechecking.Module, in *typechecking.Context) (string, string) {
+	var params, names []string
+	for _, arg := range args {
+		params = append(params, fmt.Sprintf("%s: %s", snake(arg.ObjectName()), rs.RustTypeOf(arg.Type, mod.Path(), in)))
+		names = append(names, snake(arg.ObjectName()))
+	}
+	return strings.Join(params, ", "), strings.Join(names, ", ")
+}
+
+func (rs RustBackend) generateStreams(build *backends.Filebuilder, mod *typechecking.Module, in *typechecking.Context) {
+	for _, stream := range mod.Streams {
+		name := camel(stream.ObjectName())
+
+		for _, ev := range stream.Events {
+			rs.payload(build, name+camel(ev.ObjectName()), ev.Documentation, ev.Arguments, mod, in)
+		}
+		for _, sig := range stream.Signals {
+			rs.payload(build, name+camel(sig.ObjectName()), sig.Documentation, sig.Arguments, mod, in)
+		}
+
+		docComment(build, stream.Documentation)
+		build.AddI("pub struct %s<St: Stream> {", name)
+		build.Add("pub stream: St,")
+		build.AddD("}")
+		build.AddNL()
+
+		build.AddI("impl<St: Stream> %s<St> {", name)
+		for _, ev := range stream.Events {
+			docComment(build, ev.Documentation)
+			build.AddI("pub async fn %s<'a>(&'a mut self) -> Box<dyn futures::stream::Stream<Item = std::borrow::Cow<'a, %s>> + 'a> {", snake(ev.ObjectName()), name+camel(ev.ObjectName()))
+			build.Add(`self.stream.stream_for("%s".to_string()).await`, ev.ObjectName())
+			build.AddD("}")
+		}
+		for _, sig := range stream.Signals {
+			params, names := rs.parameters(sig.Arguments, mod, in)
+			if params != "" {
+				params = ", " + params
+			}
+
+			docComment(build, sig.Documentation)
+			build.AddI("pub async fn %s(&mut self%s) -> Result<(), StreamError<St::StreamError>> {", snake(sig.ObjectName()), params)
+			build.Add(`self.stream.send("%s".to_string(), &%s { %s }).await`, sig.ObjectName(), name+camel(sig.ObjectName()), names)
+			build.AddD("}")
+		}
+		build.AddD("}")
+		build.AddNL()
+	}
+}
+
+func (rs RustBackend) generateClient(build *backends.Filebuilder, mod *typechecking.Module, in *typechecking.Context) {
+	build.AddI("pub struct Client<T: Transport> {")
+	build.Add("pub transport: T,")
+	build.AddD("}")
+	build.AddNL()
+
+	build.AddI("impl<T: Transport> Client<T> {")
+	build.AddI("pub fn new(transport: T) -> Self {")
+	build.Add("Client { transport }")
+	build.AddD("}")
+
+	for _, stream := range mod.Streams {
+		name := camel(stream.ObjectName())
+
+		build.AddNL()
+		build.AddI("pub async fn open_%s(&mut self, extra: T::Extra) -> Result<%s<T::Stream>, TransportError<T::TransportError>> {", strcase.ToSnake(stream.ObjectName()), name)
+		build.Add(`let stream = self.transport.open_stream("%s".to_string(), extra).await?;`, stream.Path().String())
+		build.Add("Ok(%s { stream })", name)
+		build.AddD("}")
+	}
+
+	for _, fn := range mod.Funcs {
+		params, names := rs.parameters(fn.Arguments, mod, in)
+		if params != "" {
+			params += ", "
+		}
+
+		ret := "()"
+		if fn.Returns != nil {
+			ret = rs.RustTypeOf(fn.Returns, mod.Path(), in)
+		}
+		fai := "serde::de::IgnoredAny"
+		if fn.Throws != nil {
+			fai = rs.RustTypeOf(fn.Throws, mod.Path(), in)
+		}
+
+		build.AddNL()
+		docComment(build, fn.Documentation)
+		build.AddI("pub async fn %s(&mut self, %sextra: T::Extra) -> Result<Result<%s, %s>, TransportError<T::TransportError>> {", snake(fn.ObjectName()), params, ret, fai)
+		build.Add("#[derive(Serialize)]")
+		build.AddI("struct Body {")
+		rs.fields(build, fn.Arguments, "", mod, in)
+		build.AddD("}")
+		build.Add(`self.transport.make_request("%s".to_string(), Body { %s }, extra).await`, fn.Path().String(), names)
+		build.AddD("}")
+	}
+
+	build.AddD("}")
+}
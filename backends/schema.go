@@ -0,0 +1,336 @@
+package backends
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"lugmac/ast"
+	"lugmac/typechecking"
+	"strings"
+
+	gmast "github.com/yuin/goldmark/ast"
+)
+
+// Schema is a JSON Schema (draft 2020-12), which is also what OpenAPI 3.1
+// and AsyncAPI describe values with.
+type Schema map[string]interface{}
+
+// Properties are the properties of an object's schema, which are written in
+// the order the fields they describe are declared, rather than sorted like
+// the rest of a Schema.
+type Properties []Property
+
+// Property is the schema of one of an object's properties.
+type Property struct {
+	Name   string
+	Schema Schema
+}
+
+// Get returns the schema of the property named name, or nil if there isn't
+// one.
+func (p Properties) Get(name string) Schema {
+	for _, prop := range p {
+		if prop.Name == name {
+			return prop.Schema
+		}
+	}
+	return nil
+}
+
+func (p Properties) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, prop := range p {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		name, err := json.Marshal(prop.Name)
+		if err != nil {
+			return nil, err
+		}
+		schema, err := json.Marshal(prop.Schema)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(name)
+		b.WriteByte(':')
+		b.Write(schema)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// DurationPattern is the pattern of the strings Durations are sent as.
+const DurationPattern = `^-?[0-9]+(\.[0-9]{1,9})?s$`
+
+// BadRequestName is the name that the schema of bad requests is defined with
+// alongside the schemas of declarations.
+const BadRequestName = "BadRequest"
+
+// BadRequest returns the schema of what servers answer requests whose content
+// isn't valid with, which lists the problems with it and where they are.
+func BadRequest() Schema {
+	problem := Schema{
+		"type": "object",
+		"properties": Properties{
+			{"path", Schema{"type": "string"}},
+			{"message", Schema{"type": "string"}},
+		},
+		"required": []string{"path", "message"},
+	}
+	return Schema{
+		"type":        "object",
+		"description": "The request's content isn't valid.",
+		"properties": Properties{
+			{"error", Schema{"const": "bad request"}},
+			{"problems", Schema{"type": "array", "items": problem}},
+		},
+		"required": []string{"error", "problems"},
+	}
+}
+
+// Schemas builds schemas of how values are sent in JSON. Structs, enums,
+// flagsets and type aliases are referred to by Ref, and collected in Used so
+// that schemas of them can be defined alongside.
+type Schemas struct {
+	// Ref returns the reference to the schema of a declaration.
+	Ref func(typ typechecking.Type) string
+	// Used holds the declarations that have been referred to, in the order
+	// they were first.
+	Used []typechecking.Type
+
+	seen map[typechecking.Path]struct{}
+}
+
+// NewSchemas returns Schemas referring to declarations with ref.
+func NewSchemas(ref func(typ typechecking.Type) string) *Schemas {
+	return &Schemas{Ref: ref, seen: map[typechecking.Path]struct{}{}}
+}
+
+// Use adds typ, a declaration, to Used if it isn't already.
+func (s *Schemas) Use(typ typechecking.Type) {
+	if _, ok := s.seen[typ.Path()]; ok {
+		return
+	}
+	s.seen[typ.Path()] = struct{}{}
+	s.Used = append(s.Used, typ)
+}
+
+// ComponentName is the name of the schema of typ, a declaration, in a
+// document describing mod. Declarations from other modules are prefixed with
+// their modules' names.
+func ComponentName(typ typechecking.Type, mod *typechecking.Module) string {
+	if typ.Path().ModulePath == mod.Path().ModulePath {
+		return typ.ObjectName()
+	}
+	return typ.Parent().(*typechecking.Module).Name + "." + typ.ObjectName()
+}
+
+// DocText returns the plain text of a part of an item's documentation.
+func DocText(node gmast.Node, source []byte) string {
+	if node == nil {
+		return ""
+	}
+	return strings.TrimSpace(string(node.Text(source)))
+}
+
+// Description returns the summary and discussion of docs as plain text.
+func Description(docs *ast.ItemDocumentation) string {
+	if docs == nil {
+		return ""
+	}
+	var paragraphs []string
+	if text := DocText(docs.Summary, docs.Source); text != "" {
+		paragraphs = append(paragraphs, text)
+	}
+	for _, node := range docs.Discussion {
+		if text := DocText(node, docs.Source); text != "" {
+			paragraphs = append(paragraphs, text)
+		}
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
+
+func describe(schema Schema, docs *ast.ItemDocumentation) Schema {
+	if description := Description(docs); description != "" {
+		schema["description"] = description
+	}
+	return schema
+}
+
+func integer(min, max int64) Schema {
+	return Schema{"type": "integer", "minimum": min, "maximum": max}
+}
+
+// Of returns the schema of typ.
+func (s *Schemas) Of(typ typechecking.Type) Schema {
+	switch k := typ.(type) {
+	case typechecking.PrimitiveType:
+		switch k {
+		case typechecking.UInt8:
+			return integer(0, 255)
+		case typechecking.UInt16:
+			return integer(0, 65535)
+		case typechecking.UInt32:
+			return integer(0, 4294967295)
+		case typechecking.Int8:
+			return integer(-128, 127)
+		case typechecking.Int16:
+			return integer(-32768, 32767)
+		case typechecking.Int32:
+			return integer(-2147483648, 2147483647)
+		case typechecking.UInt64:
+			return Schema{"type": "string", "pattern": "^[0-9]+$", "format": "uint64"}
+		case typechecking.Int64:
+			return Schema{"type": "string", "pattern": "^-?[0-9]+$", "format": "int64"}
+		case typechecking.Float32:
+			return Schema{"type": "number", "format": "float"}
+		case typechecking.Float64:
+			return Schema{"type": "number", "format": "double"}
+		case typechecking.String:
+			return Schema{"type": "string"}
+		case typechecking.Bytes:
+			return Schema{"type": "string", "contentEncoding": "base64"}
+		case typechecking.Bool:
+			return Schema{"type": "boolean"}
+		case typechecking.Timestamp:
+			return Schema{"type": "string", "format": "date-time"}
+		case typechecking.Duration:
+			return Schema{"type": "string", "pattern": DurationPattern}
+		case typechecking.UUID:
+			return Schema{"type": "string", "format": "uuid"}
+		default:
+			panic("unhandled primitive " + k.String())
+		}
+	case typechecking.ArrayType:
+		return Schema{"type": "array", "items": s.Of(k.Element)}
+	case typechecking.DictionaryType:
+		schema := Schema{"type": "object", "additionalProperties": s.Of(k.Element)}
+		if names := keySchema(k.Key); names != nil {
+			schema["propertyNames"] = names
+		}
+		return schema
+	case typechecking.OptionalType:
+		return Schema{"anyOf": []Schema{s.Of(k.Element), {"type": "null"}}}
+	case *typechecking.Struct, *typechecking.Enum, *typechecking.Flagset, *typechecking.TypeAlias:
+		s.Use(k)
+		return Schema{"$ref": s.Ref(k)}
+	default:
+		panic("unhandled " + k.String())
+	}
+}
+
+// keySchema returns the schema of the keys of a dictionary with keys of typ,
+// which are sent as strings, or nil if they can be any string.
+func keySchema(typ typechecking.Type) Schema {
+	switch typechecking.Resolve(typ) {
+	case typechecking.Bool:
+		return Schema{"enum": []string{"true", "false"}}
+	case typechecking.UInt8, typechecking.UInt16, typechecking.UInt32, typechecking.UInt64:
+		return Schema{"pattern": "^[0-9]+$"}
+	case typechecking.Int8, typechecking.Int16, typechecking.Int32, typechecking.Int64:
+		return Schema{"pattern": "^-?[0-9]+$"}
+	case typechecking.UUID:
+		return Schema{"format": "uuid"}
+	case typechecking.Bytes:
+		return Schema{"contentEncoding": "base64"}
+	default:
+		return nil
+	}
+}
+
+// Fields returns the schema of an object with fields. Fields that aren't
+// optional and don't have defaults are required.
+func (s *Schemas) Fields(fields []*typechecking.Field) Schema {
+	properties := Properties{}
+	required := []string{}
+	for _, field := range fields {
+		property := describe(s.Of(field.Type), field.Documentation)
+		if field.Default != nil {
+			property["default"] = json.RawMessage(WireJSON(field.Default, field.Type))
+		}
+		properties = append(properties, Property{field.ObjectName(), property})
+
+		_, optional := typechecking.Resolve(field.Type).(typechecking.OptionalType)
+		if !optional && field.Default == nil {
+			required = append(required, field.ObjectName())
+		}
+	}
+	schema := Schema{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// Declaration returns the schema of typ, a struct, enum, flagset or type
+// alias, which is what Ref refers to.
+func (s *Schemas) Declaration(typ typechecking.Type) Schema {
+	switch k := typ.(type) {
+	case *typechecking.Struct:
+		return describe(s.Fields(k.Fields), k.Documentation)
+	case *typechecking.Enum:
+		if k.Simple() {
+			var cases []string
+			for _, esac := range k.Cases {
+				cases = append(cases, esac.ObjectName())
+			}
+			return describe(Schema{"type": "string", "enum": cases}, k.Documentation)
+		}
+		var cases []Schema
+		for _, esac := range k.Cases {
+			cases = append(cases, describe(Schema{
+				"type":                 "object",
+				"properties":           Properties{{esac.ObjectName(), s.Fields(esac.Fields)}},
+				"required":             []string{esac.ObjectName()},
+				"additionalProperties": false,
+			}, esac.Documentation))
+		}
+		return describe(Schema{"oneOf": cases}, k.Documentation)
+	case *typechecking.Flagset:
+		var flags []string
+		for idx, flag := range k.Flags {
+			flags = append(flags, fmt.Sprintf("%s = %d", flag.ObjectName(), uint64(1)<<idx))
+		}
+		description := Description(k.Documentation)
+		if len(flags) > 0 {
+			if description != "" {
+				description += "\n\n"
+			}
+			description += "A bitmask of " + strings.Join(flags, ", ") + "."
+		}
+		schema := Schema{"type": "string", "pattern": "^[0-9]+$"}
+		if description != "" {
+			schema["description"] = description
+		}
+		return schema
+	case *typechecking.TypeAlias:
+		return describe(s.Of(k.Underlying), k.Documentation)
+	default:
+		panic("unhandled " + typ.String())
+	}
+}
+
+// Components returns the schemas of the declarations in mod, and of the
+// declarations from other modules that they or anything else described so
+// far use, keyed by their ComponentNames.
+func (s *Schemas) Components(mod *typechecking.Module) Schema {
+	for _, item := range mod.TypeAliases {
+		s.Use(item)
+	}
+	for _, item := range mod.Structs {
+		s.Use(item)
+	}
+	for _, item := range mod.Enums {
+		s.Use(item)
+	}
+	for _, item := range mod.Flagsets {
+		s.Use(item)
+	}
+	components := Schema{}
+	// describing a declaration can use more of them
+	for i := 0; i < len(s.Used); i++ {
+		components[ComponentName(s.Used[i], mod)] = s.Declaration(s.Used[i])
+	}
+	return components
+}
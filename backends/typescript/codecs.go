@@ -0,0 +1,199 @@
+package typescript
+
+import (
+	"fmt"
+	"lugmac/backends"
+	"lugmac/typechecking"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The binary encoding of values is CBOR (RFC 8949), which is sent instead of
+// JSON when a client asks for it. Each type is encoded as:
+//
+//   - integers as CBOR integers, including Int64 and UInt64
+//   - Float32 and Float64 as single and double precision floats
+//   - String, Timestamp and Duration as text strings, written as in JSON
+//   - Bytes as byte strings
+//   - UUID as byte strings of its 16 bytes
+//   - Bool as true or false
+//   - arrays as arrays, and dictionaries as maps with keys of their key type
+//   - optionals as their element when present, and null or nothing when not
+//   - structs, and the arguments of functions, events and signals, as maps of
+//     their fields, keyed by their tags if they have them and their names if
+//     they don't, leaving out empty optionals
+//   - simple enums as their cases' tags or names
+//   - other enums as maps with one entry, from the case's tag or name to a
+//     map of its fields
+//   - flagsets as unsigned integers of their bitmasks
+//   - type aliases as the type they stand for
+//
+// Functions that return or throw nothing send null, and WebSocket frames are
+// maps with "type" and "content" entries, as in JSON.
+
+// codecs writes code converting values of a module's types between JSON and
+// CBOR, keeping track of what the file it's written to needs to import.
+type codecs struct {
+	scope *scope
+
+	// runtime is the names used from the helpers package.
+	runtime map[string]struct{}
+}
+
+func newCodecs(s *scope) *codecs {
+	return &codecs{s, map[string]struct{}{}}
+}
+
+func (c *codecs) helper(name string) string {
+	c.runtime[name] = struct{}{}
+	return name
+}
+
+// keyOf returns how object is identified in CBOR.
+func keyOf(name string, tag int) string {
+	if tag != 0 {
+		return strconv.Itoa(tag)
+	}
+	return strconv.Quote(name)
+}
+
+// of returns an expression for a codec of typ.
+func (c *codecs) of(typ typechecking.Type) string {
+	switch k := typ.(type) {
+	case typechecking.PrimitiveType:
+		return c.helper("codec" + k.String())
+	case typechecking.ArrayType:
+		return fmt.Sprintf("%s(%s)", c.helper("codecArray"), c.of(k.Element))
+	case typechecking.OptionalType:
+		return fmt.Sprintf("%s(%s)", c.helper("codecOptional"), c.of(k.Element))
+	case typechecking.DictionaryType:
+		keyOf := ""
+		if key, ok := typechecking.Resolve(k.Key).(typechecking.PrimitiveType); ok {
+			switch key {
+			case typechecking.UInt8, typechecking.UInt16, typechecking.UInt32, typechecking.Int8, typechecking.Int16, typechecking.Int32:
+				keyOf = ", " + c.helper("numericKey")
+			case typechecking.Bool:
+				keyOf = ", " + c.helper("booleanKey")
+			}
+		}
+		return fmt.Sprintf("%s(%s, %s%s)", c.helper("codecDictionary"), c.of(k.Key), c.of(k.Element), keyOf)
+	case *typechecking.Struct, *typechecking.Enum, *typechecking.Flagset, *typechecking.TypeAlias:
+		// codecs are constants, so ones declared later have to be referred
+		// to lazily
+		return fmt.Sprintf("%s(() => codec%s)", c.helper("lazy"), c.scope.reference(k))
+	default:
+		panic("unhandled " + k.String())
+	}
+}
+
+// fields returns an expression for a codec of a map of fields.
+func (c *codecs) fields(fields []*typechecking.Field) string {
+	var items []string
+	for _, field := range fields {
+		items = append(items, fmt.Sprintf(`["%s", %s, %s]`, field.ObjectName(), keyOf(field.ObjectName(), field.Tag), c.of(field.Type)))
+	}
+	return fmt.Sprintf("%s([%s])", c.helper("codecFields"), strings.Join(items, ", "))
+}
+
+// result returns an expression for a codec of what a function returns or
+// throws, which is nothing if typ is nil.
+func (c *codecs) result(typ typechecking.Type) string {
+	if typ == nil {
+		return c.helper("codecNothing")
+	}
+	return c.of(typ)
+}
+
+// codecExports returns the names of what clients and servers of mod use from
+// its codecs file.
+func codecExports(mod *typechecking.Module) []string {
+	var names []string
+	if len(mod.Funcs) > 0 {
+		names = append(names, "functionCodecs")
+	}
+	if len(mod.Streams) > 0 {
+		names = append(names, "streamCodecs")
+	}
+	return names
+}
+
+// GenerateCodecs generates the codecs of the module's types, and of what its
+// functions and streams send, which transports use to send them as CBOR.
+func (ts TypescriptBackend) GenerateCodecs(mod *typechecking.Module, in *typechecking.Context) (string, error) {
+	body := backends.Filebuilder{}
+	c := newCodecs(newScope(mod))
+
+	for _, item := range mod.TypeAliases {
+		body.Add(`export const codec%s: %s = %s`, item.ObjectName(), c.helper("Codec"), c.of(item.Underlying))
+	}
+	for _, item := range mod.Structs {
+		body.Add(`export const codec%s: %s = %s`, item.ObjectName(), c.helper("Codec"), c.fields(item.Fields))
+	}
+	for _, item := range mod.Enums {
+		if item.Simple() {
+			var cases []string
+			for _, esac := range item.Cases {
+				cases = append(cases, fmt.Sprintf(`["%s", %s]`, esac.ObjectName(), keyOf(esac.ObjectName(), esac.Tag)))
+			}
+			body.Add(`export const codec%s: %s = %s([%s])`, item.ObjectName(), c.helper("Codec"), c.helper("codecOneOf"), strings.Join(cases, ", "))
+		} else {
+			body.AddI(`export const codec%s: %s = %s([`, item.ObjectName(), c.helper("Codec"), c.helper("codecCases"))
+			for _, esac := range item.Cases {
+				body.Add(`["%s", %s, %s],`, esac.ObjectName(), keyOf(esac.ObjectName(), esac.Tag), c.fields(esac.Fields))
+			}
+			body.AddD(`])`)
+		}
+	}
+	for _, item := range mod.Flagsets {
+		// flagsets are sent as decimal strings in JSON, like UInt64
+		body.Add(`export const codec%s: %s = %s`, item.ObjectName(), c.helper("Codec"), c.helper("codecUInt64"))
+	}
+
+	if len(mod.Funcs) > 0 {
+		c.helper("MethodCodec")
+		body.AddI(`export const functionCodecs: { [name: string]: MethodCodec } = {`)
+		for _, fn := range mod.Funcs {
+			body.AddI(`%s: {`, fn.ObjectName())
+			body.Add(`arguments: %s,`, c.fields(fn.Arguments))
+			body.Add(`returns: %s,`, c.result(fn.Returns))
+			body.Add(`throws: %s,`, c.result(fn.Throws))
+			body.AddD(`},`)
+		}
+		body.AddD(`}`)
+	}
+	if len(mod.Streams) > 0 {
+		c.helper("StreamCodec")
+		body.AddI(`export const streamCodecs: { [name: string]: StreamCodec } = {`)
+		for _, stream := range mod.Streams {
+			body.AddI(`%s: {`, stream.ObjectName())
+			body.AddI(`events: {`)
+			for _, ev := range stream.Events {
+				body.Add(`%s: %s,`, ev.ObjectName(), c.fields(ev.Arguments))
+			}
+			body.AddD(`},`)
+			body.AddI(`signals: {`)
+			for _, sig := range stream.Signals {
+				body.Add(`%s: %s,`, sig.ObjectName(), c.fields(sig.Arguments))
+			}
+			body.AddD(`},`)
+			body.AddD(`},`)
+		}
+		body.AddD(`}`)
+	}
+
+	var runtime []string
+	for name := range c.runtime {
+		runtime = append(runtime, name)
+	}
+	sort.Strings(runtime)
+
+	build := backends.Filebuilder{}
+	if len(runtime) > 0 {
+		build.Add(`import { %s } from '@lugma/cbor-helpers'`, strings.Join(runtime, ", "))
+	}
+	c.scope.addImportsTo(&build, "codec", "codecs", c.scope.referred)
+	build.WriteString(body.String())
+
+	return build.String(), nil
+}
@@ -0,0 +1,174 @@
+package typescript
+
+import (
+	"lugmac/backends"
+	"lugmac/typechecking"
+	"path"
+	"sort"
+	"strings"
+)
+
+// scope is the names a generated file refers to types by. Types from other
+// modules are imported from their modules' types files, under another name
+// if theirs is already taken.
+type scope struct {
+	module string
+
+	// taken maps each name in use to the module it's from.
+	taken map[string]string
+	// imports maps each module imported from to the names of its types
+	// that are used, and what they're referred to as.
+	imports map[string]map[string]string
+	// referred is the types the file refers to, which are the only ones it
+	// imports.
+	referred usage
+}
+
+// usage is the names of the types a file refers to, by the path of the
+// module they're from.
+type usage map[string]map[string]struct{}
+
+func (u usage) add(typ typechecking.Type) {
+	from := typ.Path().ModulePath
+	if u[from] == nil {
+		u[from] = map[string]struct{}{}
+	}
+	u[from][typ.ObjectName()] = struct{}{}
+}
+
+// newScope returns the scope of a file generated for mod, with everything
+// its declarations use from other modules imported.
+func newScope(mod *typechecking.Module) *scope {
+	s := &scope{
+		module:   mod.Path().ModulePath,
+		taken:    map[string]string{},
+		imports:  map[string]map[string]string{},
+		referred: usage{},
+	}
+	for _, name := range allNames(mod) {
+		s.taken[name] = s.module
+	}
+
+	fields := func(fields []*typechecking.Field) {
+		for _, field := range fields {
+			s.use(field.Type)
+		}
+	}
+
+	for _, item := range mod.TypeAliases {
+		s.use(item.Underlying)
+	}
+	for _, item := range mod.Constants {
+		s.use(item.Type)
+	}
+	for _, item := range mod.Structs {
+		fields(item.Fields)
+	}
+	for _, item := range mod.Enums {
+		for _, esac := range item.Cases {
+			fields(esac.Fields)
+		}
+	}
+	for _, item := range mod.Funcs {
+		fields(item.Arguments)
+		s.use(item.Returns)
+		s.use(item.Throws)
+	}
+	for _, item := range mod.Streams {
+		for _, ev := range item.Events {
+			fields(ev.Arguments)
+		}
+		for _, sig := range item.Signals {
+			fields(sig.Arguments)
+		}
+	}
+
+	return s
+}
+
+// use imports the types from other modules that typ refers to.
+func (s *scope) use(typ typechecking.Type) {
+	switch k := typ.(type) {
+	case typechecking.ArrayType:
+		s.use(k.Element)
+	case typechecking.DictionaryType:
+		s.use(k.Key)
+		s.use(k.Element)
+	case typechecking.OptionalType:
+		s.use(k.Element)
+	case *typechecking.Struct, *typechecking.Enum, *typechecking.Flagset, *typechecking.TypeAlias:
+		from := k.Path().ModulePath
+		if from == s.module {
+			return
+		}
+		if s.imports[from] == nil {
+			s.imports[from] = map[string]string{}
+		}
+		if _, ok := s.imports[from][k.ObjectName()]; ok {
+			return
+		}
+
+		name := k.ObjectName()
+		if module, ok := s.taken[name]; ok && module != from {
+			name = path.Base(from) + name
+		}
+		s.taken[name] = from
+		s.imports[from][k.ObjectName()] = name
+	}
+}
+
+// reference returns what typ, a struct, enum, flagset or type alias, is
+// called in the file, and notes that the file refers to it.
+func (s *scope) reference(typ typechecking.Type) string {
+	s.referred.add(typ)
+	return s.nameOf(typ)
+}
+
+// nameOf returns what typ is called in the file.
+func (s *scope) nameOf(typ typechecking.Type) string {
+	from := typ.Path().ModulePath
+	if from == s.module {
+		return typ.ObjectName()
+	}
+	return s.imports[from][typ.ObjectName()]
+}
+
+// local returns the names of the module's own types in used, in the order
+// they're declared in.
+func (s *scope) local(mod *typechecking.Module, used usage) []string {
+	var names []string
+	for _, name := range allNames(mod) {
+		if _, ok := used[s.module][name]; ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// addImportsTo adds import statements for the types in used from other
+// modules to build, importing what's generated for them in their files of a
+// kind, such as "types", named with prefix.
+func (s *scope) addImportsTo(build *backends.Filebuilder, prefix, kind string, used usage) {
+	var modules []string
+	for module := range used {
+		if module != s.module {
+			modules = append(modules, module)
+		}
+	}
+	sort.Strings(modules)
+
+	for _, module := range modules {
+		var names []string
+		for name := range used[module] {
+			as := s.imports[module][name]
+			if name == as {
+				names = append(names, prefix+name)
+			} else {
+				names = append(names, prefix+name+" as "+prefix+as)
+			}
+		}
+		sort.Strings(names)
+
+		build.Add(`import { %s } from './%s.%s'`, strings.Join(names, ", "), path.Base(module), kind)
+	}
+}
@@ -20,35 +20,38 @@ type TypescriptBackend struct {
 
 var _ backends.Backend = TypescriptBackend{}
 
-func (ts TypescriptBackend) TSTypeOf(lugma typechecking.Type, module typechecking.Path, in *typechecking.Context) string {
+func (ts TypescriptBackend) TSTypeOf(lugma typechecking.Type, s *scope, in *typechecking.Context) string {
 	switch k := lugma.(type) {
 	case typechecking.PrimitiveType:
 		switch k {
-		case typechecking.UInt8, typechecking.UInt16, typechecking.UInt32, typechecking.Int8, typechecking.Int16, typechecking.Int32:
+		case typechecking.UInt8, typechecking.UInt16, typechecking.UInt32, typechecking.Int8, typechecking.Int16, typechecking.Int32, typechecking.Float32, typechecking.Float64:
 			return "number"
-		case typechecking.Int64, typechecking.UInt64, typechecking.String, typechecking.Bytes:
+		case typechecking.Int64, typechecking.UInt64, typechecking.String, typechecking.Bytes, typechecking.Timestamp, typechecking.Duration, typechecking.UUID:
 			return "string"
 		case typechecking.Bool:
-			return "bool"
+			return "boolean"
 		default:
 			panic("unhandled primitive " + k.String())
 		}
 	case typechecking.ArrayType:
-		return fmt.Sprintf("Array<%s>", ts.TSTypeOf(k.Element, module, in))
+		return fmt.Sprintf("Array<%s>", ts.TSTypeOf(k.Element, s, in))
 	case typechecking.DictionaryType:
-		return fmt.Sprintf("[%s: %s]", ts.TSTypeOf(k.Key, module, in), ts.TSTypeOf(k.Element, module, in))
+		return fmt.Sprintf("[%s: %s]", ts.TSTypeOf(k.Key, s, in), ts.TSTypeOf(k.Element, s, in))
 	case typechecking.OptionalType:
-		return fmt.Sprintf("(%s|null|undefined)", ts.TSTypeOf(k.Element, module, in))
-	case *typechecking.Struct, *typechecking.Enum:
-		if k.Path().ModulePath == module.ModulePath {
-			return k.String()
-		}
-		return fmt.Sprintf("TODO")
+		return fmt.Sprintf("(%s|null|undefined)", ts.TSTypeOf(k.Element, s, in))
+	case *typechecking.Struct, *typechecking.Enum, *typechecking.Flagset, *typechecking.TypeAlias:
+		return s.reference(k)
 	default:
 		panic("unhandled " + k.String())
 	}
 }
 
+// TSValueOf returns an expression for value as it's sent on the wire as typ.
+func (ts TypescriptBackend) TSValueOf(value typechecking.Value, typ typechecking.Type) string {
+	// values are written as they're sent, and JSON is valid JavaScript
+	return backends.WireJSON(value, typ)
+}
+
 func init() {
 	backends.RegisterBackend(TypescriptBackend{})
 }
@@ -91,9 +94,11 @@ func (ts TypescriptBackend) GenerateCommand() *cli.Command {
 				return err
 			}
 
-			for _, prod := range w.Module.Products {
-				mod := w.KnownModules[prod.Name]
-
+			generated, err := backends.GeneratedModules(w)
+			if err != nil {
+				return err
+			}
+			for _, mod := range generated.All() {
 				result, err := ts.GenerateTypes(mod, w.Context)
 				if err != nil {
 					return err
@@ -103,13 +108,21 @@ func (ts TypescriptBackend) GenerateCommand() *cli.Command {
 				if err != nil {
 					return err
 				}
+
+				result, err = ts.GenerateCodecs(mod, w.Context)
+				if err != nil {
+					return err
+				}
+
+				err = ioutil.WriteFile(path.Join(outdir, mod.Name+".codecs.ts"), []byte(result), fs.ModePerm)
+				if err != nil {
+					return err
+				}
 			}
 
 			types := cCtx.StringSlice("types")
 			if contains(types, "client") {
-				for _, prod := range w.Module.Products {
-					mod := w.KnownModules[prod.Name]
-
+				for _, mod := range generated.Products {
 					result, err := ts.GenerateClient(mod, w.Context)
 					if err != nil {
 						return err
@@ -122,9 +135,7 @@ func (ts TypescriptBackend) GenerateCommand() *cli.Command {
 				}
 			}
 			if contains(types, "server") {
-				for _, prod := range w.Module.Products {
-					mod := w.KnownModules[prod.Name]
-
+				for _, mod := range generated.Products {
 					result, err := ts.GenerateServer(mod, w.Context)
 					if err != nil {
 						return err
@@ -135,6 +146,18 @@ func (ts TypescriptBackend) GenerateCommand() *cli.Command {
 						return err
 					}
 				}
+				// validators check the types of other modules with theirs
+				for _, mod := range generated.All() {
+					result, err := ts.GenerateValidators(mod, w.Context)
+					if err != nil {
+						return err
+					}
+
+					err = ioutil.WriteFile(path.Join(outdir, mod.Name+".validators.ts"), []byte(result), fs.ModePerm)
+					if err != nil {
+						return err
+					}
+				}
 			}
 
 			return nil
@@ -144,11 +167,30 @@ func (ts TypescriptBackend) GenerateCommand() *cli.Command {
 
 func (ts TypescriptBackend) GenerateTypes(mod *typechecking.Module, in *typechecking.Context) (string, error) {
 	build := backends.Filebuilder{}
-
+	s := newScope(mod)
+
+	for _, item := range mod.TypeAliases {
+		underlying := ts.TSTypeOf(item.Underlying, s, in)
+		// optional types can't be branded without losing null and undefined
+		_, optional := typechecking.Resolve(item.Underlying).(typechecking.OptionalType)
+		if item.Newtype && !optional {
+			build.Add(`export type %s = %s & { readonly __brand: "%s" }`, item.ObjectName(), underlying, item.Path().String())
+		} else {
+			build.Add(`export type %s = %s`, item.ObjectName(), underlying)
+		}
+	}
+	for _, item := range mod.Constants {
+		// branded types can only be made by asserting them
+		if alias, ok := item.Type.(*typechecking.TypeAlias); ok && alias.Newtype {
+			build.Add(`export const %s = %s as %s`, item.ObjectName(), ts.TSValueOf(item.Value, item.Type), ts.TSTypeOf(item.Type, s, in))
+		} else {
+			build.Add(`export const %s: %s = %s`, item.ObjectName(), ts.TSTypeOf(item.Type, s, in), ts.TSValueOf(item.Value, item.Type))
+		}
+	}
 	for _, item := range mod.Structs {
 		build.AddI("export interface %s {", item.ObjectName())
 		for _, field := range item.Fields {
-			build.Add(`%s: %s`, field.ObjectName(), ts.TSTypeOf(field.Type, mod.Path(), in))
+			build.Add(`%s: %s`, field.ObjectName(), ts.TSTypeOf(field.Type, s, in))
 		}
 		build.AddD("}")
 	}
@@ -165,7 +207,7 @@ func (ts TypescriptBackend) GenerateTypes(mod *typechecking.Module, in *typechec
 			} else {
 				build.AddE(`{ %s: {`, esac.ObjectName())
 				for _, field := range esac.Fields {
-					build.AddK(`%s: %s;`, field.ObjectName(), ts.TSTypeOf(field.Type, mod.Path(), in))
+					build.AddK(`%s: %s;`, field.ObjectName(), ts.TSTypeOf(field.Type, s, in))
 				}
 				build.AddK(`} }`)
 				if idx != len(item.Cases)-1 {
@@ -181,23 +223,26 @@ func (ts TypescriptBackend) GenerateTypes(mod *typechecking.Module, in *typechec
 		build.Add(`export type %s = string`, item.ObjectName())
 	}
 
-	return build.String(), nil
+	header := backends.Filebuilder{}
+	s.addImportsTo(&header, "", "types", s.referred)
+
+	return header.String() + build.String(), nil
 }
 
 func (ts TypescriptBackend) GenerateServer(mod *typechecking.Module, in *typechecking.Context) (string, error) {
 	build := backends.Filebuilder{}
 
-	build.Add(`import { Result, Transport, Stream } from '@lugma/server-helpers'`)
-	build.Add(`import { %s } from './%s.types'`, strings.Join(allNames(mod), ", "), mod.Name)
-	build.Add(`export * from './%s.types'`, mod.Name)
+	s := newScope(mod)
+	v := newValidation(s)
+	v.helper("Transport")
 
 	for _, stream := range mod.Streams {
-		build.AddI(`export interface %s<T> extends Stream<T> {`, stream.ObjectName())
+		build.AddI(`export interface %s<T> extends %s<T> {`, stream.ObjectName(), v.helper("Stream"))
 
 		for _, ev := range stream.Signals {
 			build.AddE(`on%s(callback: (`, strcase.ToCamel(ev.ObjectName()))
 			for idx, arg := range ev.Arguments {
-				build.AddK(`%s: %s`, arg.ObjectName(), ts.TSTypeOf(arg.Type, mod.Path(), in))
+				build.AddK(`%s: %s`, arg.ObjectName(), ts.TSTypeOf(arg.Type, s, in))
 				if idx != len(ev.Arguments)-1 {
 					build.AddK(`, `)
 				}
@@ -218,7 +263,7 @@ func (ts TypescriptBackend) GenerateServer(mod *typechecking.Module, in *typeche
 				build.AddI(`on%s: {`, strcase.ToCamel(ev.ObjectName()))
 				build.AddE(`value: function(callback: (`)
 				for idx, arg := range ev.Arguments {
-					build.AddK(`%s: %s`, arg.ObjectName(), ts.TSTypeOf(arg.Type, mod.Path(), in))
+					build.AddK(`%s: %s`, arg.ObjectName(), ts.TSTypeOf(arg.Type, s, in))
 					if idx != len(ev.Arguments)-1 {
 						build.AddK(`, `)
 					}
@@ -239,7 +284,7 @@ func (ts TypescriptBackend) GenerateServer(mod *typechecking.Module, in *typeche
 		build.AddD(`}`)
 
 		build.AddI(`export function bind%sToTransport<T>(transport: Transport<T>, slot: (stream: %s<T>) => void) {`, stream.ObjectName(), stream.ObjectName())
-		build.Add(`transport.bindStream('%s', (stream: Stream<T>) => slot(wrap%sFromStream(stream)))`, stream.Path().String(), stream.ObjectName())
+		build.Add(`transport.bindStream('%s', (stream: Stream<T>) => slot(wrap%sFromStream(stream)), streamCodecs.%s)`, stream.Path().String(), stream.ObjectName(), stream.ObjectName())
 		build.AddD(`}`)
 	}
 
@@ -247,7 +292,7 @@ func (ts TypescriptBackend) GenerateServer(mod *typechecking.Module, in *typeche
 	for _, fn := range mod.Funcs {
 		build.AddE(`%s(`, fn.ObjectName())
 		for _, arg := range fn.Arguments {
-			build.AddK(`%s: %s`, arg.ObjectName(), ts.TSTypeOf(arg.Type, mod.Path(), in))
+			build.AddK(`%s: %s`, arg.ObjectName(), ts.TSTypeOf(arg.Type, s, in))
 			build.AddK(`, `)
 		}
 		build.AddK(`extra: T | undefined`)
@@ -255,14 +300,14 @@ func (ts TypescriptBackend) GenerateServer(mod *typechecking.Module, in *typeche
 
 		ret := "void"
 		if fn.Returns != nil {
-			ret = ts.TSTypeOf(fn.Returns, mod.Path(), in)
+			ret = ts.TSTypeOf(fn.Returns, s, in)
 		}
 		fai := "void"
 		if fn.Throws != nil {
-			ret = ts.TSTypeOf(fn.Throws, mod.Path(), in)
+			ret = ts.TSTypeOf(fn.Throws, s, in)
 		}
 
-		build.AddK(`: Promise<Result<%s, %s>>`, ret, fai)
+		build.AddK(`: Promise<%s<%s, %s>>`, v.helper("Result"), ret, fai)
 
 		build.AddNL()
 	}
@@ -270,16 +315,34 @@ func (ts TypescriptBackend) GenerateServer(mod *typechecking.Module, in *typeche
 
 	build.AddI(`export function bindServerToTransport<T>(impl: Server<T>, transport: Transport<T>) {`)
 	for _, fn := range mod.Funcs {
-		build.AddE(`transport.bindMethod("%s", (content: any, extra: T | undefined) => impl.%s(`, fn.Path().String(), fn.ObjectName())
+		build.AddI(`transport.bindMethod("%s", async (content: any, extra: T | undefined) => {`, fn.Path().String())
+		build.Add(`const problems: %s[] = []`, v.helper("Problem"))
+		v.fields(&build, "content", `""`, fn.Arguments)
+		build.AddI(`if (problems.length > 0) {`)
+		build.Add(`return %s(problems)`, v.helper("badRequest"))
+		build.AddD(`}`)
+		build.AddE(`return impl.%s(`, fn.ObjectName())
 		for _, arg := range fn.Arguments {
 			build.AddK(`content['%s'], `, arg.ObjectName())
 		}
-		build.AddK(`extra))`)
+		build.AddK(`extra)`)
 		build.AddNL()
+		build.AddD(`}, functionCodecs.%s)`, fn.ObjectName())
 	}
 	build.AddD(`}`)
 
-	return build.String(), nil
+	header := backends.Filebuilder{}
+	v.addImportsTo(&header, mod, false)
+	if codecs := codecExports(mod); len(codecs) > 0 {
+		header.Add(`import { %s } from './%s.codecs'`, strings.Join(codecs, ", "), mod.Name)
+	}
+	if local := s.local(mod, s.referred); len(local) > 0 {
+		header.Add(`import { %s } from './%s.types'`, strings.Join(local, ", "), mod.Name)
+	}
+	s.addImportsTo(&header, "", "types", s.referred)
+	header.Add(`export * from './%s.types'`, mod.Name)
+
+	return header.String() + build.String(), nil
 }
 
 func allNames(mod *typechecking.Module) []string {
@@ -293,15 +356,19 @@ func allNames(mod *typechecking.Module) []string {
 	for _, item := range mod.Flagsets {
 		names = append(names, item.ObjectName())
 	}
+	for _, item := range mod.TypeAliases {
+		names = append(names, item.ObjectName())
+	}
+	for _, item := range mod.Constants {
+		names = append(names, item.ObjectName())
+	}
 	return names
 }
 
 func (ts TypescriptBackend) GenerateClient(mod *typechecking.Module, in *typechecking.Context) (string, error) {
 	build := backends.Filebuilder{}
 
-	build.Add(`import { Transport, Stream } from '@lugma/web-helpers'`)
-	build.Add(`import { %s } from './%s.types'`, strings.Join(allNames(mod), ", "), mod.Name)
-	build.Add(`export * from './%s.types'`, mod.Name)
+	s := newScope(mod)
 
 	for _, stream := range mod.Streams {
 		build.AddI(`export interface %s extends Stream {`, stream.ObjectName())
@@ -309,7 +376,7 @@ func (ts TypescriptBackend) GenerateClient(mod *typechecking.Module, in *typeche
 		for _, ev := range stream.Events {
 			build.AddE(`on%s(callback: (`, strcase.ToCamel(ev.ObjectName()))
 			for idx, arg := range ev.Arguments {
-				build.AddK(`%s: %s`, arg.ObjectName(), ts.TSTypeOf(arg.Type, mod.Path(), in))
+				build.AddK(`%s: %s`, arg.ObjectName(), ts.TSTypeOf(arg.Type, s, in))
 				if idx != len(ev.Arguments)-1 {
 					build.AddK(`, `)
 				}
@@ -323,14 +390,14 @@ func (ts TypescriptBackend) GenerateClient(mod *typechecking.Module, in *typeche
 		build.AddI(`export function open%sFromTransport<T>(transport: Transport<T>, extra: T | undefined): %s {`, stream.ObjectName(), stream.ObjectName())
 		{
 			build.AddI(`return Object.create(`)
-			build.Add(`transport.openStream("%s", extra),`, stream.Path().String())
+			build.Add(`transport.openStream("%s", extra, streamCodecs.%s),`, stream.Path().String(), stream.ObjectName())
 			build.AddI(`{`)
 
 			for _, ev := range stream.Events {
 				build.AddI(`on%s: {`, ev.ObjectName())
 				build.AddE(`value: function(callback: (`)
 				for idx, arg := range ev.Arguments {
-					build.AddK(`%s: %s`, arg.ObjectName(), ts.TSTypeOf(arg.Type, mod.Path(), in))
+					build.AddK(`%s: %s`, arg.ObjectName(), ts.TSTypeOf(arg.Type, s, in))
 					if idx != len(ev.Arguments)-1 {
 						build.AddK(`, `)
 					}
@@ -355,14 +422,10 @@ func (ts TypescriptBackend) GenerateClient(mod *typechecking.Module, in *typeche
 
 	for _, fn := range mod.Funcs {
 		build.AddE(`%s(`, fn.ObjectName())
-		for _, arg := range fn.Arguments {
-			build.AddK(`%s: %s`, arg.ObjectName(), ts.TSTypeOf(arg.Type, mod.Path(), in))
-			build.AddK(`, `)
-		}
-		build.AddK(`extra: T | undefined`)
+		ts.clientArguments(&build, fn.Arguments, s, in)
 		build.AddK(`)`)
 		if fn.Returns != nil {
-			build.AddK(`: Promise<%s>`, ts.TSTypeOf(fn.Returns, mod.Path(), in))
+			build.AddK(`: Promise<%s>`, ts.TSTypeOf(fn.Returns, s, in))
 		} else {
 			build.AddK(`: Promise<void>`)
 		}
@@ -374,15 +437,11 @@ func (ts TypescriptBackend) GenerateClient(mod *typechecking.Module, in *typeche
 	build.AddI(`return {`)
 	for _, fn := range mod.Funcs {
 		build.AddE(`async %s(`, fn.ObjectName())
-		for _, arg := range fn.Arguments {
-			build.AddK(`%s: %s`, arg.ObjectName(), ts.TSTypeOf(arg.Type, mod.Path(), in))
-			build.AddK(`, `)
-		}
-		build.AddK(`extra: T | undefined`)
+		ts.clientArguments(&build, fn.Arguments, s, in)
 		build.AddK(`)`)
 
 		if fn.Returns != nil {
-			build.AddK(`: Promise<%s>`, ts.TSTypeOf(fn.Returns, mod.Path(), in))
+			build.AddK(`: Promise<%s>`, ts.TSTypeOf(fn.Returns, s, in))
 		} else {
 			build.AddK(`: Promise<void>`)
 		}
@@ -400,6 +459,7 @@ func (ts TypescriptBackend) GenerateClient(mod *typechecking.Module, in *typeche
 		}
 		build.AddD(`},`)
 		build.Add(`extra,`)
+		build.Add(`functionCodecs.%s,`, fn.ObjectName())
 		build.AddD(`)`)
 
 		build.AddD(`},`)
@@ -408,5 +468,47 @@ func (ts TypescriptBackend) GenerateClient(mod *typechecking.Module, in *typeche
 	build.AddD(`}`)
 	build.AddD(`}`)
 
-	return build.String(), nil
+	header := backends.Filebuilder{}
+	if len(mod.Streams) > 0 {
+		header.Add(`import { Transport, Stream } from '@lugma/web-helpers'`)
+	} else {
+		header.Add(`import { Transport } from '@lugma/web-helpers'`)
+	}
+	if local := s.local(mod, s.referred); len(local) > 0 {
+		header.Add(`import { %s } from './%s.types'`, strings.Join(local, ", "), mod.Name)
+	}
+	if codecs := codecExports(mod); len(codecs) > 0 {
+		header.Add(`import { %s } from './%s.codecs'`, strings.Join(codecs, ", "), mod.Name)
+	}
+	s.addImportsTo(&header, "", "types", s.referred)
+	header.Add(`export * from './%s.types'`, mod.Name)
+
+	return header.String() + build.String(), nil
+}
+
+// clientArguments adds the parameters of a client method taking args. Arguments
+// with defaults can be left undefined, which leaves them out of the request
+// for the server to fill in, and can be omitted if only arguments with
+// defaults follow them.
+func (ts TypescriptBackend) clientArguments(build *backends.Filebuilder, args []*typechecking.Field, s *scope, in *typechecking.Context) {
+	trailing := len(args)
+	for trailing > 0 && args[trailing-1].Default != nil {
+		trailing--
+	}
+	for idx, arg := range args {
+		switch {
+		case idx >= trailing:
+			build.AddK(`%s?: %s`, arg.ObjectName(), ts.TSTypeOf(arg.Type, s, in))
+		case arg.Default != nil:
+			build.AddK(`%s: %s | undefined`, arg.ObjectName(), ts.TSTypeOf(arg.Type, s, in))
+		default:
+			build.AddK(`%s: %s`, arg.ObjectName(), ts.TSTypeOf(arg.Type, s, in))
+		}
+		build.AddK(`, `)
+	}
+	if trailing < len(args) {
+		build.AddK(`extra?: T`)
+	} else {
+		build.AddK(`extra: T | undefined`)
+	}
 }
@@ -0,0 +1,111 @@
+package typescript
+
+import (
+	"lugmac/modules"
+	"lugmac/typechecking"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var workspace = map[string]string{
+	"lugma.yaml": `name: W
+version: 1.0.0
+products:
+  - type: module
+    name: Base
+  - type: module
+    name: M
+    depends: [Base]
+  - type: module
+    name: N
+    depends: [Base]
+`,
+	"Sources/Base/Base.lugma": "struct ID {\n    let raw: String\n}\nstruct Unused {\n    let n: Int32\n}\nconst Max: Int32 = 5\n",
+	"Sources/M/M.lugma": `import "Base" as Base
+const Greeting: String = "hi"
+enum Role {
+    case admin
+    case user
+}
+struct User {
+    let id: Base.ID
+    let role: Role
+}
+func get(id: Base.ID) -> User
+stream Chat {
+    event said(text: String)
+}
+`,
+	"Sources/N/N.lugma": "import \"Base\" as Base\nconst Limit: Int32 = 1\nfunc ping()\n",
+}
+
+var importLine = regexp.MustCompile(`^import \{ (.*) \} from '(.*)'$`)
+
+// TestImportsAreUsed checks that every generated file only imports what it
+// uses, which TypeScript's noUnusedLocals requires.
+func TestImportsAreUsed(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range workspace {
+		file := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w, err := modules.LoadWorkspaceFrom(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.GenerateModules(); err != nil {
+		t.Fatal(err)
+	}
+
+	ts := TypescriptBackend{}
+	generators := map[string]func(*typechecking.Module, *typechecking.Context) (string, error){
+		"types":      ts.GenerateTypes,
+		"codecs":     ts.GenerateCodecs,
+		"validators": ts.GenerateValidators,
+		"client":     ts.GenerateClient,
+		"server":     ts.GenerateServer,
+	}
+	for _, name := range []string{"Base", "M", "N"} {
+		mod := w.KnownModules[name]
+		for kind, generate := range generators {
+			file := name + "." + kind + ".ts"
+			out, err := generate(mod, w.Context)
+			if err != nil {
+				t.Fatalf("%s: %s", file, err)
+			}
+
+			var body []string
+			var imported []string
+			for _, line := range strings.Split(out, "\n") {
+				match := importLine.FindStringSubmatch(line)
+				if match == nil {
+					body = append(body, line)
+					continue
+				}
+				if strings.TrimSpace(match[1]) == "" {
+					t.Errorf("%s imports nothing from %s", file, match[2])
+					continue
+				}
+				for _, item := range strings.Split(match[1], ", ") {
+					fields := strings.Fields(item)
+					imported = append(imported, fields[len(fields)-1])
+				}
+			}
+
+			rest := strings.Join(body, "\n")
+			for _, name := range imported {
+				if !regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\b`).MatchString(rest) {
+					t.Errorf("%s imports %s without using it:\n%s", file, name, out)
+				}
+			}
+		}
+	}
+}
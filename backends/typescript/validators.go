@@ -0,0 +1,144 @@
+package typescript
+
+import (
+	"fmt"
+	"lugmac/backends"
+	"lugmac/typechecking"
+	"sort"
+	"strings"
+)
+
+// validation writes code validating values of a module's types, keeping
+// track of what the file it's written to needs to import.
+type validation struct {
+	scope *scope
+
+	// runtime is the names used from the helpers package.
+	runtime map[string]struct{}
+	// referred is the types whose validators are used.
+	referred usage
+}
+
+func newValidation(s *scope) *validation {
+	return &validation{s, map[string]struct{}{}, usage{}}
+}
+
+func (v *validation) helper(name string) string {
+	v.runtime[name] = struct{}{}
+	return name
+}
+
+// of returns an expression for a validator of typ.
+func (v *validation) of(typ typechecking.Type) string {
+	switch k := typ.(type) {
+	case typechecking.PrimitiveType:
+		return v.helper("validate" + k.String())
+	case typechecking.ArrayType:
+		return fmt.Sprintf("%s(%s)", v.helper("validateArray"), v.of(k.Element))
+	case typechecking.OptionalType:
+		return fmt.Sprintf("%s(%s)", v.helper("validateOptional"), v.of(k.Element))
+	case typechecking.DictionaryType:
+		keyOf := ""
+		if key, ok := typechecking.Resolve(k.Key).(typechecking.PrimitiveType); ok {
+			switch key {
+			case typechecking.UInt8, typechecking.UInt16, typechecking.UInt32, typechecking.Int8, typechecking.Int16, typechecking.Int32:
+				keyOf = ", " + v.helper("numericKey")
+			case typechecking.Bool:
+				keyOf = ", " + v.helper("booleanKey")
+			}
+		}
+		return fmt.Sprintf("%s(%s, %s%s)", v.helper("validateDictionary"), v.of(k.Key), v.of(k.Element), keyOf)
+	case *typechecking.Struct, *typechecking.Enum, *typechecking.Flagset, *typechecking.TypeAlias:
+		v.referred.add(k)
+		return "validate" + v.scope.nameOf(k)
+	default:
+		panic("unhandled " + k.String())
+	}
+}
+
+// fields adds statements validating that value, at path, is an object with
+// fields. Missing fields that have defaults are filled in first.
+func (v *validation) fields(build *backends.Filebuilder, value, path string, fields []*typechecking.Field) {
+	if len(fields) == 0 {
+		build.Add(`%s(%s, %s, problems)`, v.helper("validateObject"), value, path)
+		return
+	}
+	build.AddI(`if (%s(%s, %s, problems)) {`, v.helper("validateObject"), value, path)
+	for _, field := range fields {
+		if field.Default != nil {
+			build.Add(`if (%s["%s"] === undefined) %s["%s"] = %s`, value, field.ObjectName(), value, field.ObjectName(), backends.WireJSON(field.Default, field.Type))
+		}
+		build.Add(`%s(%s, "%s", %s, %s, problems)`, v.helper("validateField"), value, field.ObjectName(), v.of(field.Type), path)
+	}
+	build.AddD(`}`)
+}
+
+// addImportsTo adds imports of what's been used to build. Validators of the
+// module's own types are imported if they're defined in another file.
+func (v *validation) addImportsTo(build *backends.Filebuilder, mod *typechecking.Module, fromOwnFile bool) {
+	var runtime []string
+	for name := range v.runtime {
+		runtime = append(runtime, name)
+	}
+	sort.Strings(runtime)
+	if len(runtime) > 0 {
+		build.Add(`import { %s } from '@lugma/server-helpers'`, strings.Join(runtime, ", "))
+	}
+
+	if local := v.scope.local(mod, v.referred); !fromOwnFile && len(local) > 0 {
+		for idx := range local {
+			local[idx] = "validate" + local[idx]
+		}
+		build.Add(`import { %s } from './%s.validators'`, strings.Join(local, ", "), mod.Name)
+	}
+
+	v.scope.addImportsTo(build, "validate", "validators", v.referred)
+}
+
+// GenerateValidators generates functions checking that values received over
+// the wire are valid for the module's types.
+func (ts TypescriptBackend) GenerateValidators(mod *typechecking.Module, in *typechecking.Context) (string, error) {
+	body := backends.Filebuilder{}
+	v := newValidation(newScope(mod))
+
+	for _, item := range mod.TypeAliases {
+		body.AddI(`export function validate%s(value: any, path: string, problems: %s[]): void {`, item.ObjectName(), v.helper("Problem"))
+		body.Add(`%s(value, path, problems)`, v.of(item.Underlying))
+		body.AddD(`}`)
+	}
+	for _, item := range mod.Structs {
+		body.AddI(`export function validate%s(value: any, path: string, problems: %s[]): void {`, item.ObjectName(), v.helper("Problem"))
+		v.fields(&body, "value", "path", item.Fields)
+		body.AddD(`}`)
+	}
+	for _, item := range mod.Enums {
+		body.AddI(`export function validate%s(value: any, path: string, problems: %s[]): void {`, item.ObjectName(), v.helper("Problem"))
+		if item.Simple() {
+			var cases []string
+			for _, esac := range item.Cases {
+				cases = append(cases, fmt.Sprintf(`"%s"`, esac.ObjectName()))
+			}
+			body.Add(`%s([%s])(value, path, problems)`, v.helper("validateOneOf"), strings.Join(cases, ", "))
+		} else {
+			body.AddI(`%s({`, v.helper("validateCases"))
+			for _, esac := range item.Cases {
+				body.AddI(`%s: (value: any, path: string, problems: Problem[]) => {`, esac.ObjectName())
+				v.fields(&body, "value", "path", esac.Fields)
+				body.AddD(`},`)
+			}
+			body.AddD(`})(value, path, problems)`)
+		}
+		body.AddD(`}`)
+	}
+	for _, item := range mod.Flagsets {
+		body.AddI(`export function validate%s(value: any, path: string, problems: %s[]): void {`, item.ObjectName(), v.helper("Problem"))
+		body.Add(`%s(%d)(value, path, problems)`, v.helper("validateFlags"), len(item.Flags))
+		body.AddD(`}`)
+	}
+
+	build := backends.Filebuilder{}
+	v.addImportsTo(&build, mod, true)
+	build.WriteString(body.String())
+
+	return build.String(), nil
+}
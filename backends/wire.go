@@ -0,0 +1,59 @@
+package backends
+
+import (
+	"encoding/json"
+	"lugmac/typechecking"
+	"strconv"
+	"strings"
+)
+
+// WireJSON returns value, which is a value of typ, encoded as JSON the way
+// it's sent on the wire.
+func WireJSON(value typechecking.Value, typ typechecking.Type) string {
+	typ = typechecking.Resolve(typ)
+	if optional, ok := typ.(typechecking.OptionalType); ok {
+		return WireJSON(value, optional.Element)
+	}
+
+	switch v := value.(type) {
+	case typechecking.IntegerValue:
+		switch typ {
+		case typechecking.Int64, typechecking.UInt64:
+			return strconv.Quote(v.String())
+		default:
+			return v.String()
+		}
+	case typechecking.NumberValue:
+		return strconv.FormatFloat(float64(v), 'g', -1, 64)
+	case typechecking.StringValue:
+		var sb strings.Builder
+		enc := json.NewEncoder(&sb)
+		enc.SetEscapeHTML(false)
+		enc.Encode(string(v))
+		return strings.TrimSuffix(sb.String(), "\n")
+	case typechecking.BoolValue:
+		return strconv.FormatBool(bool(v))
+	case typechecking.ListValue:
+		var element typechecking.Type
+		if array, ok := typ.(typechecking.ArrayType); ok {
+			element = array.Element
+		}
+		var items []string
+		for _, item := range v {
+			items = append(items, WireJSON(item, element))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case typechecking.DictionaryValue:
+		var element typechecking.Type
+		if dict, ok := typ.(typechecking.DictionaryType); ok {
+			element = dict.Element
+		}
+		var items []string
+		for _, entry := range v {
+			items = append(items, WireJSON(typechecking.StringValue(entry.Key), typechecking.String)+": "+WireJSON(entry.Value, element))
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	default:
+		panic("unhandled value " + value.String())
+	}
+}
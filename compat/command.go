@@ -0,0 +1,79 @@
+package compat
+
+import (
+	"fmt"
+	"lugmac/modules"
+
+	"github.com/urfave/cli/v2"
+)
+
+func load(dir string) (*modules.Workspace, error) {
+	w, err := modules.LoadWorkspaceFrom(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.GenerateModules()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check workspace at %s:\n%w", dir, err)
+	}
+
+	return w, nil
+}
+
+var Command = &cli.Command{
+	Name:      "diff",
+	Usage:     "Find changes between two versions of a workspace that would break clients",
+	ArgsUsage: "<old workspace> <new workspace>",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "check-version",
+			Usage: "Fail if the version in the new lugma.yaml isn't bumped enough for the changes under semver",
+		},
+		&cli.BoolFlag{
+			Name:  "fail-on-breaking",
+			Usage: "Fail if there are any breaking changes",
+		},
+	},
+	Action: func(cCtx *cli.Context) error {
+		if cCtx.NArg() != 2 {
+			return cli.Exit("diff needs the directories of the old and new workspaces", 1)
+		}
+
+		old, err := load(cCtx.Args().Get(0))
+		if err != nil {
+			return err
+		}
+		new, err := load(cCtx.Args().Get(1))
+		if err != nil {
+			return err
+		}
+
+		changes := Compare(old.KnownModules, new.KnownModules)
+		for _, change := range changes {
+			fmt.Println(change)
+		}
+
+		if cCtx.Bool("check-version") {
+			oldVersion, err := ParseVersion(old.Module.Version)
+			if err != nil {
+				return fmt.Errorf("bad version in old lugma.yaml: %w", err)
+			}
+			newVersion, err := ParseVersion(new.Module.Version)
+			if err != nil {
+				return fmt.Errorf("bad version in new lugma.yaml: %w", err)
+			}
+
+			err = CheckBump(oldVersion, newVersion, changes)
+			if err != nil {
+				return cli.Exit(err.Error(), 1)
+			}
+		}
+
+		if cCtx.Bool("fail-on-breaking") && changes.Breaking() {
+			return cli.Exit("breaking changes found", 1)
+		}
+
+		return nil
+	},
+}
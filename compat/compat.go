@@ -0,0 +1,471 @@
+// Package compat compares two versions of a workspace to find changes that
+// would break clients speaking the older version over the wire.
+package compat
+
+import (
+	"fmt"
+	"lugmac/typechecking"
+	"sort"
+)
+
+type Severity int
+
+const (
+	// Compatible changes can be understood by clients of either version.
+	Compatible Severity = iota
+	// Breaking changes can make clients of the old version fail.
+	Breaking
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Compatible:
+		return "compatible"
+	case Breaking:
+		return "breaking"
+	default:
+		panic("Unhandled severity")
+	}
+}
+
+// Change is a difference between two versions of a workspace.
+type Change struct {
+	Severity Severity
+	// Item is the dotted name of what changed, such as Module.Struct.field.
+	Item    string
+	Message string
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s: %s", c.Severity, c.Item, c.Message)
+}
+
+type Changes []Change
+
+func (c Changes) Breaking() bool {
+	for _, change := range c {
+		if change.Severity == Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// direction is which way values of a type travel over the wire, which
+// decides whether making them optional or required is compatible.
+type direction int
+
+const (
+	// toServer values are written by clients, like function arguments.
+	toServer direction = iota
+	// toClient values are written by servers, like return values.
+	toClient
+	// both values travel either way, like struct fields.
+	both
+)
+
+// combine returns the direction of values travelling both in a and in b.
+func combine(a, b direction) direction {
+	if a == b {
+		return a
+	}
+	return both
+}
+
+type comparer struct {
+	changes Changes
+
+	// flows holds the directions values of the declarations in either
+	// version travel in, keyed by their paths. Declarations that aren't used
+	// by any function or stream aren't in it.
+	flows map[typechecking.Path]direction
+}
+
+func (c *comparer) add(severity Severity, item, format string, args ...interface{}) {
+	c.changes = append(c.changes, Change{severity, item, fmt.Sprintf(format, args...)})
+}
+
+// Compare returns the changes made to the modules in old to get those in
+// new, keyed by name.
+func Compare(old, new map[string]*typechecking.Module) Changes {
+	c := &comparer{flows: map[typechecking.Path]direction{}}
+	c.flow(old)
+	c.flow(new)
+
+	for _, name := range sortedKeys(old) {
+		if _, ok := new[name]; !ok {
+			c.add(Breaking, name, "module removed")
+			continue
+		}
+		c.module(name, old[name], new[name])
+	}
+	for _, name := range sortedKeys(new) {
+		if _, ok := old[name]; !ok {
+			c.add(Compatible, name, "module added")
+		}
+	}
+
+	return c.changes
+}
+
+// flow records the directions the values of declarations used by the
+// functions and streams of modules travel in.
+func (c *comparer) flow(modules map[string]*typechecking.Module) {
+	for _, mod := range modules {
+		for _, fn := range mod.Funcs {
+			c.flowFields(fn.Arguments, toServer)
+			c.flowType(fn.Returns, toClient)
+			c.flowType(fn.Throws, toClient)
+		}
+		for _, stream := range mod.Streams {
+			for _, ev := range stream.Events {
+				c.flowFields(ev.Arguments, toClient)
+			}
+			for _, sig := range stream.Signals {
+				c.flowFields(sig.Arguments, toServer)
+			}
+		}
+	}
+}
+
+func (c *comparer) flowFields(fields []*typechecking.Field, dir direction) {
+	for _, field := range fields {
+		c.flowType(field.Type, dir)
+	}
+}
+
+func (c *comparer) flowType(typ typechecking.Type, dir direction) {
+	switch t := typ.(type) {
+	case typechecking.ArrayType:
+		c.flowType(t.Element, dir)
+		return
+	case typechecking.DictionaryType:
+		c.flowType(t.Key, dir)
+		c.flowType(t.Element, dir)
+		return
+	case typechecking.OptionalType:
+		c.flowType(t.Element, dir)
+		return
+	case *typechecking.TypeAlias, *typechecking.Struct, *typechecking.Enum, *typechecking.Flagset:
+	default:
+		return
+	}
+
+	// declarations are only gone through again when they're found to travel
+	// in another direction
+	if previous, ok := c.flows[typ.Path()]; ok {
+		if combine(previous, dir) == previous {
+			return
+		}
+		dir = combine(previous, dir)
+	}
+	c.flows[typ.Path()] = dir
+
+	switch t := typ.(type) {
+	case *typechecking.TypeAlias:
+		c.flowType(t.Underlying, dir)
+	case *typechecking.Struct:
+		c.flowFields(t.Fields, dir)
+	case *typechecking.Enum:
+		for _, esac := range t.Cases {
+			c.flowFields(esac.Fields, dir)
+		}
+	}
+}
+
+// flowOf returns the direction values of the declaration at path travel in.
+// Those that aren't used by any function or stream may be by other
+// workspaces, so they're taken to travel both ways.
+func (c *comparer) flowOf(path typechecking.Path) direction {
+	if dir, ok := c.flows[path]; ok {
+		return dir
+	}
+	return both
+}
+
+func sortedKeys(m map[string]*typechecking.Module) []string {
+	var ret []string
+	for k := range m {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// named matches up items by name, calling removed, added, or same for each.
+func named[T typechecking.Object](old, new []T, removed, added func(T), same func(old, new T)) {
+	for _, o := range old {
+		found := false
+		for _, n := range new {
+			if o.ObjectName() == n.ObjectName() {
+				same(o, n)
+				found = true
+				break
+			}
+		}
+		if !found {
+			removed(o)
+		}
+	}
+	for _, n := range new {
+		found := false
+		for _, o := range old {
+			if o.ObjectName() == n.ObjectName() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			added(n)
+		}
+	}
+}
+
+// declarations compares declarations of one kind in a module, which only
+// break clients when they're removed.
+func declarations[T typechecking.Object](c *comparer, module, kind string, old, new []T, same func(item string, old, new T)) {
+	named(old, new,
+		func(o T) { c.add(Breaking, module+"."+o.ObjectName(), "%s removed", kind) },
+		func(n T) { c.add(Compatible, module+"."+n.ObjectName(), "%s added", kind) },
+		func(o, n T) { same(module+"."+o.ObjectName(), o, n) },
+	)
+}
+
+func (c *comparer) module(name string, old, new *typechecking.Module) {
+	declarations(c, name, "struct", old.Structs, new.Structs, func(item string, o, n *typechecking.Struct) {
+		c.fields(item, "field", o.Fields, n.Fields, both)
+	})
+	declarations(c, name, "enum", old.Enums, new.Enums, func(item string, o, n *typechecking.Enum) {
+		c.enum(item, o, n, c.flowOf(n.Path()))
+	})
+	declarations(c, name, "flagset", old.Flagsets, new.Flagsets, c.flagset)
+	declarations(c, name, "func", old.Funcs, new.Funcs, c.function)
+	declarations(c, name, "stream", old.Streams, new.Streams, c.stream)
+	declarations(c, name, "type alias", old.TypeAliases, new.TypeAliases, func(item string, o, n *typechecking.TypeAlias) {
+		c.types(item, "underlying type", o.Underlying, n.Underlying, both)
+	})
+	declarations(c, name, "constant", old.Constants, new.Constants, c.constant)
+}
+
+func (c *comparer) constant(item string, old, new *typechecking.Constant) {
+	if !sameType(old.Type, new.Type) {
+		c.add(Breaking, item, "type changed from %s to %s", old.Type, new.Type)
+	}
+	// clients built with the old value keep using it
+	if old.Value != nil && new.Value != nil && old.Value.String() != new.Value.String() {
+		c.add(Breaking, item, "value changed from %s to %s", old.Value, new.Value)
+	}
+}
+
+// enum compares an enum whose values travel in direction dir. Readers reject
+// cases they don't know, so adding one breaks clients that receive them.
+func (c *comparer) enum(item string, old, new *typechecking.Enum, dir direction) {
+	if old.Simple() != new.Simple() {
+		c.add(Breaking, item, "cases changed from %s to %s, which changes how every case is encoded", simplicity(old), simplicity(new))
+	}
+
+	named(old.Cases, new.Cases,
+		func(o *typechecking.Case) { c.add(Breaking, item+"."+o.ObjectName(), "enum case removed") },
+		func(n *typechecking.Case) {
+			if dir == toServer {
+				c.add(Compatible, item+"."+n.ObjectName(), "enum case added")
+			} else {
+				c.add(Breaking, item+"."+n.ObjectName(), "enum case added, which clients of the old version can't read")
+			}
+		},
+		func(o, n *typechecking.Case) {
+			c.tag(item+"."+o.ObjectName(), o.Tag, n.Tag)
+			c.fields(item+"."+o.ObjectName(), "case value", o.Fields, n.Fields, both)
+		},
+	)
+}
+
+func simplicity(e *typechecking.Enum) string {
+	if e.Simple() {
+		return "having no values"
+	}
+	return "having values"
+}
+
+func (c *comparer) flagset(item string, old, new *typechecking.Flagset) {
+	// flags are encoded by their position, so moving one changes its meaning
+	for i, o := range old.Flags {
+		moved := -1
+		for j, n := range new.Flags {
+			if n.ObjectName() == o.ObjectName() {
+				moved = j
+			}
+		}
+		switch {
+		case moved == -1:
+			c.add(Breaking, item+"."+o.ObjectName(), "flag removed")
+		case moved != i:
+			c.add(Breaking, item+"."+o.ObjectName(), "flag moved from bit %d to bit %d", i, moved)
+		}
+	}
+	for j, n := range new.Flags {
+		if old.Child(n.ObjectName()) != nil {
+			continue
+		}
+		if j < len(old.Flags) {
+			c.add(Breaking, item+"."+n.ObjectName(), "flag added at bit %d, which was already in use", j)
+		} else {
+			c.add(Compatible, item+"."+n.ObjectName(), "flag added")
+		}
+	}
+}
+
+func (c *comparer) function(item string, old, new *typechecking.Func) {
+	c.fields(item, "argument", old.Arguments, new.Arguments, toServer)
+
+	switch {
+	case old.Returns == nil && new.Returns != nil:
+		c.add(Breaking, item, "now returns %s", new.Returns)
+	case old.Returns != nil && new.Returns == nil:
+		c.add(Breaking, item, "no longer returns %s", old.Returns)
+	case old.Returns != nil:
+		c.types(item, "return type", old.Returns, new.Returns, toClient)
+	}
+
+	switch {
+	case old.Throws == nil && new.Throws != nil:
+		c.add(Breaking, item, "now throws %s", new.Throws)
+	case old.Throws != nil && new.Throws == nil:
+		c.add(Compatible, item, "no longer throws %s", old.Throws)
+	case old.Throws != nil:
+		c.types(item, "thrown type", old.Throws, new.Throws, toClient)
+	}
+}
+
+func (c *comparer) stream(item string, old, new *typechecking.Stream) {
+	named(old.Events, new.Events,
+		func(o *typechecking.Event) { c.add(Breaking, item+"."+o.ObjectName(), "stream event removed") },
+		func(n *typechecking.Event) { c.add(Compatible, item+"."+n.ObjectName(), "stream event added") },
+		func(o, n *typechecking.Event) {
+			c.fields(item+"."+o.ObjectName(), "argument", o.Arguments, n.Arguments, toClient)
+		},
+	)
+	named(old.Signals, new.Signals,
+		func(o *typechecking.Signal) { c.add(Breaking, item+"."+o.ObjectName(), "stream signal removed") },
+		func(n *typechecking.Signal) { c.add(Compatible, item+"."+n.ObjectName(), "stream signal added") },
+		func(o, n *typechecking.Signal) {
+			c.fields(item+"."+o.ObjectName(), "argument", o.Arguments, n.Arguments, toServer)
+		},
+	)
+}
+
+func (c *comparer) fields(item, kind string, old, new []*typechecking.Field, dir direction) {
+	named(old, new,
+		func(o *typechecking.Field) {
+			c.add(Breaking, item+"."+o.Name, "%s removed", kind)
+		},
+		func(n *typechecking.Field) {
+			if _, ok := typechecking.Resolve(n.Type).(typechecking.OptionalType); ok && dir != toClient {
+				c.add(Compatible, item+"."+n.Name, "optional %s added", kind)
+			} else if n.Default != nil && dir != toClient {
+				c.add(Compatible, item+"."+n.Name, "%s with a default added", kind)
+			} else {
+				c.add(Breaking, item+"."+n.Name, "required %s added", kind)
+			}
+		},
+		func(o, n *typechecking.Field) {
+			c.types(item+"."+o.Name, kind, o.Type, n.Type, dir)
+			c.defaults(item+"."+o.Name, kind, o, n, dir)
+			c.tag(item+"."+o.Name, o.Tag, n.Tag)
+		},
+	)
+}
+
+// tag compares the tags of a field or case, which identify it in binary
+// encodings.
+func (c *comparer) tag(item string, old, new int) {
+	switch {
+	case old == new:
+	case old == 0:
+		c.add(Compatible, item, "tagged %d", new)
+	case new == 0:
+		c.add(Breaking, item, "tag %d removed", old)
+	default:
+		c.add(Breaking, item, "tag changed from %d to %d", old, new)
+	}
+}
+
+// defaults compares the default values of a field sent in direction dir.
+// Readers fill in defaults, so only fields read by servers are affected.
+func (c *comparer) defaults(item, kind string, old, new *typechecking.Field, dir direction) {
+	if dir == toClient {
+		return
+	}
+	switch {
+	case old.Default != nil && new.Default == nil:
+		if _, ok := typechecking.Resolve(new.Type).(typechecking.OptionalType); !ok {
+			c.add(Breaking, item, "%s no longer has a default", kind)
+		}
+	case old.Default == nil && new.Default != nil:
+		c.add(Compatible, item, "%s now defaults to %s", kind, new.Default)
+	case old.Default != nil && old.Default.String() != new.Default.String():
+		c.add(Compatible, item, "%s default changed from %s to %s", kind, old.Default, new.Default)
+	}
+}
+
+// types compares the type of something sent in direction dir.
+func (c *comparer) types(item, kind string, old, new typechecking.Type, dir direction) {
+	if sameType(old, new) {
+		return
+	}
+
+	oldOptional, isOldOptional := typechecking.Resolve(old).(typechecking.OptionalType)
+	newOptional, isNewOptional := typechecking.Resolve(new).(typechecking.OptionalType)
+
+	switch {
+	case isNewOptional && !isOldOptional && sameType(old, newOptional.Element):
+		if dir == toServer {
+			c.add(Compatible, item, "%s changed from required to optional", kind)
+		} else {
+			c.add(Breaking, item, "%s changed from required to optional", kind)
+		}
+	case isOldOptional && !isNewOptional && sameType(oldOptional.Element, new):
+		if dir == toClient {
+			c.add(Compatible, item, "%s changed from optional to required", kind)
+		} else {
+			c.add(Breaking, item, "%s changed from optional to required", kind)
+		}
+	default:
+		c.add(Breaking, item, "%s changed from %s to %s", kind, old, new)
+	}
+}
+
+func sameType(a, b typechecking.Type) bool {
+	// aliases are sent as the types they stand for, so they're only told
+	// apart from them when they're both aliases
+	if alias, ok := a.(*typechecking.TypeAlias); ok {
+		if other, ok := b.(*typechecking.TypeAlias); ok && alias.Path() == other.Path() {
+			return true
+		}
+	}
+	a, b = typechecking.Resolve(a), typechecking.Resolve(b)
+
+	switch a := a.(type) {
+	case typechecking.PrimitiveType:
+		b, ok := b.(typechecking.PrimitiveType)
+		return ok && a == b
+	case typechecking.ArrayType:
+		b, ok := b.(typechecking.ArrayType)
+		return ok && sameType(a.Element, b.Element)
+	case typechecking.DictionaryType:
+		b, ok := b.(typechecking.DictionaryType)
+		return ok && sameType(a.Key, b.Key) && sameType(a.Element, b.Element)
+	case typechecking.OptionalType:
+		b, ok := b.(typechecking.OptionalType)
+		return ok && sameType(a.Element, b.Element)
+	case nil:
+		return b == nil
+	default:
+		if b == nil {
+			return false
+		}
+		// named types are the same if they're declared in the same place,
+		// even if what's in them changed; that's compared separately.
+		return a.Path() == b.Path()
+	}
+}
@@ -0,0 +1,202 @@
+package compat
+
+import (
+	"lugmac/ast"
+	"lugmac/typechecking"
+	"testing"
+)
+
+// checked checks source as a module named M.
+func checked(t *testing.T, source string) map[string]*typechecking.Module {
+	t.Helper()
+	tree, err := ast.Parse([]byte(source))
+	if err != nil {
+		t.Fatalf("failed to parse:\n%s", err)
+	}
+	tree.Path = "M.lugma"
+	m, err := typechecking.NewContext(typechecking.FileImportResolver).Module(tree, "W/M")
+	if err != nil {
+		t.Fatalf("failed to check:\n%s", err)
+	}
+	return map[string]*typechecking.Module{"M": m}
+}
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		name     string
+		old, new string
+		// want are the changes found, as strings.
+		want []string
+	}{
+		{
+			name: "enum case added to a returned enum",
+			old:  "enum E {\n case a\n}\nfunc f() -> E",
+			new:  "enum E {\n case a\n case b\n}\nfunc f() -> E",
+			want: []string{"breaking: M.E.b: enum case added, which clients of the old version can't read"},
+		},
+		{
+			name: "enum case added to a thrown enum",
+			old:  "enum E {\n case a\n}\nfunc f() throws E",
+			new:  "enum E {\n case a\n case b\n}\nfunc f() throws E",
+			want: []string{"breaking: M.E.b: enum case added, which clients of the old version can't read"},
+		},
+		{
+			name: "enum case added to an enum sent in an event",
+			old:  "enum E {\n case a\n}\nstream S {\n event e(e: E)\n}",
+			new:  "enum E {\n case a\n case b\n}\nstream S {\n event e(e: E)\n}",
+			want: []string{"breaking: M.E.b: enum case added, which clients of the old version can't read"},
+		},
+		{
+			name: "enum case added to an enum only sent to servers",
+			old:  "enum E {\n case a\n}\nstruct A {\n let e: E\n}\nfunc f(a: A)",
+			new:  "enum E {\n case a\n case b\n}\nstruct A {\n let e: E\n}\nfunc f(a: A)",
+			want: []string{"compatible: M.E.b: enum case added"},
+		},
+		{
+			name: "enum case added to an enum sent both ways",
+			old:  "enum E {\n case a\n}\nfunc f(e: E)\nfunc g() -> [E]",
+			new:  "enum E {\n case a\n case b\n}\nfunc f(e: E)\nfunc g() -> [E]",
+			want: []string{"breaking: M.E.b: enum case added, which clients of the old version can't read"},
+		},
+		{
+			name: "enum case added to an enum no function uses",
+			old:  "enum E {\n case a\n}",
+			new:  "enum E {\n case a\n case b\n}",
+			want: []string{"breaking: M.E.b: enum case added, which clients of the old version can't read"},
+		},
+		{
+			name: "enum case removed",
+			old:  "enum E {\n case a\n case b\n}\nfunc f(e: E)",
+			new:  "enum E {\n case a\n}\nfunc f(e: E)",
+			want: []string{"breaking: M.E.b: enum case removed"},
+		},
+		{
+			name: "optional field added",
+			old:  "struct S {\n let a: String\n}",
+			new:  "struct S {\n let a: String\n let b: String?\n}",
+			want: []string{"compatible: M.S.b: optional field added"},
+		},
+		{
+			name: "required argument added",
+			old:  "func f(a: String)",
+			new:  "func f(a: String, b: String)",
+			want: []string{"breaking: M.f.b: required argument added"},
+		},
+		{
+			name: "argument with a default added",
+			old:  "func f(a: String)",
+			new:  "func f(a: String, b: Int32 = 1)",
+			want: []string{"compatible: M.f.b: argument with a default added"},
+		},
+		{
+			name: "return type made optional",
+			old:  "func f() -> String",
+			new:  "func f() -> String?",
+			want: []string{"breaking: M.f: return type changed from required to optional"},
+		},
+		{
+			name: "flag added at the end",
+			old:  "flagset F {\n flag a\n}",
+			new:  "flagset F {\n flag a\n flag b\n}",
+			want: []string{"compatible: M.F.b: flag added"},
+		},
+		{
+			name: "flag added before another",
+			old:  "flagset F {\n flag a\n}",
+			new:  "flagset F {\n flag b\n flag a\n}",
+			want: []string{
+				"breaking: M.F.a: flag moved from bit 0 to bit 1",
+				"breaking: M.F.b: flag added at bit 0, which was already in use",
+			},
+		},
+		{
+			name: "function now throws",
+			old:  "struct Oops {\n let why: String\n}\nfunc f()",
+			new:  "struct Oops {\n let why: String\n}\nfunc f() throws Oops",
+			want: []string{"breaking: M.f: now throws Oops"},
+		},
+		{
+			name: "struct removed",
+			old:  "struct S {\n let a: String\n}",
+			new:  "",
+			want: []string{"breaking: M.S: struct removed"},
+		},
+		{
+			name: "nothing changed",
+			old:  "struct S {\n let a: String\n}\nfunc f(s: S) -> S",
+			new:  "struct S {\n let a: String\n}\nfunc f(s: S) -> S",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			changes := Compare(checked(t, c.old), checked(t, c.new))
+			var got []string
+			for _, change := range changes {
+				got = append(got, change.String())
+			}
+			if len(got) != len(c.want) {
+				t.Fatalf("expected %q, got %q", c.want, got)
+			}
+			for i := range got {
+				if got[i] != c.want[i] {
+					t.Errorf("expected %q, got %q", c.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCheckBump(t *testing.T) {
+	compatible := Changes{{Compatible, "M.S.b", "optional field added"}}
+	breaking := Changes{{Breaking, "M.S", "struct removed"}}
+
+	cases := []struct {
+		name     string
+		old, new Version
+		changes  Changes
+		ok       bool
+	}{
+		{"no changes, same version", Version{1, 2, 3}, Version{1, 2, 3}, nil, true},
+		{"no changes, patch bump", Version{1, 2, 3}, Version{1, 2, 4}, nil, true},
+		{"no changes, version went backwards", Version{1, 2, 3}, Version{1, 2, 2}, nil, false},
+		{"compatible changes, patch bump", Version{1, 2, 3}, Version{1, 2, 4}, compatible, false},
+		{"compatible changes, minor bump", Version{1, 2, 3}, Version{1, 3, 0}, compatible, true},
+		{"breaking changes, minor bump", Version{1, 2, 3}, Version{1, 3, 0}, breaking, false},
+		{"breaking changes, major bump", Version{1, 2, 3}, Version{2, 0, 0}, breaking, true},
+		{"breaking changes before 1.0.0, minor bump", Version{0, 2, 3}, Version{0, 3, 0}, breaking, true},
+		{"breaking changes before 1.0.0, patch bump", Version{0, 2, 3}, Version{0, 2, 4}, breaking, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := CheckBump(c.old, c.new, c.changes)
+			if c.ok && err != nil {
+				t.Errorf("expected %s to %s to be enough, got %s", c.old, c.new, err)
+			} else if !c.ok && err == nil {
+				t.Errorf("expected %s to %s not to be enough", c.old, c.new)
+			}
+		})
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	cases := []struct {
+		in   string
+		want Version
+		ok   bool
+	}{
+		{"1.2.3", Version{1, 2, 3}, true},
+		{"v0.1.0", Version{0, 1, 0}, true},
+		{"1.2.3-beta.1+build", Version{1, 2, 3}, true},
+		{"1.2", Version{}, false},
+		{"1.x.3", Version{}, false},
+		{"1.-2.3", Version{}, false},
+	}
+	for _, c := range cases {
+		got, err := ParseVersion(c.in)
+		if c.ok && (err != nil || got != c.want) {
+			t.Errorf("ParseVersion(%q) = %v, %v; expected %v", c.in, got, err, c.want)
+		} else if !c.ok && err == nil {
+			t.Errorf("ParseVersion(%q) = %v; expected an error", c.in, got)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package compat
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a semantic version, without its prerelease or build metadata.
+type Version struct {
+	Major, Minor, Patch int
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+func ParseVersion(s string) (Version, error) {
+	trimmed := strings.TrimPrefix(s, "v")
+	if i := strings.IndexAny(trimmed, "-+"); i != -1 {
+		trimmed = trimmed[:i]
+	}
+
+	parts := strings.Split(trimmed, ".")
+	if len(parts) != 3 {
+		return Version{}, fmt.Errorf("%q isn't a semantic version like 1.2.3", s)
+	}
+
+	var nums [3]int
+	for i, part := range parts {
+		num, err := strconv.Atoi(part)
+		if err != nil || num < 0 {
+			return Version{}, fmt.Errorf("%q isn't a semantic version like 1.2.3", s)
+		}
+		nums[i] = num
+	}
+
+	return Version{nums[0], nums[1], nums[2]}, nil
+}
+
+func (v Version) less(o Version) bool {
+	if v.Major != o.Major {
+		return v.Major < o.Major
+	}
+	if v.Minor != o.Minor {
+		return v.Minor < o.Minor
+	}
+	return v.Patch < o.Patch
+}
+
+// Required returns the smallest version after old that changes can be
+// released as. Before 1.0.0, breaking changes only need a new minor version.
+func Required(old Version, changes Changes) Version {
+	switch {
+	case changes.Breaking() && old.Major > 0:
+		return Version{old.Major + 1, 0, 0}
+	case changes.Breaking() || len(changes) > 0:
+		return Version{old.Major, old.Minor + 1, 0}
+	default:
+		return Version{old.Major, old.Minor, old.Patch + 1}
+	}
+}
+
+// CheckBump returns an error if going from old to new isn't enough of a
+// version bump for changes.
+func CheckBump(old, new Version, changes Changes) error {
+	if len(changes) == 0 {
+		if new.less(old) {
+			return fmt.Errorf("version went backwards from %s to %s", old, new)
+		}
+		return nil
+	}
+
+	required := Required(old, changes)
+	if new.less(required) {
+		return fmt.Errorf("version %s is not enough of a bump from %s for these changes; it needs to be at least %s", new, old, required)
+	}
+	return nil
+}
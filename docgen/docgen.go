@@ -3,6 +3,7 @@ package docgen
 import (
 	"bytes"
 	"fmt"
+	"html"
 	"html/template"
 	"lugmac/ast"
 	"lugmac/ast/extension"
@@ -147,6 +148,23 @@ func breadcrumbsFor(item typechecking.Object) string {
 	return strings.Join(s, ` <span class="px-2">/</span> `)
 }
 
+func renderAnnotationsTo(sb *strings.Builder, item typechecking.Object) {
+	annotations := typechecking.AnnotationsOf(item)
+
+	if deprecated := annotations.Get("deprecated"); deprecated != nil {
+		sb.WriteString(`<p class="deprecated"><strong>Deprecated</strong>`)
+		if reason, ok := deprecated.Argument(0).(typechecking.StringValue); ok {
+			sb.WriteString(": " + html.EscapeString(string(reason)))
+		}
+		sb.WriteString(`</p>`)
+	}
+	if since := annotations.Get("since"); since != nil {
+		if version, ok := since.Argument(0).(typechecking.StringValue); ok {
+			sb.WriteString(fmt.Sprintf(`<p class="since">Available since %s</p>`, html.EscapeString(string(version))))
+		}
+	}
+}
+
 func renderObject(outdir string, workspace *typechecking.Workspace, item typechecking.Object, docs *ast.ItemDocumentation) error {
 	var res = &resolver{}
 	var gm = goldmark.New(
@@ -187,6 +205,7 @@ func renderObject(outdir string, workspace *typechecking.Workspace, item typeche
 		}
 
 		mainBuilder.WriteString(fmt.Sprintf("<h1>%s</h1>", item.ObjectName()))
+		renderAnnotationsTo(&mainBuilder, item)
 
 		err := rend(docs.Summary)
 		if err != nil {
@@ -251,6 +270,7 @@ func renderObject(outdir string, workspace *typechecking.Workspace, item typeche
 		var mainBuilder strings.Builder
 
 		mainBuilder.WriteString(fmt.Sprintf("<h1>%s</h1>", item.ObjectName()))
+		renderAnnotationsTo(&mainBuilder, item)
 
 		mainBuilder.WriteString(fmt.Sprintf(`<pre><code>%s</code></pre>`, HTMLSignatureFor(item, item)))
 
@@ -354,6 +374,18 @@ var Command = &cli.Command{
 					}
 				}
 			}
+			for _, alias := range mod.TypeAliases {
+				err = renderObject(outdir, mod.InWorkspace, alias, alias.Documentation)
+				if err != nil {
+					return err
+				}
+			}
+			for _, constant := range mod.Constants {
+				err = renderObject(outdir, mod.InWorkspace, constant, constant.Documentation)
+				if err != nil {
+					return err
+				}
+			}
 			for _, fn := range mod.Funcs {
 				err = renderObject(outdir, mod.InWorkspace, fn, fn.Documentation)
 				if err != nil {
@@ -2,6 +2,7 @@ package docgen
 
 import (
 	"fmt"
+	"html"
 	lugmaast "lugmac/ast"
 	"lugmac/ast/extension"
 	"lugmac/typechecking"
@@ -85,6 +86,10 @@ func (item Item) renderTableOfContentsTo(sb *strings.Builder, currently typechec
 			sb.WriteString(`<span class="codicon codicon-symbol-namespace symbol-workspace"></span>`)
 		case *typechecking.Stream:
 			sb.WriteString(`<span class="codicon codicon-remote symbol-stream"></span>`)
+		case *typechecking.TypeAlias:
+			sb.WriteString(`<span class="codicon codicon-symbol-class symbol-class"></span>`)
+		case *typechecking.Constant:
+			sb.WriteString(`<span class="codicon codicon-symbol-constant symbol-field"></span>`)
 		}
 		sb.WriteString(fmt.Sprintf(`%s</a>`, item.Object.ObjectName()))
 	}
@@ -213,6 +218,10 @@ func DocumentationItemFor(object typechecking.Object) *lugmaast.ItemDocumentatio
 		return t.Documentation
 	case *typechecking.Stream:
 		return t.Documentation
+	case *typechecking.TypeAlias:
+		return t.Documentation
+	case *typechecking.Constant:
+		return t.Documentation
 	default:
 		return nil
 	}
@@ -343,7 +352,14 @@ func hcode(s string) string {
 }
 
 func hfield(object *typechecking.Field) string {
-	return fmt.Sprintf(`%s: <span class="code-type">%s</span>`, object.Name, object.Type.String())
+	return fmt.Sprintf(`%s: <span class="code-type">%s</span>`, object.Name, object.Type.String()) + hdefault(object)
+}
+
+func hdefault(object *typechecking.Field) string {
+	if object.Default == nil {
+		return ""
+	}
+	return " = " + html.EscapeString(object.Default.String())
 }
 
 func hfields(objects []*typechecking.Field) string {
@@ -377,7 +393,7 @@ func HTMLSignatureFor(object typechecking.Object, currently typechecking.Object)
 		}
 		return hcode(inner)
 	case *typechecking.Field:
-		return hcode(hkeyword("let") + " " + fmt.Sprintf(`<span class="code-item-name">%s</span>: <span class="code-type">%s</span>`, t.Name, t.Type.String()))
+		return hcode(hkeyword("let") + " " + fmt.Sprintf(`<span class="code-item-name">%s</span>: <span class="code-type">%s</span>`, t.Name, t.Type.String()) + hdefault(t))
 	case *typechecking.Struct:
 		return hcode(hkeyword("struct") + " " + hitem(object.ObjectName()))
 	case *typechecking.Stream:
@@ -386,6 +402,14 @@ func HTMLSignatureFor(object typechecking.Object, currently typechecking.Object)
 		return hcode(hkeyword("enum") + " " + hitem(object.ObjectName()))
 	case *typechecking.Module:
 		return hcode(hkeyword("module") + " " + hitem(object.ObjectName()))
+	case *typechecking.TypeAlias:
+		keyword := "typealias"
+		if t.Newtype {
+			keyword = "newtype"
+		}
+		return hcode(hkeyword(keyword) + " " + hitem(object.ObjectName()) + fmt.Sprintf(` = <span class="code-type">%s</span>`, t.Underlying.String()))
+	case *typechecking.Constant:
+		return hcode(hkeyword("const") + " " + fmt.Sprintf(`<span class="code-item-name">%s</span>: <span class="code-type">%s</span> = %s`, t.ObjectName(), t.Type.String(), html.EscapeString(t.Value.String())))
 	default:
 		panic("bad object type " + reflect.TypeOf(object).String())
 	}
@@ -416,7 +440,7 @@ func DefaultStructureFor(object typechecking.Object) Item {
 		return DefaultStructureForEnum(t)
 	case *typechecking.Struct:
 		return DefaultStructureForStruct(t)
-	case *typechecking.Func:
+	case *typechecking.Func, *typechecking.TypeAlias, *typechecking.Constant:
 		return Item{object, nil}
 	case *typechecking.Flagset:
 		return DefaultStructureForFlagset(t)
@@ -461,6 +485,16 @@ func DefaultStructureForModule(m *typechecking.Module) Item {
 		flagsetSection.Items = append(flagsetSection.Items, itemOnly(StructureFor(flagset)))
 	}
 
+	typeAliasSection := Section{Title: "Type Aliases"}
+	for _, alias := range m.TypeAliases {
+		typeAliasSection.Items = append(typeAliasSection.Items, itemOnly(StructureFor(alias)))
+	}
+
+	constantSection := Section{Title: "Constants"}
+	for _, constant := range m.Constants {
+		constantSection.Items = append(constantSection.Items, itemOnly(StructureFor(constant)))
+	}
+
 	streamsSection := Section{Title: "Streams"}
 	for _, stream := range m.Streams {
 		streamsSection.Items = append(flagsetSection.Items, itemOnly(StructureFor(stream)))
@@ -480,6 +514,12 @@ func DefaultStructureForModule(m *typechecking.Module) Item {
 	if len(flagsetSection.Items) > 0 {
 		ret.Children = append(ret.Children, flagsetSection)
 	}
+	if len(typeAliasSection.Items) > 0 {
+		ret.Children = append(ret.Children, typeAliasSection)
+	}
+	if len(constantSection.Items) > 0 {
+		ret.Children = append(ret.Children, constantSection)
+	}
 	if len(streamsSection.Items) > 0 {
 		ret.Children = append(ret.Children, streamsSection)
 	}
@@ -0,0 +1,61 @@
+// Package dump writes checked workspaces out in the representation of package
+// lugmac/ir/v1, for generators and other tools that live outside lugmac.
+package dump
+
+import (
+	"encoding/json"
+	"fmt"
+	"lugmac/modules"
+	"os"
+
+	"github.com/urfave/cli/v2"
+)
+
+var Command = &cli.Command{
+	Name:  "dump",
+	Usage: "Write out the checked modules of a workspace for other tools to read",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "The format to write the workspace in, which can only be json",
+			Value: "json",
+		},
+		&cli.StringFlag{
+			Name:    "workspace",
+			Aliases: []string{"w"},
+			Usage:   "The workspace to dump",
+			Value:   ".",
+		},
+		&cli.StringFlag{
+			Name:    "output",
+			Aliases: []string{"o"},
+			Usage:   "The file to write to, instead of standard output",
+		},
+	},
+	Action: func(cCtx *cli.Context) error {
+		if format := cCtx.String("format"); format != "json" {
+			return cli.Exit(fmt.Sprintf("workspaces can't be dumped as %s, only as json", format), 1)
+		}
+
+		w, err := modules.LoadWorkspaceFrom(cCtx.String("workspace"))
+		if err != nil {
+			return err
+		}
+		err = w.GenerateModules()
+		if err != nil {
+			return err
+		}
+
+		data, err := json.MarshalIndent(Workspace(w), "", "\t")
+		if err != nil {
+			return err
+		}
+		data = append(data, '\n')
+
+		if output := cCtx.String("output"); output != "" {
+			return os.WriteFile(output, data, 0644)
+		}
+		_, err = os.Stdout.Write(data)
+		return err
+	},
+}
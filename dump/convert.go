@@ -0,0 +1,270 @@
+package dump
+
+import (
+	"encoding/json"
+	"lugmac/ast"
+	"lugmac/backends"
+	ir "lugmac/ir/v1"
+	"lugmac/modules"
+	"lugmac/typechecking"
+	"path/filepath"
+	"sort"
+)
+
+// Workspace returns the representation of w's own modules and the modules of
+// its dependencies that they use, which must have been checked.
+func Workspace(w *modules.Workspace) *ir.Workspace {
+	ret := &ir.Workspace{
+		IRVersion:    ir.Version,
+		Name:         w.Module.Name,
+		Version:      w.Module.Version,
+		Modules:      []*ir.Module{},
+		Dependencies: []*ir.Module{},
+	}
+	var products []*typechecking.Module
+	for _, prod := range w.Module.Products {
+		mod, ok := w.KnownModules[prod.Name]
+		if !ok {
+			continue
+		}
+		products = append(products, mod)
+		ret.Modules = append(ret.Modules, Module(mod, w.Dir))
+	}
+
+	// dependencies are told apart by their paths, so unlike in generated
+	// code, modules of different workspaces can have the same names
+	seen := map[typechecking.Path]bool{}
+	var visit func(mod *typechecking.Module)
+	visit = func(mod *typechecking.Module) {
+		names := make([]string, 0, len(mod.Imports))
+		for name := range mod.Imports {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			imported := mod.Imports[name]
+			if imported.InWorkspace == w.Workspace || seen[imported.Path()] {
+				continue
+			}
+			seen[imported.Path()] = true
+			ret.Dependencies = append(ret.Dependencies, Module(imported, w.Dir))
+			visit(imported)
+		}
+	}
+	for _, mod := range products {
+		visit(mod)
+	}
+	return ret
+}
+
+// converter converts the parts of a module, giving the files they're declared
+// in relative to dir.
+type converter struct {
+	dir string
+}
+
+func (c converter) file(name string) string {
+	if rel, err := filepath.Rel(c.dir, name); err == nil {
+		return filepath.ToSlash(rel)
+	}
+	return filepath.ToSlash(name)
+}
+
+// Module returns the representation of mod, whose files are given relative to
+// dir.
+func Module(mod *typechecking.Module, dir string) *ir.Module {
+	c := converter{dir}
+	ret := &ir.Module{
+		Name:          mod.Name,
+		Path:          mod.Path().String(),
+		Documentation: documentation(mod.Documentation),
+		Imports:       map[string]string{},
+		TypeAliases:   []*ir.TypeAlias{},
+		Constants:     []*ir.Constant{},
+		Structs:       []*ir.Struct{},
+		Enums:         []*ir.Enum{},
+		Flagsets:      []*ir.Flagset{},
+		Funcs:         []*ir.Func{},
+		Streams:       []*ir.Stream{},
+	}
+	for as, imported := range mod.Imports {
+		ret.Imports[as] = imported.Path().String()
+	}
+
+	for _, alias := range mod.TypeAliases {
+		ret.TypeAliases = append(ret.TypeAliases, &ir.TypeAlias{
+			Item:       c.item(alias, alias.Documentation, alias.Annotations),
+			Newtype:    alias.Newtype,
+			Underlying: typeOf(alias.Underlying),
+		})
+	}
+	for _, constant := range mod.Constants {
+		ret.Constants = append(ret.Constants, &ir.Constant{
+			Item:  c.item(constant, constant.Documentation, constant.Annotations),
+			Type:  typeOf(constant.Type),
+			Value: wire(constant.Value, constant.Type),
+		})
+	}
+	for _, strct := range mod.Structs {
+		ret.Structs = append(ret.Structs, &ir.Struct{
+			Item:   c.item(strct, strct.Documentation, strct.Annotations),
+			Fields: c.fields(strct.Fields),
+		})
+	}
+	for _, enum := range mod.Enums {
+		e := &ir.Enum{
+			Item:   c.item(enum, enum.Documentation, enum.Annotations),
+			Simple: enum.Simple(),
+			Cases:  []*ir.Case{},
+		}
+		for _, esac := range enum.Cases {
+			e.Cases = append(e.Cases, &ir.Case{
+				Item:   c.item(esac, esac.Documentation, esac.Annotations),
+				Tag:    esac.Tag,
+				Fields: c.fields(esac.Fields),
+			})
+		}
+		ret.Enums = append(ret.Enums, e)
+	}
+	for _, flagset := range mod.Flagsets {
+		fs := &ir.Flagset{
+			Item:     c.item(flagset, flagset.Documentation, flagset.Annotations),
+			Optional: flagset.Optional,
+			Flags:    []*ir.Flag{},
+		}
+		for i, flag := range flagset.Flags {
+			fs.Flags = append(fs.Flags, &ir.Flag{
+				Item: c.item(flag, flag.Documentation, flag.Annotations),
+				Bit:  i,
+			})
+		}
+		ret.Flagsets = append(ret.Flagsets, fs)
+	}
+	for _, fn := range mod.Funcs {
+		ret.Funcs = append(ret.Funcs, &ir.Func{
+			Item:      c.item(fn, fn.Documentation, fn.Annotations),
+			Arguments: c.fields(fn.Arguments),
+			Returns:   typeOf(fn.Returns),
+			Throws:    typeOf(fn.Throws),
+		})
+	}
+	for _, stream := range mod.Streams {
+		s := &ir.Stream{
+			Item:    c.item(stream, stream.Documentation, stream.Annotations),
+			Events:  []*ir.Message{},
+			Signals: []*ir.Message{},
+		}
+		for _, ev := range stream.Events {
+			s.Events = append(s.Events, &ir.Message{
+				Item:      c.item(ev, ev.Documentation, ev.Annotations),
+				Arguments: c.fields(ev.Arguments),
+			})
+		}
+		for _, sig := range stream.Signals {
+			s.Signals = append(s.Signals, &ir.Message{
+				Item:      c.item(sig, sig.Documentation, sig.Annotations),
+				Arguments: c.fields(sig.Arguments),
+			})
+		}
+		ret.Streams = append(ret.Streams, s)
+	}
+	return ret
+}
+
+func (c converter) item(object typechecking.Object, docs *ast.ItemDocumentation, annotations typechecking.Annotations) ir.Item {
+	ret := ir.Item{
+		Name:          object.ObjectName(),
+		Path:          object.Path().String(),
+		Documentation: documentation(docs),
+	}
+	for _, annotation := range annotations {
+		a := &ir.Annotation{Name: annotation.Name, Arguments: []json.RawMessage{}}
+		for _, arg := range annotation.Arguments {
+			a.Arguments = append(a.Arguments, wire(arg, nil))
+		}
+		ret.Annotations = append(ret.Annotations, a)
+	}
+	if loc, ok := typechecking.LocationOf(object); ok {
+		ret.Location = &ir.Location{
+			File:  c.file(loc.File),
+			Start: ir.Position{Row: loc.Span.Start.Row, Column: loc.Span.Start.Column},
+			End:   ir.Position{Row: loc.Span.End.Row, Column: loc.Span.End.Column},
+		}
+	}
+	return ret
+}
+
+func (c converter) fields(of []*typechecking.Field) []*ir.Field {
+	ret := []*ir.Field{}
+	for _, field := range of {
+		f := &ir.Field{
+			Item: c.item(field, field.Documentation, field.Annotations),
+			Type: typeOf(field.Type),
+			Tag:  field.Tag,
+		}
+		if field.Default != nil {
+			f.Default = wire(field.Default, field.Type)
+		}
+		ret = append(ret, f)
+	}
+	return ret
+}
+
+// wire returns value as it's sent in JSON.
+func wire(value typechecking.Value, typ typechecking.Type) json.RawMessage {
+	if value == nil {
+		return nil
+	}
+	return json.RawMessage(backends.WireJSON(value, typ))
+}
+
+func typeOf(typ typechecking.Type) *ir.Type {
+	switch t := typ.(type) {
+	case nil:
+		return nil
+	case typechecking.PrimitiveType:
+		return &ir.Type{Kind: ir.PrimitiveKind, Name: t.String()}
+	case typechecking.ArrayType:
+		return &ir.Type{Kind: ir.ArrayKind, Element: typeOf(t.Element)}
+	case typechecking.DictionaryType:
+		return &ir.Type{Kind: ir.DictionaryKind, Key: typeOf(t.Key), Element: typeOf(t.Element)}
+	case typechecking.OptionalType:
+		return &ir.Type{Kind: ir.OptionalKind, Element: typeOf(t.Element)}
+	case *typechecking.Struct:
+		return declared(ir.StructKind, t)
+	case *typechecking.Enum:
+		return declared(ir.EnumKind, t)
+	case *typechecking.Flagset:
+		return declared(ir.FlagsetKind, t)
+	case *typechecking.TypeAlias:
+		return declared(ir.TypeAliasKind, t)
+	default:
+		panic("unhandled type " + typ.String())
+	}
+}
+
+func declared(kind ir.TypeKind, typ typechecking.Type) *ir.Type {
+	return &ir.Type{Kind: kind, Name: typ.ObjectName(), Path: typ.Path().String()}
+}
+
+// documentation returns docs with the parts that have meanings of their own
+// as plain text, or nil if there are no docs.
+func documentation(docs *ast.ItemDocumentation) *ir.Documentation {
+	if docs == nil {
+		return nil
+	}
+	ret := &ir.Documentation{
+		Source:  string(docs.Source),
+		Summary: backends.DocText(docs.Summary, docs.Source),
+		Returns: backends.DocText(docs.Returns, docs.Source),
+		Throws:  backends.DocText(docs.Throws, docs.Source),
+	}
+	for name, node := range docs.Parameters {
+		if ret.Parameters == nil {
+			ret.Parameters = map[string]string{}
+		}
+		ret.Parameters[name] = backends.DocText(node, docs.Source)
+	}
+	return ret
+}
@@ -0,0 +1,196 @@
+package dump
+
+import (
+	"encoding/json"
+	"lugmac/ast"
+	ir "lugmac/ir/v1"
+	"lugmac/modules"
+	"lugmac/typechecking"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// converted checks source as the module M of a workspace named Test and
+// returns its representation.
+func converted(t *testing.T, source string) *ir.Module {
+	t.Helper()
+	tree, err := ast.Parse([]byte(source))
+	if err != nil {
+		t.Fatalf("failed to parse:\n%s", err)
+	}
+	tree.Path = "/ws/M.lugma"
+	m, err := typechecking.NewContext(typechecking.FileImportResolver).Module(tree, "Test/M")
+	if err != nil {
+		t.Fatalf("failed to check:\n%s", err)
+	}
+	return Module(m, "/ws")
+}
+
+func TestFlagsets(t *testing.T) {
+	mod := converted(t, `
+flagset Permissions: optional {
+    flag read
+    flag write
+}
+flagset Modes {
+    flag fast
+}
+`)
+	if len(mod.Flagsets) != 2 {
+		t.Fatalf("got %d flagsets, want 2", len(mod.Flagsets))
+	}
+	perms, modes := mod.Flagsets[0], mod.Flagsets[1]
+	if !perms.Optional || modes.Optional {
+		t.Errorf("got Permissions optional: %t and Modes optional: %t, want true and false", perms.Optional, modes.Optional)
+	}
+	for i, flag := range perms.Flags {
+		if flag.Bit != i {
+			t.Errorf("got %s at bit %d, want %d", flag.Name, flag.Bit, i)
+		}
+	}
+	if loc := perms.Location; loc == nil || loc.File != "M.lugma" {
+		t.Errorf("got location %+v, want one in M.lugma", loc)
+	}
+}
+
+// writeFiles writes files, keyed by their paths relative to dir.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		file := filepath.Join(dir, name)
+		err := os.MkdirAll(filepath.Dir(file), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(file, []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// typesIn returns the types of fields.
+func typesIn(fields []*ir.Field) []*ir.Type {
+	var ret []*ir.Type
+	for _, f := range fields {
+		ret = append(ret, f.Type)
+	}
+	return ret
+}
+
+// TestDependencies dumps a workspace whose module uses a vendored dependency,
+// checking that everything referred to is in the dump.
+func TestDependencies(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"lib/lugma.yaml": `name: Lib
+version: 1.0.0
+products:
+  - type: module
+    name: L
+    depends: [Base]
+  - type: module
+    name: Base
+`,
+		"lib/Sources/Base/Base.lugma": "newtype ID = String\n",
+		"lib/Sources/L/L.lugma":       "import \"Base\" as Base\nstruct Thing {\n    let id: Base.ID\n}\n",
+		"app/lugma.yaml": `name: App
+version: 1.0.0
+dependencies:
+  - name: Lib
+    path: ../lib
+products:
+  - type: module
+    name: A
+    depends: [Lib/L]
+`,
+		"app/Sources/A/A.lugma": "import \"Lib/L\" as L\nfunc get() -> [L.Thing]\n",
+	})
+	app := filepath.Join(dir, "app")
+	w, err := modules.LoadWorkspaceFrom(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.Vendor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.RemoveAll(filepath.Join(dir, "lib"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err = modules.LoadWorkspaceFrom(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.GenerateModules()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// read it back the way other tools would
+	data, err := json.Marshal(Workspace(w))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dumped ir.Workspace
+	err = json.Unmarshal(data, &dumped)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, mod := range dumped.Dependencies {
+		got = append(got, mod.Path)
+	}
+	if strings.Join(got, " ") != "Lib/L Lib/Base" {
+		t.Errorf("got dependencies %v, want Lib/L and Lib/Base", got)
+	}
+
+	modulePaths := map[string]bool{}
+	declared := map[string]bool{}
+	var types []*ir.Type
+	for _, mod := range append(dumped.Modules, dumped.Dependencies...) {
+		modulePaths[mod.Path] = true
+		for _, alias := range mod.TypeAliases {
+			declared[alias.Path] = true
+			types = append(types, alias.Underlying)
+		}
+		for _, strct := range mod.Structs {
+			declared[strct.Path] = true
+			types = append(types, typesIn(strct.Fields)...)
+		}
+		for _, fn := range mod.Funcs {
+			types = append(types, typesIn(fn.Arguments)...)
+			types = append(types, fn.Returns, fn.Throws)
+		}
+	}
+	for _, mod := range append(dumped.Modules, dumped.Dependencies...) {
+		for as, path := range mod.Imports {
+			if !modulePaths[path] {
+				t.Errorf("%s imports %s as %s, which isn't in the dump", mod.Path, path, as)
+			}
+		}
+	}
+	resolved := 0
+	for len(types) > 0 {
+		typ := types[0]
+		types = types[1:]
+		if typ == nil {
+			continue
+		}
+		types = append(types, typ.Key, typ.Element)
+		if typ.Path == "" {
+			continue
+		}
+		if !declared[typ.Path] {
+			t.Errorf("%s is referred to, but isn't in the dump", typ.Path)
+		}
+		resolved++
+	}
+	if resolved != 2 {
+		t.Errorf("resolved %d declarations, want Lib/L/Thing and Lib/Base/ID", resolved)
+	}
+}
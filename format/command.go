@@ -0,0 +1,98 @@
+package format
+
+import (
+	"fmt"
+	"io/fs"
+	"lugmac/typechecking"
+	"os"
+	"path/filepath"
+
+	"github.com/urfave/cli/v2"
+)
+
+// filesIn returns the Lugma files at each of paths, looking through
+// directories for them.
+func filesIn(paths []string) ([]string, error) {
+	var ret []string
+
+	for _, path := range paths {
+		err := filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && filepath.Ext(path) == ".lugma" {
+				ret = append(ret, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ret, nil
+}
+
+var Command = &cli.Command{
+	Name:      "fmt",
+	Usage:     "Format Lugma IDL definitions",
+	ArgsUsage: "[files or directories...]",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "check",
+			Usage: "Don't rewrite files, but print how they would change and fail if any would",
+		},
+	},
+	Action: func(cCtx *cli.Context) error {
+		paths := cCtx.Args().Slice()
+		if len(paths) == 0 {
+			paths = []string{"."}
+		}
+
+		files, err := filesIn(paths)
+		if err != nil {
+			return err
+		}
+
+		unformatted, broken := 0, 0
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+
+			formatted, err := Format(data)
+			if err != nil {
+				for _, diag := range typechecking.DiagnosticsFor(file, err) {
+					fmt.Fprint(os.Stderr, diag.Render(func(string) []byte { return data }))
+				}
+				broken++
+				continue
+			}
+
+			if string(formatted) == string(data) {
+				continue
+			}
+			unformatted++
+
+			if cCtx.Bool("check") {
+				fmt.Print(Diff(file, data, formatted))
+				continue
+			}
+
+			err = os.WriteFile(file, formatted, 0644)
+			if err != nil {
+				return err
+			}
+		}
+
+		if broken > 0 {
+			return cli.Exit(fmt.Sprintf("%d of %d files could not be formatted", broken, len(files)), 1)
+		}
+		if cCtx.Bool("check") && unformatted > 0 {
+			return cli.Exit(fmt.Sprintf("%d of %d files are not formatted", unformatted, len(files)), 1)
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,140 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+)
+
+// context is how many unchanged lines are shown around each change.
+const context = 3
+
+type edit struct {
+	kind byte // ' ', '-', or '+'
+	line string
+}
+
+// edits returns the shortest way to turn a into b, line by line.
+func edits(a, b []string) []edit {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ret []edit
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			ret = append(ret, edit{' ', a[i]})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			ret = append(ret, edit{'-', a[i]})
+			i++
+		default:
+			ret = append(ret, edit{'+', b[j]})
+			j++
+		}
+	}
+	return ret
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// Diff returns a unified diff turning before into after, labelled with name.
+// It's empty if they're the same.
+func Diff(name string, before, after []byte) string {
+	if string(before) == string(after) {
+		return ""
+	}
+
+	es := edits(splitLines(string(before)), splitLines(string(after)))
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s (formatted)\n", name, name)
+
+	for start := 0; start < len(es); {
+		// find the next change
+		for start < len(es) && es[start].kind == ' ' {
+			start++
+		}
+		if start == len(es) {
+			break
+		}
+
+		// extend the hunk until there's enough unchanged lines to end it
+		end := start
+		for end < len(es) {
+			if es[end].kind != ' ' {
+				end++
+				continue
+			}
+			run := end
+			for run < len(es) && es[run].kind == ' ' {
+				run++
+			}
+			if run == len(es) || run-end > 2*context {
+				break
+			}
+			end = run
+		}
+
+		from := start - context
+		if from < 0 {
+			from = 0
+		}
+		to := end + context
+		if to > len(es) {
+			to = len(es)
+		}
+
+		// work out where the hunk is in each file
+		aLine, bLine := 1, 1
+		for _, e := range es[:from] {
+			if e.kind != '+' {
+				aLine++
+			}
+			if e.kind != '-' {
+				bLine++
+			}
+		}
+		aCount, bCount := 0, 0
+		for _, e := range es[from:to] {
+			if e.kind != '+' {
+				aCount++
+			}
+			if e.kind != '-' {
+				bCount++
+			}
+		}
+
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n", aLine, aCount, bLine, bCount)
+		for _, e := range es[from:to] {
+			sb.WriteByte(e.kind)
+			sb.WriteString(e.line)
+			sb.WriteByte('\n')
+		}
+
+		start = to
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,406 @@
+// Package format rewrites Lugma source into its canonical layout.
+package format
+
+import (
+	"lugmac/ast"
+	lugma "lugmac/parser"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+const indentation = "    "
+
+// Format returns input laid out canonically. Comments are kept in the order
+// they were written, either on their own lines or at the end of the line they
+// were at the end of. Files with syntax errors aren't formatted.
+func Format(input []byte) ([]byte, error) {
+	parser := sitter.NewParser()
+	parser.SetLanguage(lugma.GetLanguage())
+
+	tree := parser.Parse(nil, input)
+	root := tree.RootNode()
+
+	if errs := ast.SyntaxErrorsIn(root, input); len(errs) > 0 {
+		return nil, errs
+	}
+	if _, err := ast.FileFromNode(root, input); err != nil {
+		return nil, err
+	}
+
+	p := &printer{input: input, first: true}
+	p.collectComments(root)
+	p.file(root)
+
+	return []byte(p.String()), nil
+}
+
+type printer struct {
+	input []byte
+	lines []string
+
+	// comments holds every comment not printed yet, in source order.
+	comments []*sitter.Node
+
+	indent int
+
+	// last is where the last thing printed ended in the source, for deciding
+	// where blank lines and trailing comments go.
+	last sitter.Point
+
+	// first is whether nothing has been printed in the current block yet.
+	first bool
+}
+
+func (p *printer) String() string {
+	if len(p.lines) == 0 {
+		return ""
+	}
+	return strings.Join(p.lines, "\n") + "\n"
+}
+
+func (p *printer) collectComments(n *sitter.Node) {
+	if n.Type() == "comment" {
+		p.comments = append(p.comments, n)
+		return
+	}
+	for i := 0; i < int(n.ChildCount()); i++ {
+		p.collectComments(n.Child(i))
+	}
+}
+
+func before(a, b sitter.Point) bool {
+	return a.Row < b.Row || (a.Row == b.Row && a.Column < b.Column)
+}
+
+// trailing moves comments on the same line as the last thing printed, and
+// before the next thing at next, to the end of its line.
+func (p *printer) trailing(next sitter.Point) {
+	for len(p.comments) > 0 && len(p.lines) > 0 {
+		c := p.comments[0]
+		if c.StartPoint().Row != p.last.Row || !before(c.StartPoint(), next) || strings.Contains(c.Content(p.input), "\n") {
+			return
+		}
+		p.lines[len(p.lines)-1] += " " + c.Content(p.input)
+		p.last = c.EndPoint()
+		p.comments = p.comments[1:]
+	}
+}
+
+// leading prints comments before start, and any on other lines than end, on
+// their own lines.
+func (p *printer) leading(start, end sitter.Point) {
+	for len(p.comments) > 0 {
+		c := p.comments[0]
+		if !before(c.StartPoint(), start) && (!before(c.StartPoint(), end) || c.StartPoint().Row == end.Row) {
+			return
+		}
+		p.blankLineBefore(c.StartPoint())
+		p.emit(p.reindent(c))
+		p.last = c.EndPoint()
+		p.comments = p.comments[1:]
+	}
+}
+
+// reindent returns the lines of comment c, with continuation lines of block
+// comments moved along with their first line.
+func (p *printer) reindent(c *sitter.Node) string {
+	lines := strings.Split(c.Content(p.input), "\n")
+	column := int(c.StartPoint().Column)
+
+	for i := 1; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], " \t\r")
+		trim := 0
+		for trim < len(line) && trim < column && (line[trim] == ' ' || line[trim] == '\t') {
+			trim++
+		}
+		lines[i] = line[trim:]
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// blankLineBefore keeps a blank line from the source before something
+// starting at start, collapsing several into one.
+func (p *printer) blankLineBefore(start sitter.Point) {
+	if !p.first && start.Row > p.last.Row+1 {
+		p.lines = append(p.lines, "")
+	}
+	p.first = false
+}
+
+func (p *printer) emit(text string) {
+	prefix := strings.Repeat(indentation, p.indent)
+	for _, line := range strings.Split(text, "\n") {
+		if line == "" {
+			p.lines = append(p.lines, "")
+		} else {
+			p.lines = append(p.lines, prefix+line)
+		}
+	}
+}
+
+// item prints text as a line of its own, standing in for the source from
+// start to end.
+func (p *printer) item(start, end sitter.Point, text string) {
+	p.trailing(start)
+	p.leading(start, end)
+	p.blankLineBefore(start)
+	p.emit(text)
+	p.last = end
+}
+
+// open prints text as the line beginning a block.
+func (p *printer) open(start, end sitter.Point, text string) {
+	p.item(start, end, text)
+	p.indent++
+	p.first = true
+}
+
+// close prints the brace at n ending a block.
+func (p *printer) close(n *sitter.Node) {
+	p.trailing(n.StartPoint())
+	p.leading(n.StartPoint(), n.StartPoint())
+	p.indent--
+	p.emit("}")
+	p.first = false
+	p.last = n.EndPoint()
+}
+
+func (p *printer) file(n *sitter.Node) {
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		child := n.NamedChild(i)
+		if child.Type() != "statement" {
+			continue
+		}
+		p.declaration(child.Child(0))
+	}
+
+	end := n.EndPoint()
+	p.trailing(end)
+	p.first = false
+	p.leading(end, end)
+}
+
+func (p *printer) content(n *sitter.Node) string {
+	return n.Content(p.input)
+}
+
+func (p *printer) name(n *sitter.Node) string {
+	return p.content(n.ChildByFieldName("name"))
+}
+
+// childrenOf returns the children of n with type typ.
+func childrenOf(n *sitter.Node, typ string) []*sitter.Node {
+	var ret []*sitter.Node
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if n.Child(i).Type() == typ {
+			ret = append(ret, n.Child(i))
+		}
+	}
+	return ret
+}
+
+// keyword returns the first child of n with type typ.
+func keyword(n *sitter.Node, typ string) *sitter.Node {
+	if children := childrenOf(n, typ); len(children) > 0 {
+		return children[0]
+	}
+	return nil
+}
+
+func (p *printer) declaration(n *sitter.Node) {
+	switch n.Type() {
+	case "import":
+		p.item(n.StartPoint(), n.EndPoint(), "import "+p.content(n.ChildByFieldName("path"))+" as "+p.content(n.ChildByFieldName("alias")))
+	case "func_declaration":
+		p.annotations(n)
+		p.item(keyword(n, "func").StartPoint(), n.EndPoint(), p.function(n))
+	case "struct_declaration":
+		p.block(n, "struct", "field_declaration", func(child *sitter.Node) string {
+			return "let " + p.name(child) + ": " + p.typ(child.ChildByFieldName("type")) + p.defaultOf(child)
+		})
+	case "enum_declaration":
+		p.block(n, "enum", "case_declaration", func(child *sitter.Node) string {
+			if keyword(child, "(") == nil {
+				return "case " + p.name(child)
+			}
+			return "case " + p.name(child) + p.arguments(child)
+		})
+	case "flagset_declaration":
+		p.block(n, "flagset", "flag_declaration", func(child *sitter.Node) string {
+			return "flag " + p.name(child)
+		})
+	case "typealias_declaration":
+		p.annotations(n)
+		kind := n.ChildByFieldName("kind")
+		p.item(kind.StartPoint(), n.EndPoint(), kind.Type()+" "+p.name(n)+" = "+p.typ(n.ChildByFieldName("type")))
+	case "const_declaration":
+		p.annotations(n)
+		p.item(keyword(n, "const").StartPoint(), n.EndPoint(), "const "+p.name(n)+": "+p.typ(n.ChildByFieldName("type"))+" = "+p.literal(n.ChildByFieldName("value")))
+	case "stream_declaration":
+		p.block(n, "stream", "", func(child *sitter.Node) string {
+			switch child.Type() {
+			case "event_declaration":
+				return "event " + p.name(child) + p.arguments(child)
+			case "signal_declaration":
+				return "signal " + p.name(child) + p.arguments(child)
+			default:
+				return ""
+			}
+		})
+	}
+}
+
+// block prints a declaration with members inside braces. Members are the
+// children with type member, or every event and signal if it's empty.
+func (p *printer) block(n *sitter.Node, kw, member string, line func(child *sitter.Node) string) {
+	p.annotations(n)
+
+	brace := keyword(n, "{")
+	closing := keyword(n, "}")
+	header := kw + " " + p.name(n)
+	if n.ChildByFieldName("optional") != nil {
+		header += ": optional"
+	}
+	header += " {"
+
+	var members []*sitter.Node
+	for i := 0; i < int(n.ChildCount()); i++ {
+		child := n.Child(i)
+		if child.Type() == member || (member == "" && (child.Type() == "event_declaration" || child.Type() == "signal_declaration")) {
+			members = append(members, child)
+		}
+	}
+
+	hasComments := len(p.comments) > 0 && before(p.comments[0].StartPoint(), closing.StartPoint()) && !before(p.comments[0].StartPoint(), brace.EndPoint())
+	if len(members) == 0 && !hasComments {
+		p.item(keyword(n, kw).StartPoint(), n.EndPoint(), header+"}")
+		return
+	}
+
+	p.open(keyword(n, kw).StartPoint(), brace.EndPoint(), header)
+	for _, child := range members {
+		p.annotations(child)
+		p.item(firstAfterAnnotations(child).StartPoint(), child.EndPoint(), line(child))
+	}
+	p.close(closing)
+}
+
+// firstAfterAnnotations returns the first child of n that isn't an annotation.
+func firstAfterAnnotations(n *sitter.Node) *sitter.Node {
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if n.Child(i).Type() != "annotation" && n.Child(i).Type() != "comment" {
+			return n.Child(i)
+		}
+	}
+	return n
+}
+
+// annotations prints the annotations on n, each on its own line.
+func (p *printer) annotations(n *sitter.Node) {
+	for _, annotation := range childrenOf(n, "annotation") {
+		p.item(annotation.StartPoint(), annotation.EndPoint(), p.annotation(annotation))
+	}
+}
+
+func (p *printer) annotation(n *sitter.Node) string {
+	var args []string
+	for _, child := range childrenOf(n, "literal") {
+		args = append(args, p.literal(child))
+	}
+	return "@" + p.name(n) + "(" + strings.Join(args, ", ") + ")"
+}
+
+func (p *printer) literal(n *sitter.Node) string {
+	inner := n.NamedChild(0)
+
+	switch inner.Type() {
+	case "list":
+		var items []string
+		for _, child := range childrenOf(inner, "literal") {
+			items = append(items, p.literal(child))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case "dictionary":
+		var entries []string
+		for i := 0; i < int(inner.NamedChildCount()); i++ {
+			child := inner.NamedChild(i)
+			switch child.Type() {
+			case "identifier":
+				entries = append(entries, p.content(child)+": ")
+			case "literal":
+				entries[len(entries)-1] += p.literal(child)
+			}
+		}
+		if len(entries) == 0 {
+			return "[:]"
+		}
+		return "[" + strings.Join(entries, ", ") + "]"
+	default:
+		return p.content(inner)
+	}
+}
+
+func (p *printer) arguments(n *sitter.Node) string {
+	var args []string
+	for _, arg := range childrenOf(n, "arg") {
+		var s string
+		for _, annotation := range childrenOf(arg, "annotation") {
+			s += p.annotation(annotation) + " "
+		}
+		s += p.name(arg) + ": " + p.typ(arg.ChildByFieldName("type")) + p.defaultOf(arg)
+		args = append(args, s)
+	}
+	return "(" + strings.Join(args, ", ") + ")"
+}
+
+// defaultOf returns the default value of the field or argument n, with the
+// equals sign before it, or nothing if it doesn't have one.
+func (p *printer) defaultOf(n *sitter.Node) string {
+	value := n.ChildByFieldName("default")
+	if value == nil {
+		return ""
+	}
+	return " = " + p.literal(value)
+}
+
+func (p *printer) function(n *sitter.Node) string {
+	s := "func " + p.name(n) + p.arguments(n)
+
+	var previous string
+	for i := 0; i < int(n.ChildCount()); i++ {
+		child := n.Child(i)
+		switch child.Type() {
+		case "comment":
+			continue
+		case "type":
+			switch previous {
+			case "throws":
+				s += " throws " + p.typ(child)
+			case "->":
+				s += " -> " + p.typ(child)
+			}
+		}
+		previous = child.Type()
+	}
+
+	return s
+}
+
+func (p *printer) typ(n *sitter.Node) string {
+	var sb strings.Builder
+	for i := 0; i < int(n.ChildCount()); i++ {
+		child := n.Child(i)
+		switch child.Type() {
+		case "type":
+			sb.WriteString(p.typ(child))
+		case ":":
+			sb.WriteString(": ")
+		case "comment":
+		default:
+			sb.WriteString(p.content(child))
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,62 @@
+package format
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files with what's formatted now")
+
+// TestGolden formats every testdata/*.input.lugma and compares the result
+// with the .golden.lugma next to it.
+func TestGolden(t *testing.T) {
+	inputs, err := filepath.Glob(filepath.Join("testdata", "*.input.lugma"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inputs) == 0 {
+		t.Fatal("no golden tests in testdata")
+	}
+
+	for _, input := range inputs {
+		name := strings.TrimSuffix(filepath.Base(input), ".input.lugma")
+		golden := filepath.Join("testdata", name+".golden.lugma")
+
+		t.Run(name, func(t *testing.T) {
+			source, err := os.ReadFile(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := Format(source)
+			if err != nil {
+				t.Fatalf("failed to format: %s", err)
+			}
+
+			if *update {
+				err = os.WriteFile(golden, got, 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("formatted differently from %s:\n%s", golden, got)
+			}
+
+			again, err := Format(got)
+			if err != nil {
+				t.Fatalf("failed to format formatted source: %s", err)
+			}
+			if !bytes.Equal(again, got) {
+				t.Errorf("formatting isn't stable, the second time giving:\n%s", again)
+			}
+		})
+	}
+}
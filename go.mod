@@ -4,6 +4,7 @@ go 1.18
 
 require (
 	github.com/alecthomas/repr v0.1.0
+	github.com/iancoleman/strcase v0.2.0
 	github.com/rivo/uniseg v0.2.0
 	github.com/smacker/go-tree-sitter v0.0.0-20220628134258-ac06e95cfa11
 	github.com/urfave/cli/v2 v2.11.0
@@ -13,7 +14,6 @@ require (
 
 require (
 	github.com/cpuguy83/go-md2man/v2 v2.0.2 // indirect
-	github.com/iancoleman/strcase v0.2.0 // indirect
 	github.com/russross/blackfriday/v2 v2.1.0 // indirect
 	github.com/xrash/smetrics v0.0.0-20201216005158-039620a65673 // indirect
 )
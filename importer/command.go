@@ -0,0 +1,193 @@
+// Package importer converts descriptions of existing APIs into Lugma
+// workspaces, so that they don't have to be written out by hand.
+package importer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"lugmac/compat"
+	"lugmac/modules"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+)
+
+var flags = []cli.Flag{
+	&cli.StringFlag{
+		Name:    "workspace",
+		Aliases: []string{"w"},
+		Usage:   "The directory to write the workspace to",
+		Value:   ".",
+	},
+	&cli.StringFlag{
+		Name:    "product",
+		Aliases: []string{"p"},
+		Usage:   "The name of the module to import into, instead of one made from the document's title",
+	},
+	&cli.StringFlag{
+		Name:  "name",
+		Usage: "The name of the workspace, instead of the module's",
+	},
+}
+
+// write writes the source of what im imported into a new workspace with a
+// module named product, and checks that it typechecks.
+func write(cCtx *cli.Context, im *importer, product, version string) error {
+	if name := cCtx.String("product"); name != "" {
+		product = name
+	}
+	product = camel(product)
+	if product == "" {
+		return cli.Exit("there's no title to name the module after, so it has to be given with --product", 1)
+	}
+	name := cCtx.String("name")
+	if name == "" {
+		name = product
+	}
+	if _, err := compat.ParseVersion(version); err != nil {
+		im.report("/info/version", "%q isn't a semantic version, so the workspace is at 0.0.0", version)
+		version = "0.0.0"
+	}
+
+	dir := cCtx.String("workspace")
+	manifest := filepath.Join(dir, "lugma.yaml")
+	sources := filepath.Join(dir, "Sources", product)
+	for _, file := range []string{manifest, sources} {
+		if _, err := os.Stat(file); err == nil {
+			return fmt.Errorf("%s already exists", file)
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	source, err := im.source()
+	if err != nil {
+		return fmt.Errorf("failed to write imported source: %w", err)
+	}
+
+	var definition bytes.Buffer
+	enc := yaml.NewEncoder(&definition)
+	enc.SetIndent(2)
+	err = enc.Encode(&modules.ModuleDefinition{
+		Name:     name,
+		Version:  version,
+		Products: []modules.ProductDefinition{{Type: "module", Name: product}},
+	})
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(sources, 0750)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(filepath.Join(sources, product+".lugma"), source, 0644)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(manifest, definition.Bytes(), 0644)
+	if err != nil {
+		return err
+	}
+
+	for _, problem := range im.problems {
+		fmt.Fprintln(os.Stderr, problem)
+	}
+
+	w, err := modules.LoadWorkspaceFrom(dir)
+	if err != nil {
+		return err
+	}
+	err = w.GenerateModules()
+	if err != nil {
+		return fmt.Errorf("the imported workspace doesn't check:\n%w", err)
+	}
+
+	if len(im.problems) == 1 {
+		fmt.Fprintln(os.Stderr, "1 construct could not be imported as it was")
+	} else if len(im.problems) > 1 {
+		fmt.Fprintf(os.Stderr, "%d constructs could not be imported as they were\n", len(im.problems))
+	}
+	return nil
+}
+
+// read reads the document at file into v.
+func read(file string, v interface{}) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	err = yaml.Unmarshal(data, v)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", file, err)
+	}
+	return nil
+}
+
+var Command = &cli.Command{
+	Name:  "import",
+	Usage: "Create a Lugma workspace from a description of an existing API",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "openapi",
+			Usage:     "Import the schemas and operations of an OpenAPI 3 document",
+			ArgsUsage: "<document>",
+			Flags:     flags,
+			Action: func(cCtx *cli.Context) error {
+				if cCtx.NArg() != 1 {
+					return cli.Exit("import openapi needs the OpenAPI document to import", 1)
+				}
+				file := cCtx.Args().First()
+
+				doc := &document{}
+				err := read(file, doc)
+				if err != nil {
+					return err
+				}
+				if !strings.HasPrefix(doc.OpenAPI, "3.") {
+					if doc.Swagger != "" {
+						return cli.Exit(fmt.Sprintf("%s is a Swagger %s document, and only OpenAPI 3 documents can be imported", file, doc.Swagger), 1)
+					}
+					return cli.Exit(fmt.Sprintf("%s isn't an OpenAPI 3 document", file), 1)
+				}
+
+				im := newImporter(file)
+				im.openAPI(doc)
+				return write(cCtx, im, doc.Info.Title, doc.Info.Version)
+			},
+		},
+		{
+			Name:      "jsonschema",
+			Usage:     "Import the definitions of a JSON Schema",
+			ArgsUsage: "<schema>",
+			Flags:     flags,
+			Action: func(cCtx *cli.Context) error {
+				if cCtx.NArg() != 1 {
+					return cli.Exit("import jsonschema needs the JSON Schema to import", 1)
+				}
+				file := cCtx.Args().First()
+
+				root := &schema{}
+				err := read(file, root)
+				if err != nil {
+					return err
+				}
+
+				name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+				name = strings.TrimSuffix(name, ".schema")
+				if root.Title != "" {
+					name = root.Title
+				}
+
+				im := newImporter(file)
+				im.jsonSchema(root, name)
+				return write(cCtx, im, name, "0.0.0")
+			},
+		},
+	},
+}
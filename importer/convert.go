@@ -0,0 +1,607 @@
+package importer
+
+import (
+	"fmt"
+	"lugmac/backends"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+// typ is a Lugma type being imported: a primitive, an array, dictionary or
+// optional of another, or a declaration.
+type typ interface{}
+
+type primitive string
+
+type arrayOf struct {
+	element typ
+}
+
+type dictionaryOf struct {
+	key, element typ
+}
+
+type optionalOf struct {
+	element typ
+}
+
+func optional(t typ) typ {
+	if _, ok := t.(optionalOf); ok {
+		return t
+	}
+	return optionalOf{t}
+}
+
+type kind int
+
+const (
+	aliasKind kind = iota
+	structKind
+	enumKind
+)
+
+// decl is a declaration being imported, made from a schema.
+type decl struct {
+	kind   kind
+	name   string
+	at     string
+	docs   string
+	schema *schema
+
+	// component is whether the declaration is of a named schema, which is
+	// imported even if nothing uses it.
+	component bool
+	filling   bool
+	filled    bool
+
+	underlying typ
+	fields     []*field
+	cases      []*enumCase
+
+	// broken is whether an alias refers to itself.
+	broken bool
+	// visit is how far along arranging the declaration is.
+	visit int
+}
+
+// simple returns whether d is an enum without fields in any of its cases.
+func (d *decl) simple() bool {
+	if d.kind != enumKind {
+		return false
+	}
+	for _, esac := range d.cases {
+		if len(esac.fields) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+type field struct {
+	name string
+	docs string
+	at   string
+	typ  typ
+	def  interface{}
+	// required is whether the field has to be given, which it doesn't if
+	// it's optional or has a default.
+	required bool
+}
+
+type enumCase struct {
+	name string
+	docs string
+	// value is the value of a case of a simple enum in the document.
+	value  string
+	fields []*field
+}
+
+type function struct {
+	name      string
+	docs      string
+	at        string
+	arguments []*field
+
+	returns, throws         typ
+	returnsDocs, throwsDocs string
+}
+
+// importer converts a document into Lugma declarations, keeping track of what
+// it couldn't.
+type importer struct {
+	// file is the document being imported, which problems are reported in.
+	file string
+
+	decls     []*decl
+	functions []*function
+	// refs holds the declarations made of the named schemas, keyed by the
+	// references to them.
+	refs map[string]*decl
+	// names holds the names of every declaration and function.
+	names map[string]struct{}
+
+	problems []string
+}
+
+func newImporter(file string) *importer {
+	return &importer{
+		file:  file,
+		refs:  map[string]*decl{},
+		names: map[string]struct{}{},
+	}
+}
+
+func (im *importer) report(at, format string, args ...interface{}) {
+	problem := fmt.Sprintf("%s#%s: %s", im.file, at, fmt.Sprintf(format, args...))
+	// parameters of paths are imported once for each of their operations
+	for _, reported := range im.problems {
+		if reported == problem {
+			return
+		}
+	}
+	im.problems = append(im.problems, problem)
+}
+
+// child returns the JSON pointer to what's at keys in what at points to.
+func child(at string, keys ...string) string {
+	for _, key := range keys {
+		key = strings.ReplaceAll(key, "~", "~0")
+		key = strings.ReplaceAll(key, "/", "~1")
+		at += "/" + key
+	}
+	return at
+}
+
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+var separators = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// camel returns raw in UpperCamelCase, dropping what can't be in an
+// identifier.
+func camel(raw string) string {
+	name := strcase.ToCamel(separators.ReplaceAllString(raw, " "))
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
+
+// unique returns name, or name with a number after it if it's in taken, and
+// adds what it returns to taken.
+func unique(name string, taken map[string]struct{}) string {
+	base := name
+	for i := 2; ; i++ {
+		if _, ok := taken[name]; !ok {
+			break
+		}
+		name = base + strconv.Itoa(i)
+	}
+	taken[name] = struct{}{}
+	return name
+}
+
+// typeName returns a name for a declaration made from raw, which no other
+// declaration has.
+func (im *importer) typeName(raw string) string {
+	name := camel(raw)
+	if name == "" {
+		name = "Type"
+	}
+	return unique(name, im.names)
+}
+
+// memberName returns the name of a field or case whose name in the document
+// is raw, which is the same if it can be.
+func (im *importer) memberName(raw, at string) string {
+	if identifier.MatchString(raw) {
+		return raw
+	}
+	name := camel(raw)
+	if name == "" {
+		name = "value"
+	} else if name[0] != '_' {
+		name = strings.ToLower(name[:1]) + name[1:]
+	}
+	im.report(at, "%q isn't a Lugma identifier, so it's named %s, which is how it's sent now", raw, name)
+	return name
+}
+
+// declare makes a declaration of kind from s.
+func (im *importer) declare(k kind, s *schema, at, hint string) *decl {
+	d := &decl{kind: k, name: im.typeName(hint), at: at, docs: s.docs(), schema: s}
+	im.decls = append(im.decls, d)
+	return d
+}
+
+// hoist makes a declaration of kind from s, which is written inline.
+func (im *importer) hoist(k kind, s *schema, at, hint string) typ {
+	d := im.declare(k, s, at, hint)
+	im.fill(d)
+	return d
+}
+
+// fill converts the schema of d into its contents.
+func (im *importer) fill(d *decl) {
+	if d.filled || d.filling {
+		return
+	}
+	d.filling = true
+	switch d.kind {
+	case aliasKind:
+		d.underlying = im.typeOf(d.schema, d.at, d.name)
+	case structKind:
+		d.fields = im.fields(d.schema, d.at, d.name)
+	case enumKind:
+		d.cases = im.cases(d.schema, d.at, d.name)
+	}
+	d.filling = false
+	d.filled = true
+}
+
+// variants returns the schemas s is one of, keyed by where they are, and
+// whether one of them is null.
+func variants(s *schema, at string) (vs ordered[*schema], nullable bool) {
+	for _, list := range []entry[[]*schema]{{"oneOf", s.OneOf}, {"anyOf", s.AnyOf}} {
+		for i, v := range list.Value {
+			if v.isNull() {
+				nullable = true
+				continue
+			}
+			vs = append(vs, entry[*schema]{child(at, list.Key, strconv.Itoa(i)), v})
+		}
+	}
+	return vs, nullable
+}
+
+// stringsOnly returns whether every value in an enum is a string, leaving out
+// null.
+func stringsOnly(values []interface{}) bool {
+	for _, v := range values {
+		if _, ok := v.(string); !ok && v != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// kindOf returns what kind of declaration s is imported as if it's named.
+func kindOf(s *schema) kind {
+	if s.Ref != "" {
+		return aliasKind
+	}
+	vs, _ := variants(s, "")
+	var kinds []string
+	for _, t := range s.Type {
+		if t != "null" {
+			kinds = append(kinds, t)
+		}
+	}
+	object := len(kinds) == 0 || len(kinds) == 1 && kinds[0] == "object"
+	switch {
+	case len(s.Enum) > 0 && stringsOnly(s.Enum):
+		return enumKind
+	case len(vs) > 1:
+		return enumKind
+	case len(vs) == 0 && object && (len(s.Properties) > 0 || len(s.AllOf) > 1):
+		return structKind
+	}
+	return aliasKind
+}
+
+// typeOf returns the type of the values s allows, declaring what it needs
+// named after hint, or nil if Lugma can't describe them, which is reported.
+func (im *importer) typeOf(s *schema, at, hint string) typ {
+	if s == nil {
+		im.report(at, "there's no schema, so it's left out")
+		return nil
+	}
+	if s.Ref != "" {
+		if d, ok := im.refs[s.Ref]; ok {
+			return d
+		}
+		im.report(at, "refers to %s, which isn't a schema that can be imported, so it's left out", s.Ref)
+		return nil
+	}
+
+	vs, nullable := variants(s, at)
+	nullable = nullable || s.Nullable
+	var kinds []string
+	for _, t := range s.Type {
+		if t == "null" {
+			nullable = true
+		} else {
+			kinds = append(kinds, t)
+		}
+	}
+	for _, v := range s.Enum {
+		if v == nil {
+			nullable = true
+		}
+	}
+
+	t := im.nonNull(s, at, hint, vs, kinds)
+	if t != nil && nullable {
+		return optional(t)
+	}
+	return t
+}
+
+func (im *importer) nonNull(s *schema, at, hint string, vs ordered[*schema], kinds []string) typ {
+	switch kindOf(s) {
+	case structKind:
+		return im.hoist(structKind, s, at, hint)
+	case enumKind:
+		return im.hoist(enumKind, s, at, hint)
+	}
+	switch {
+	case len(vs) == 1:
+		return im.typeOf(vs[0].Value, vs[0].Key, hint)
+	case len(s.AllOf) == 1:
+		return im.typeOf(s.AllOf[0], child(at, "allOf", "0"), hint)
+	case len(s.Enum) > 0:
+		im.report(at, "only enums of strings can be imported, so only the type of its values is")
+	}
+
+	if len(kinds) > 1 {
+		im.report(at, "can be any of %s, which Lugma can't describe, so it's left out", strings.Join(kinds, ", "))
+		return nil
+	}
+	kind := ""
+	if len(kinds) == 1 {
+		kind = kinds[0]
+	}
+
+	switch kind {
+	case "string":
+		switch s.Format {
+		case "date-time":
+			return primitive("Timestamp")
+		case "uuid":
+			return primitive("UUID")
+		case "byte", "binary":
+			return primitive("Bytes")
+		case "int64":
+			return primitive("Int64")
+		case "uint64":
+			return primitive("UInt64")
+		}
+		if s.ContentEncoding == "base64" {
+			return primitive("Bytes")
+		}
+		if s.Pattern == backends.DurationPattern {
+			// which is how Durations are exported
+			return primitive("Duration")
+		}
+		if s.Format != "" {
+			im.report(at, "is a string of the format %q, which Lugma doesn't have, so it's any String", s.Format)
+		} else if s.Pattern != "" {
+			im.report(at, "is a string matching %s, which Lugma can't check, so it's any String", s.Pattern)
+		}
+		return primitive("String")
+	case "integer":
+		return im.integerOf(s, at)
+	case "number":
+		if s.Format == "float" {
+			return primitive("Float32")
+		}
+		return primitive("Float64")
+	case "boolean":
+		return primitive("Bool")
+	case "array":
+		if s.Items == nil {
+			im.report(at, "is an array of anything, which Lugma can't describe, so it's left out")
+			return nil
+		}
+		element := im.typeOf(s.Items, child(at, "items"), hint+"Item")
+		if element == nil {
+			return nil
+		}
+		return arrayOf{element}
+	case "object", "":
+		if a := s.AdditionalProperties; a != nil && a.Schema != nil {
+			element := im.typeOf(a.Schema, child(at, "additionalProperties"), hint+"Value")
+			if element == nil {
+				return nil
+			}
+			return dictionaryOf{primitive("String"), element}
+		}
+		if kind == "object" {
+			im.report(at, "is an object with any properties, which Lugma can't describe, so it's left out")
+		} else {
+			im.report(at, "allows any value, which Lugma can't describe, so it's left out")
+		}
+		return nil
+	default:
+		im.report(at, "has the unknown type %q, so it's left out", kind)
+		return nil
+	}
+}
+
+// integers are the ranges of Lugma's integer types, which are how they're
+// described when exported.
+var integers = []struct {
+	name     string
+	min, max float64
+}{
+	{"UInt8", 0, 255},
+	{"UInt16", 0, 65535},
+	{"UInt32", 0, 4294967295},
+	{"Int8", -128, 127},
+	{"Int16", -32768, 32767},
+	{"Int32", -2147483648, 2147483647},
+}
+
+// integerOf returns the integer type of s, reporting bounds that it doesn't
+// keep.
+func (im *importer) integerOf(s *schema, at string) typ {
+	switch s.Format {
+	case "int32":
+		return primitive("Int32")
+	case "int64":
+		return primitive("Int64")
+	}
+	if s.Minimum != nil && s.Maximum != nil {
+		for _, integer := range integers {
+			if *s.Minimum == integer.min && *s.Maximum == integer.max {
+				return primitive(integer.name)
+			}
+		}
+	}
+	if s.Minimum != nil || s.Maximum != nil {
+		im.report(at, "is an integer with bounds that no Lugma integer has, so it's any Int64")
+	}
+	return primitive("Int64")
+}
+
+// fields returns the fields of objects s allows, declaring what they need
+// named after owner.
+func (im *importer) fields(s *schema, at, owner string) []*field {
+	var fields []*field
+	for i, member := range s.AllOf {
+		mat := child(at, "allOf", strconv.Itoa(i))
+		if member.Ref != "" {
+			d, ok := im.refs[member.Ref]
+			if ok && d.kind == structKind {
+				im.fill(d)
+				fields = append(fields, d.fields...)
+			} else {
+				im.report(mat, "only objects can be combined, so %s is left out", member.Ref)
+			}
+			continue
+		}
+		fields = append(fields, im.fields(member, mat, owner)...)
+	}
+
+	required := map[string]bool{}
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	for _, prop := range s.Properties {
+		pat := child(at, "properties", prop.Key)
+		t := im.typeOf(prop.Value, pat, owner+camel(prop.Key))
+		if t == nil {
+			continue
+		}
+		f := &field{
+			name:     im.memberName(prop.Key, pat),
+			at:       pat,
+			typ:      t,
+			required: required[prop.Key],
+		}
+		if prop.Value != nil {
+			f.docs = prop.Value.docs()
+			f.def = prop.Value.Default
+		}
+		if !required[prop.Key] && f.def == nil {
+			f.typ = optional(t)
+		}
+		fields = append(fields, f)
+	}
+
+	// later fields replace earlier ones of the same name, as properties of
+	// objects in allOf are overridden by the object's own
+	var kept []*field
+	index := map[string]int{}
+	for _, f := range fields {
+		if i, ok := index[f.name]; ok {
+			kept[i] = f
+			continue
+		}
+		index[f.name] = len(kept)
+		kept = append(kept, f)
+	}
+	return kept
+}
+
+// cases returns the cases of an enum made of s, declaring what they need
+// named after owner.
+func (im *importer) cases(s *schema, at, owner string) []*enumCase {
+	var cases []*enumCase
+	taken := map[string]struct{}{}
+
+	if len(s.Enum) > 0 {
+		for i, v := range s.Enum {
+			value, ok := v.(string)
+			if !ok {
+				continue
+			}
+			name := unique(im.memberName(value, child(at, "enum", strconv.Itoa(i))), taken)
+			cases = append(cases, &enumCase{name: name, value: value})
+		}
+		return cases
+	}
+
+	mapping := map[string]string{}
+	if s.Discriminator != nil {
+		for name, ref := range s.Discriminator.Mapping {
+			mapping[ref] = name
+		}
+	}
+
+	vs, _ := variants(s, at)
+	for i, v := range vs {
+		vat, variant := v.Key, v.Value
+		esac := &enumCase{docs: variant.docs()}
+
+		if name, inner, ok := exported(variant); ok {
+			// enums exported from Lugma are objects of one property, named
+			// after the case, holding its fields
+			esac.name = im.memberName(name, child(vat, "properties", name))
+			esac.fields = im.fields(inner, child(vat, "properties", name), owner+camel(name))
+		} else if variant.Ref == "" && kindOf(variant) == structKind {
+			esac.name = "case" + strconv.Itoa(i+1)
+			if variant.Title != "" {
+				esac.name = strcase.ToLowerCamel(camel(variant.Title))
+			}
+			esac.docs = variant.Description
+			esac.fields = im.fields(variant, vat, owner+camel(esac.name))
+		} else {
+			t := im.typeOf(variant, vat, owner+"Case"+strconv.Itoa(i+1))
+			if t == nil {
+				continue
+			}
+			switch k := t.(type) {
+			case *decl:
+				esac.name = strcase.ToLowerCamel(k.name)
+				if name, ok := mapping[variant.Ref]; ok && identifier.MatchString(name) {
+					esac.name = name
+				}
+			case primitive:
+				esac.name = strcase.ToLowerCamel(string(k))
+			default:
+				esac.name = "case" + strconv.Itoa(i+1)
+			}
+			if variant.Title != "" {
+				esac.name = strcase.ToLowerCamel(camel(variant.Title))
+			}
+			esac.fields = []*field{{name: "value", at: vat, typ: t, required: true}}
+		}
+		esac.name = unique(esac.name, taken)
+		cases = append(cases, esac)
+	}
+	return cases
+}
+
+// exported returns whether s is an object of one property and nothing else,
+// the property's name and its schema, which is how Lugma exports cases of
+// enums with fields.
+func exported(s *schema) (string, *schema, bool) {
+	if s.Ref != "" || len(s.Properties) != 1 || len(s.Required) != 1 {
+		return "", nil, false
+	}
+	if s.AdditionalProperties == nil || s.AdditionalProperties.Allowed {
+		return "", nil, false
+	}
+	prop := s.Properties[0]
+	if prop.Key != s.Required[0] || prop.Value == nil || prop.Value.Ref != "" {
+		return "", nil, false
+	}
+	for _, t := range prop.Value.Type {
+		if t != "object" {
+			return "", nil, false
+		}
+	}
+	return prop.Key, prop.Value, true
+}
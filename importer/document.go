@@ -0,0 +1,196 @@
+package importer
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// The parts of OpenAPI 3 documents and JSON Schemas that can be imported.
+// Both are read as YAML, which JSON is a subset of.
+
+// entry is an entry of an ordered mapping.
+type entry[T any] struct {
+	Key   string
+	Value T
+}
+
+// ordered is a mapping whose entries are kept in the order they were
+// written, so that what's imported from it is too.
+type ordered[T any] []entry[T]
+
+func (o *ordered[T]) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: expected a mapping", value.Line)
+	}
+	for i := 0; i+1 < len(value.Content); i += 2 {
+		var v T
+		err := value.Content[i+1].Decode(&v)
+		if err != nil {
+			return err
+		}
+		*o = append(*o, entry[T]{value.Content[i].Value, v})
+	}
+	return nil
+}
+
+// types is the type of a schema, which is either one type or a list of them.
+type types []string
+
+func (t *types) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*t = types{value.Value}
+		return nil
+	}
+	return value.Decode((*[]string)(t))
+}
+
+// additional is what a schema says about properties it doesn't list, which is
+// either whether there can be any or a schema of them.
+type additional struct {
+	Allowed bool
+	Schema  *schema
+}
+
+func (a *additional) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		return value.Decode(&a.Allowed)
+	}
+	a.Allowed = true
+	return value.Decode(&a.Schema)
+}
+
+type schema struct {
+	Ref             string `yaml:"$ref"`
+	Type            types  `yaml:"type"`
+	Format          string `yaml:"format"`
+	ContentEncoding string `yaml:"contentEncoding"`
+	Pattern         string `yaml:"pattern"`
+	Nullable        bool   `yaml:"nullable"`
+	Title           string `yaml:"title"`
+	Description     string `yaml:"description"`
+
+	Enum    []interface{} `yaml:"enum"`
+	Default interface{}   `yaml:"default"`
+	Minimum *float64      `yaml:"minimum"`
+	Maximum *float64      `yaml:"maximum"`
+
+	Items                *schema          `yaml:"items"`
+	Properties           ordered[*schema] `yaml:"properties"`
+	Required             []string         `yaml:"required"`
+	AdditionalProperties *additional      `yaml:"additionalProperties"`
+	OneOf                []*schema        `yaml:"oneOf"`
+	AnyOf                []*schema        `yaml:"anyOf"`
+	AllOf                []*schema        `yaml:"allOf"`
+	Discriminator        *discriminator   `yaml:"discriminator"`
+	Defs                 ordered[*schema] `yaml:"$defs"`
+	Definitions          ordered[*schema] `yaml:"definitions"`
+}
+
+type discriminator struct {
+	PropertyName string            `yaml:"propertyName"`
+	Mapping      map[string]string `yaml:"mapping"`
+}
+
+// isNull returns whether s only allows null, which is how OpenAPI 3.1 and
+// JSON Schema make other schemas nullable.
+func (s *schema) isNull() bool {
+	return s != nil && len(s.Type) == 1 && s.Type[0] == "null"
+}
+
+// docs returns the documentation of what s describes.
+func (s *schema) docs() string {
+	if s.Description != "" {
+		return s.Description
+	}
+	return s.Title
+}
+
+type document struct {
+	OpenAPI string `yaml:"openapi"`
+	Swagger string `yaml:"swagger"`
+	Info    struct {
+		Title       string `yaml:"title"`
+		Description string `yaml:"description"`
+		Version     string `yaml:"version"`
+	} `yaml:"info"`
+	Paths      ordered[*pathItem] `yaml:"paths"`
+	Components struct {
+		Schemas       ordered[*schema]        `yaml:"schemas"`
+		Parameters    map[string]*parameter   `yaml:"parameters"`
+		RequestBodies map[string]*requestBody `yaml:"requestBodies"`
+		Responses     map[string]*response    `yaml:"responses"`
+	} `yaml:"components"`
+}
+
+type pathItem struct {
+	Ref        string       `yaml:"$ref"`
+	Parameters []*parameter `yaml:"parameters"`
+
+	Get     *operation `yaml:"get"`
+	Put     *operation `yaml:"put"`
+	Post    *operation `yaml:"post"`
+	Delete  *operation `yaml:"delete"`
+	Options *operation `yaml:"options"`
+	Head    *operation `yaml:"head"`
+	Patch   *operation `yaml:"patch"`
+	Trace   *operation `yaml:"trace"`
+}
+
+// operations returns the operations of p keyed by their methods, in the order
+// OpenAPI lists them.
+func (p *pathItem) operations() ordered[*operation] {
+	var ops ordered[*operation]
+	for _, op := range []entry[*operation]{
+		{"get", p.Get}, {"put", p.Put}, {"post", p.Post}, {"delete", p.Delete},
+		{"options", p.Options}, {"head", p.Head}, {"patch", p.Patch}, {"trace", p.Trace},
+	} {
+		if op.Value != nil {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
+type operation struct {
+	OperationID string             `yaml:"operationId"`
+	Summary     string             `yaml:"summary"`
+	Description string             `yaml:"description"`
+	Parameters  []*parameter       `yaml:"parameters"`
+	RequestBody *requestBody       `yaml:"requestBody"`
+	Responses   ordered[*response] `yaml:"responses"`
+}
+
+type parameter struct {
+	Ref         string  `yaml:"$ref"`
+	Name        string  `yaml:"name"`
+	In          string  `yaml:"in"`
+	Description string  `yaml:"description"`
+	Required    bool    `yaml:"required"`
+	Schema      *schema `yaml:"schema"`
+}
+
+type mediaType struct {
+	Schema *schema `yaml:"schema"`
+}
+
+type requestBody struct {
+	Ref         string              `yaml:"$ref"`
+	Description string              `yaml:"description"`
+	Required    bool                `yaml:"required"`
+	Content     ordered[*mediaType] `yaml:"content"`
+}
+
+type response struct {
+	Ref         string              `yaml:"$ref"`
+	Description string              `yaml:"description"`
+	Content     ordered[*mediaType] `yaml:"content"`
+}
+
+// isJSON returns whether a media type is JSON.
+func isJSON(mediaType string) bool {
+	mediaType, _, _ = strings.Cut(mediaType, ";")
+	mediaType = strings.TrimSpace(mediaType)
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
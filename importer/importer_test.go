@@ -0,0 +1,304 @@
+package importer
+
+import (
+	"lugmac/ast"
+	"lugmac/backends/jsonschema"
+	"lugmac/typechecking"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// imported imports document, read like the file named file, with do, checks
+// that what's imported typechecks and returns its source and the problems
+// reported.
+func imported(t *testing.T, file, document string, doc interface{}, do func(im *importer)) (string, []string) {
+	t.Helper()
+	err := yaml.Unmarshal([]byte(document), doc)
+	if err != nil {
+		t.Fatalf("failed to parse %s: %s", file, err)
+	}
+	im := newImporter(file)
+	do(im)
+	source, err := im.source()
+	if err != nil {
+		t.Fatalf("failed to write imported source: %s", err)
+	}
+	checked(t, string(source))
+	return string(source), im.problems
+}
+
+// checked typechecks source as the module M of a workspace named Test.
+func checked(t *testing.T, source string) (*typechecking.Module, *typechecking.Context) {
+	t.Helper()
+	tree, err := ast.Parse([]byte(source))
+	if err != nil {
+		t.Fatalf("failed to parse:\n%s\n%s", err, source)
+	}
+	tree.Path = "M.lugma"
+	ctx := typechecking.NewContext(typechecking.FileImportResolver)
+	m, err := ctx.Module(tree, "Test/M")
+	if err != nil {
+		t.Fatalf("failed to check:\n%s\n%s", err, source)
+	}
+	return m, ctx
+}
+
+// fromJSONSchema imports document as a JSON Schema named Test.
+func fromJSONSchema(t *testing.T, document string) (string, []string) {
+	t.Helper()
+	root := &schema{}
+	return imported(t, "test.json", document, root, func(im *importer) {
+		im.jsonSchema(root, "Test")
+	})
+}
+
+// checkImport compares what was imported with what's wanted.
+func checkImport(t *testing.T, source string, problems []string, wantSource string, wantProblems []string) {
+	t.Helper()
+	if strings.TrimSpace(source) != strings.TrimSpace(wantSource) {
+		t.Errorf("imported:\n%s\nwant:\n%s", source, wantSource)
+	}
+	if strings.Join(problems, "\n") != strings.Join(wantProblems, "\n") {
+		t.Errorf("reported:\n%s\nwant:\n%s", strings.Join(problems, "\n"), strings.Join(wantProblems, "\n"))
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	cases := []struct {
+		name     string
+		document string
+		source   string
+		problems []string
+	}{
+		{
+			name: "properties in document order",
+			document: `{"$defs": {"User": {"type": "object", "required": ["name", "id"], "properties": {
+				"name": {"type": "string"},
+				"id": {"type": "string", "format": "uuid"},
+				"age": {"type": "integer", "minimum": 0, "maximum": 255}
+			}}}}`,
+			source: `
+struct User {
+    let name: String
+    let id: UUID
+    let age: UInt8?
+}`,
+		},
+		{
+			name: "lossy mappings",
+			document: `{"$defs": {"Lossy": {"type": "object", "required": ["email", "code", "count"], "properties": {
+				"email": {"type": "string", "format": "email"},
+				"code": {"type": "string", "pattern": "^[A-Z]{3}$"},
+				"count": {"type": "integer", "minimum": 1, "maximum": 10},
+				"timeout": {"type": "string", "pattern": "^-?[0-9]+(\\.[0-9]{1,9})?s$"}
+			}}}}`,
+			source: `
+struct Lossy {
+    let email: String
+    let code: String
+    let count: Int64
+    let timeout: Duration?
+}`,
+			problems: []string{
+				`test.json#/$defs/Lossy/properties/email: is a string of the format "email", which Lugma doesn't have, so it's any String`,
+				`test.json#/$defs/Lossy/properties/code: is a string matching ^[A-Z]{3}$, which Lugma can't check, so it's any String`,
+				`test.json#/$defs/Lossy/properties/count: is an integer with bounds that no Lugma integer has, so it's any Int64`,
+			},
+		},
+		{
+			name: "enums and defaults",
+			document: `{"$defs": {
+				"Colour": {"type": "string", "enum": ["red", "dark-green"]},
+				"Paint": {"type": "object", "properties": {
+					"colour": {"$ref": "#/$defs/Colour", "default": "red"},
+					"coats": {"type": "integer", "format": "int32", "default": 2},
+					"finish": {"$ref": "#/$defs/Finish"}
+				}},
+				"Finish": {"oneOf": [
+					{"type": "object", "required": ["matte"], "additionalProperties": false, "properties": {
+						"matte": {"type": "object", "properties": {}}
+					}},
+					{"type": "object", "required": ["gloss"], "additionalProperties": false, "properties": {
+						"gloss": {"type": "object", "required": ["shine"], "properties": {"shine": {"type": "number"}}}
+					}}
+				]}
+			}}`,
+			source: `
+struct Paint {
+    let colour: Colour = "red"
+    let coats: Int32 = 2
+    let finish: Finish?
+}
+
+enum Colour {
+    case red
+    case darkGreen
+}
+
+enum Finish {
+    case matte
+    case gloss(shine: Float64)
+}`,
+			problems: []string{
+				`test.json#/$defs/Colour/enum/1: "dark-green" isn't a Lugma identifier, so it's named darkGreen, which is how it's sent now`,
+			},
+		},
+		{
+			name: "struct and enum containing each other",
+			document: `{"$defs": {
+				"Tree": {"oneOf": [
+					{"type": "object", "required": ["leaf"], "additionalProperties": false, "properties": {
+						"leaf": {"type": "object", "properties": {}}
+					}},
+					{"type": "object", "required": ["branch"], "additionalProperties": false, "properties": {
+						"branch": {"type": "object", "required": ["node"], "properties": {"node": {"$ref": "#/$defs/Branch"}}}
+					}}
+				]},
+				"Branch": {"type": "object", "required": ["children"], "properties": {
+					"children": {"type": "array", "items": {"$ref": "#/$defs/Tree"}}
+				}}
+			}}`,
+			source: `
+struct Branch {}
+
+enum Tree {
+    case leaf
+    case branch(node: Branch)
+}`,
+			problems: []string{
+				`test.json#/$defs/Branch/properties/children: refers back to Tree, which Lugma can't describe, so it's left out`,
+			},
+		},
+		{
+			name: "what can't be described",
+			document: `{"$defs": {"Loose": {"type": "object", "required": ["any"], "properties": {
+				"any": {},
+				"either": {"type": ["string", "integer"]},
+				"name": {"type": "string"}
+			}}}}`,
+			source: `
+struct Loose {
+    let name: String?
+}`,
+			problems: []string{
+				`test.json#/$defs/Loose/properties/any: allows any value, which Lugma can't describe, so it's left out`,
+				`test.json#/$defs/Loose/properties/either: can be any of string, integer, which Lugma can't describe, so it's left out`,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			source, problems := fromJSONSchema(t, c.document)
+			checkImport(t, source, problems, c.source, c.problems)
+		})
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	pets := `
+openapi: 3.0.3
+info:
+  title: Pets
+  version: 1.0.0
+paths:
+  /pets/{id}:
+    get:
+      operationId: getPet
+      summary: Gets a pet.
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema: {type: string, format: uuid}
+      responses:
+        "200":
+          description: The pet.
+          content:
+            application/json:
+              schema: {$ref: "#/components/schemas/Pet"}
+        "404":
+          description: There's no such pet.
+          content:
+            application/json:
+              schema: {type: string}
+  /pets:
+    post:
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              type: object
+              required: [name]
+              properties:
+                name: {type: string}
+                tags: {type: array, items: {type: string}}
+      responses:
+        "204":
+          description: Added.
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name, id]
+      properties:
+        name: {type: string}
+        id: {type: string, format: uuid}
+`
+	doc := &document{}
+	source, problems := imported(t, "pets.yaml", pets, doc, func(im *importer) {
+		im.openAPI(doc)
+	})
+	checkImport(t, source, problems, `
+struct Pet {
+    let name: String
+    let id: UUID
+}
+
+/**
+    Gets a pet.
+
+    - Returns: The pet.
+
+    - Throws: There's no such pet.
+*/
+func getPet(id: UUID) throws String -> Pet
+
+func postPets(name: String, tags: [String]?)`, nil)
+}
+
+// TestRoundTrip imports the JSON Schema exported of a module and checks that
+// its declarations come back as they were.
+func TestRoundTrip(t *testing.T) {
+	source := `
+struct User {
+    let name: String
+    let id: UUID
+    let joined: Timestamp
+    let timeout: Duration
+    let avatar: Bytes
+    let balance: Int64
+    let score: Float32
+    let age: UInt8?
+    let admin: Bool
+    let friends: [String]
+    let visits: [String: UInt32]
+}
+
+enum Role {
+    case reader
+    case writer
+}`
+
+	m, ctx := checked(t, source)
+	exported, err := jsonschema.JSONSchemaBackend{}.GenerateSchema(m, ctx)
+	if err != nil {
+		t.Fatalf("failed to export: %s", err)
+	}
+
+	got, problems := fromJSONSchema(t, string(exported))
+	checkImport(t, got, problems, source, nil)
+}
@@ -0,0 +1,254 @@
+package importer
+
+import (
+	"strconv"
+	"strings"
+)
+
+// components declares the named schemas in schemas, which are at at, without
+// filling them in, so that they can refer to each other.
+func (im *importer) components(schemas ordered[*schema], at string) []*decl {
+	var named []*decl
+	for _, s := range schemas {
+		if s.Value == nil {
+			continue
+		}
+		d := im.declare(kindOf(s.Value), s.Value, child(at, s.Key), s.Key)
+		d.component = true
+		im.refs["#"+d.at] = d
+		named = append(named, d)
+	}
+	return named
+}
+
+// openAPI imports the schemas and operations of doc.
+func (im *importer) openAPI(doc *document) {
+	for _, d := range im.components(doc.Components.Schemas, "/components/schemas") {
+		im.fill(d)
+	}
+
+	for _, path := range doc.Paths {
+		at := child("", "paths", path.Key)
+		if path.Value == nil {
+			continue
+		}
+		if path.Value.Ref != "" {
+			im.report(at, "refers to a path item elsewhere, which can't be imported, so it's left out")
+			continue
+		}
+		for _, op := range path.Value.operations() {
+			im.operation(doc, path.Key, op.Key, path.Value, op.Value, child(at, op.Key))
+		}
+	}
+}
+
+// jsonSchema imports the definitions of root, and root itself named after
+// name if it describes anything.
+func (im *importer) jsonSchema(root *schema, name string) {
+	named := im.components(root.Defs, "/$defs")
+	named = append(named, im.components(root.Definitions, "/definitions")...)
+
+	if root.Ref != "" || len(root.Type) > 0 || len(root.Properties) > 0 || len(root.Enum) > 0 ||
+		len(root.OneOf) > 0 || len(root.AnyOf) > 0 || len(root.AllOf) > 0 {
+		if root.Title != "" {
+			name = root.Title
+		}
+		d := im.declare(kindOf(root), root, "", name)
+		d.component = true
+		im.refs["#"] = d
+		named = append(named, d)
+	}
+
+	for _, d := range named {
+		im.fill(d)
+	}
+}
+
+// jsonContent returns the schema of the JSON one of content and where it is,
+// or nil if there isn't one, which is reported.
+func (im *importer) jsonContent(content ordered[*mediaType], at string) (*schema, string, bool) {
+	var types []string
+	for _, mt := range content {
+		if isJSON(mt.Key) && mt.Value != nil {
+			return mt.Value.Schema, child(at, mt.Key, "schema"), true
+		}
+		types = append(types, mt.Key)
+	}
+	if len(types) > 0 {
+		im.report(at, "is only sent as %s, and only JSON can be imported, so it's left out", strings.Join(types, ", "))
+	}
+	return nil, "", false
+}
+
+// empty returns whether s only allows objects without any properties.
+func empty(s *schema) bool {
+	return s != nil && s.Ref == "" && len(s.Type) == 1 && s.Type[0] == "object" &&
+		len(s.Properties) == 0 && len(s.AllOf) == 0 && len(s.OneOf) == 0 && len(s.AnyOf) == 0 &&
+		(s.AdditionalProperties == nil || !s.AdditionalProperties.Allowed)
+}
+
+// functionName returns the name of the function made of an operation whose
+// ID is raw, which no other declaration or function has.
+func (im *importer) functionName(raw string) string {
+	name := raw
+	if !identifier.MatchString(name) {
+		name = camel(raw)
+		if name == "" {
+			name = "call"
+		} else if name[0] != '_' {
+			name = strings.ToLower(name[:1]) + name[1:]
+		}
+	}
+	return unique(name, im.names)
+}
+
+// operation imports the operation at method on path as a function, which takes
+// the operation's parameters and the properties of what it's sent as its
+// arguments.
+func (im *importer) operation(doc *document, path, method string, item *pathItem, op *operation, at string) {
+	raw := op.OperationID
+	if raw == "" {
+		raw = method + " " + path
+	}
+	fn := &function{name: im.functionName(raw), at: at}
+	var docs []string
+	for _, text := range []string{op.Summary, op.Description} {
+		if text = strings.TrimSpace(text); text != "" {
+			docs = append(docs, text)
+		}
+	}
+	fn.docs = strings.Join(docs, "\n\n")
+	im.functions = append(im.functions, fn)
+
+	taken := map[string]struct{}{}
+	argument := func(f *field) {
+		name := unique(f.name, taken)
+		if name != f.name {
+			im.report(f.at, "another argument is already named %s, so it's named %s", f.name, name)
+			f.name = name
+		}
+		fn.arguments = append(fn.arguments, f)
+	}
+
+	// parameters of the operation replace those of its path with the same
+	// name and location
+	var params []entry[*parameter]
+	for _, list := range []struct {
+		at     string
+		params []*parameter
+	}{
+		{child("", "paths", path, "parameters"), item.Parameters},
+		{child(at, "parameters"), op.Parameters},
+	} {
+		for i, p := range list.params {
+			pat := child(list.at, strconv.Itoa(i))
+			if p.Ref != "" {
+				resolved, ok := doc.Components.Parameters[strings.TrimPrefix(p.Ref, "#/components/parameters/")]
+				if !ok {
+					im.report(pat, "refers to %s, which isn't a parameter that can be imported, so it's left out", p.Ref)
+					continue
+				}
+				p = resolved
+			}
+			replaced := false
+			for j := range params {
+				if params[j].Value.Name == p.Name && params[j].Value.In == p.In {
+					params[j] = entry[*parameter]{pat, p}
+					replaced = true
+				}
+			}
+			if !replaced {
+				params = append(params, entry[*parameter]{pat, p})
+			}
+		}
+	}
+	for _, p := range params {
+		pat, param := p.Key, p.Value
+		t := im.typeOf(param.Schema, child(pat, "schema"), camel(fn.name)+camel(param.Name))
+		if t == nil {
+			continue
+		}
+		f := &field{name: im.memberName(param.Name, pat), docs: param.Description, at: pat, typ: t, required: param.Required}
+		if param.Schema != nil {
+			f.def = param.Schema.Default
+		}
+		if !param.Required && f.def == nil {
+			f.typ = optional(t)
+		}
+		argument(f)
+	}
+
+	if body := op.RequestBody; body != nil {
+		bat := child(at, "requestBody")
+		if body.Ref != "" {
+			resolved, ok := doc.Components.RequestBodies[strings.TrimPrefix(body.Ref, "#/components/requestBodies/")]
+			if !ok {
+				im.report(bat, "refers to %s, which isn't a request body that can be imported, so it's left out", body.Ref)
+			}
+			body = resolved
+		}
+		if body != nil {
+			if s, sat, ok := im.jsonContent(body.Content, child(bat, "content")); ok {
+				if empty(s) {
+					// functions that take nothing are sent an empty object
+				} else if s.Ref == "" && kindOf(s) == structKind {
+					// objects sent inline are spread into arguments, as
+					// functions take theirs as an object
+					for _, f := range im.fields(s, sat, camel(fn.name)) {
+						argument(f)
+					}
+				} else if t := im.typeOf(s, sat, camel(fn.name)+"Body"); t != nil {
+					if !body.Required {
+						t = optional(t)
+					}
+					argument(&field{name: "body", docs: body.Description, at: bat, typ: t, required: body.Required})
+				}
+			}
+		}
+	}
+
+	decided := false
+	for _, r := range op.Responses {
+		rat := child(at, "responses", r.Key)
+		resp := r.Value
+		if resp == nil {
+			continue
+		}
+		if resp.Ref != "" {
+			resolved, ok := doc.Components.Responses[strings.TrimPrefix(resp.Ref, "#/components/responses/")]
+			if !ok {
+				im.report(rat, "refers to %s, which isn't a response that can be imported, so it's left out", resp.Ref)
+				continue
+			}
+			resp = resolved
+		}
+
+		if strings.HasPrefix(r.Key, "2") {
+			if decided {
+				im.report(rat, "only the first successful response can be returned, so it's left out")
+				continue
+			}
+			decided = true
+			fn.returnsDocs = resp.Description
+			if len(resp.Content) == 0 {
+				continue
+			}
+			if s, sat, ok := im.jsonContent(resp.Content, child(rat, "content")); ok {
+				fn.returns = im.typeOf(s, sat, camel(fn.name)+"Result")
+			}
+			continue
+		}
+
+		if len(resp.Content) == 0 {
+			continue
+		}
+		if fn.throws != nil {
+			im.report(rat, "only the first error response can be thrown, so it's left out")
+			continue
+		}
+		if s, sat, ok := im.jsonContent(resp.Content, child(rat, "content")); ok {
+			fn.throws = im.typeOf(s, sat, camel(fn.name)+"Error")
+			fn.throwsDocs = resp.Description
+		}
+	}
+}
@@ -0,0 +1,512 @@
+package importer
+
+import (
+	"fmt"
+	"lugmac/backends"
+	"lugmac/format"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Declarations can refer to each other in any order, but a struct or enum
+// can't contain itself, however indirectly. Aliases of structs and enums are
+// written out where they're used, so that only structs and enums have to be
+// checked for what would contain itself.
+
+// refsIn returns the declarations t refers to directly.
+func refsIn(t typ) []*decl {
+	switch k := t.(type) {
+	case arrayOf:
+		return refsIn(k.element)
+	case dictionaryOf:
+		return append(refsIn(k.key), refsIn(k.element)...)
+	case optionalOf:
+		return refsIn(k.element)
+	case *decl:
+		return []*decl{k}
+	}
+	return nil
+}
+
+// checkAlias marks d, an alias, as broken if it refers to itself, and returns
+// whether it is.
+func (im *importer) checkAlias(d *decl) bool {
+	switch d.visit {
+	case 1:
+		return true
+	case 2:
+		return d.broken
+	}
+	d.visit = 1
+	for _, ref := range refsIn(d.underlying) {
+		if ref.kind == aliasKind && im.checkAlias(ref) {
+			d.broken = true
+		}
+	}
+	d.visit = 2
+	if d.broken {
+		im.report(d.at, "refers to itself, which Lugma can't describe, so it and what uses it are left out")
+	}
+	return d.broken
+}
+
+// plain returns whether t only refers to aliases of primitives, so that an
+// alias of it can be declared.
+func plain(t typ) bool {
+	switch k := t.(type) {
+	case nil:
+		return false
+	case arrayOf:
+		return plain(k.element)
+	case dictionaryOf:
+		return plain(k.key) && plain(k.element)
+	case optionalOf:
+		return plain(k.element)
+	case *decl:
+		return k.kind == aliasKind && !k.broken && plain(k.underlying)
+	}
+	return true
+}
+
+// expand returns t with the aliases that can't be declared written out in
+// full, or nil if it refers to a broken one.
+func expand(t typ) typ {
+	switch k := t.(type) {
+	case arrayOf:
+		element := expand(k.element)
+		if element == nil {
+			return nil
+		}
+		return arrayOf{element}
+	case dictionaryOf:
+		key, element := expand(k.key), expand(k.element)
+		if key == nil || element == nil {
+			return nil
+		}
+		return dictionaryOf{key, element}
+	case optionalOf:
+		element := expand(k.element)
+		if element == nil {
+			return nil
+		}
+		return optional(element)
+	case *decl:
+		if k.kind != aliasKind {
+			return k
+		}
+		if k.broken {
+			return nil
+		}
+		if plain(k) {
+			return k
+		}
+		return expand(k.underlying)
+	}
+	return t
+}
+
+// expandFields expands the types of fields, leaving out those that refer to
+// broken aliases.
+func expandFields(fields []*field) []*field {
+	var kept []*field
+	for _, f := range fields {
+		if f.typ = expand(f.typ); f.typ != nil {
+			kept = append(kept, f)
+		}
+	}
+	return kept
+}
+
+// within returns t as it can be used in a field of a struct or enum being
+// arranged, arranging the structs and enums it uses before it, or nil if it
+// can't be used, which is reported.
+func (im *importer) within(t typ, at string, into *[]*decl) typ {
+	switch k := t.(type) {
+	case arrayOf:
+		element := im.within(k.element, at, into)
+		if element == nil {
+			return nil
+		}
+		return arrayOf{element}
+	case dictionaryOf:
+		element := im.within(k.element, at, into)
+		if element == nil {
+			return nil
+		}
+		return dictionaryOf{k.key, element}
+	case optionalOf:
+		element := im.within(k.element, at, into)
+		if element == nil {
+			return nil
+		}
+		return optional(element)
+	case *decl:
+		if k.kind == aliasKind {
+			return k
+		}
+		if k.visit == 1 {
+			im.report(at, "refers back to %s, which Lugma can't describe, so it's left out", k.name)
+			return nil
+		}
+		im.arrange(k, into)
+		return k
+	}
+	return t
+}
+
+// arrange adds d, a struct or enum, to into after what it uses.
+func (im *importer) arrange(d *decl, into *[]*decl) {
+	if d.visit != 0 {
+		return
+	}
+	d.visit = 1
+	within := func(fields []*field) []*field {
+		var kept []*field
+		for _, f := range fields {
+			if f.typ = im.within(f.typ, f.at, into); f.typ != nil {
+				kept = append(kept, f)
+			}
+		}
+		return kept
+	}
+	d.fields = within(d.fields)
+	for _, esac := range d.cases {
+		esac.fields = within(esac.fields)
+	}
+	d.visit = 2
+	*into = append(*into, d)
+}
+
+// use marks d and what it refers to as used.
+func use(t typ, used map[*decl]bool) {
+	for _, d := range refsIn(t) {
+		if used[d] {
+			continue
+		}
+		used[d] = true
+		use(d.underlying, used)
+		for _, f := range d.fields {
+			use(f.typ, used)
+		}
+		for _, esac := range d.cases {
+			for _, f := range esac.fields {
+				use(f.typ, used)
+			}
+		}
+	}
+}
+
+// declarations returns the aliases, structs and enums to declare, each after
+// what it uses.
+func (im *importer) declarations() (aliases, structs, enums []*decl) {
+	for _, d := range im.decls {
+		if d.kind == aliasKind {
+			im.checkAlias(d)
+		}
+	}
+	for _, d := range im.decls {
+		if d.kind == aliasKind {
+			if !d.broken && d.component && !plain(d) {
+				im.report(d.at, "refers to structs or enums, so it's written out where it's used")
+			}
+			continue
+		}
+		d.fields = expandFields(d.fields)
+		for _, esac := range d.cases {
+			esac.fields = expandFields(esac.fields)
+		}
+	}
+	for _, fn := range im.functions {
+		fn.arguments = expandFields(fn.arguments)
+		fn.returns = expand(fn.returns)
+		fn.throws = expand(fn.throws)
+	}
+
+	var arranged []*decl
+	for _, d := range im.decls {
+		if d.kind != aliasKind {
+			im.arrange(d, &arranged)
+		}
+	}
+	for _, d := range arranged {
+		if d.kind == structKind {
+			structs = append(structs, d)
+		} else {
+			enums = append(enums, d)
+		}
+	}
+
+	used := map[*decl]bool{}
+	for _, d := range im.decls {
+		if d.component {
+			use(d, used)
+		}
+	}
+	for _, fn := range im.functions {
+		for _, f := range fn.arguments {
+			use(f.typ, used)
+		}
+		use(fn.returns, used)
+		use(fn.throws, used)
+	}
+
+	// aliases are declared after the aliases they refer to
+	var alias func(d *decl)
+	declared := map[*decl]bool{}
+	alias = func(d *decl) {
+		if declared[d] {
+			return
+		}
+		declared[d] = true
+		for _, ref := range refsIn(d.underlying) {
+			alias(ref)
+		}
+		aliases = append(aliases, d)
+	}
+	for _, d := range im.decls {
+		if d.kind == aliasKind && used[d] && plain(d) {
+			alias(d)
+		}
+	}
+
+	filter := func(decls []*decl) []*decl {
+		var kept []*decl
+		for _, d := range decls {
+			if used[d] {
+				kept = append(kept, d)
+			}
+		}
+		return kept
+	}
+	return aliases, filter(structs), filter(enums)
+}
+
+func render(t typ) string {
+	switch k := t.(type) {
+	case primitive:
+		return string(k)
+	case arrayOf:
+		return "[" + render(k.element) + "]"
+	case dictionaryOf:
+		return "[" + render(k.key) + ": " + render(k.element) + "]"
+	case optionalOf:
+		return render(k.element) + "?"
+	case *decl:
+		return k.name
+	}
+	panic("unhandled type")
+}
+
+var digits = regexp.MustCompile(`^-?[0-9]+$`)
+
+// literal returns value, as it was decoded from the document, written as a
+// Lugma value of t, or false if it can't be.
+func literal(value interface{}, t typ) (string, bool) {
+	switch k := t.(type) {
+	case optionalOf:
+		return literal(value, k.element)
+	case *decl:
+		str, ok := value.(string)
+		switch {
+		case k.kind == aliasKind:
+			return literal(value, k.underlying)
+		case k.simple() && ok:
+			for _, esac := range k.cases {
+				if esac.value == str {
+					return strconv.Quote(esac.name), true
+				}
+			}
+		}
+	case primitive:
+		switch k {
+		case "Bool":
+			if b, ok := value.(bool); ok {
+				if b {
+					return "Yes", true
+				}
+				return "No", true
+			}
+		case "Float32", "Float64":
+			switch v := value.(type) {
+			case int, int64, uint64:
+				return fmt.Sprint(v), true
+			case float64:
+				if !math.IsInf(v, 0) && !math.IsNaN(v) {
+					return strconv.FormatFloat(v, 'g', -1, 64), true
+				}
+			}
+		case "UInt8", "UInt16", "UInt32", "UInt64", "Int8", "Int16", "Int32", "Int64":
+			var lit string
+			switch v := value.(type) {
+			case int, int64, uint64:
+				lit = fmt.Sprint(v)
+			case string:
+				// 64-bit integers are sent as strings
+				if strings.HasSuffix(string(k), "64") && digits.MatchString(v) {
+					lit = v
+				}
+			}
+			if lit != "" && !(strings.HasPrefix(string(k), "U") && strings.HasPrefix(lit, "-")) {
+				return lit, true
+			}
+		case "String", "Timestamp", "Duration", "UUID", "Bytes":
+			if str, ok := value.(string); ok {
+				return strconv.Quote(str), true
+			}
+		}
+	case arrayOf:
+		if list, ok := value.([]interface{}); ok {
+			var items []string
+			for _, item := range list {
+				lit, ok := literal(item, k.element)
+				if !ok {
+					return "", false
+				}
+				items = append(items, lit)
+			}
+			return "[" + strings.Join(items, ", ") + "]", true
+		}
+	case dictionaryOf:
+		if dict, ok := value.(map[string]interface{}); ok {
+			var keys []string
+			for key := range dict {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			var entries []string
+			for _, key := range keys {
+				lit, ok := literal(dict[key], k.element)
+				if !ok || !identifier.MatchString(key) {
+					return "", false
+				}
+				entries = append(entries, key+": "+lit)
+			}
+			return "[" + strings.Join(entries, ", ") + "]", true
+		}
+	}
+	return "", false
+}
+
+// addDocs adds a documentation comment of docs to b.
+func addDocs(b *backends.Filebuilder, docs string) {
+	docs = strings.TrimSpace(strings.ReplaceAll(docs, "*/", "* /"))
+	if docs == "" {
+		return
+	}
+	b.Add("/**")
+	for _, line := range strings.Split(docs, "\n") {
+		b.Add("    %s", strings.TrimRight(line, " \t\r"))
+	}
+	b.Add("*/")
+}
+
+// declaration returns how f is declared, with its default if it has one that
+// can be written in Lugma.
+func (im *importer) declaration(f *field) string {
+	t := f.typ
+	def := ""
+	if f.def != nil {
+		if lit, ok := literal(f.def, t); ok {
+			def = " = " + lit
+		} else {
+			im.report(f.at, "its default can't be written in Lugma, so it's left out")
+			if !f.required {
+				t = optional(t)
+			}
+		}
+	}
+	return f.name + ": " + render(t) + def
+}
+
+// source returns the Lugma source of what's been imported.
+func (im *importer) source() ([]byte, error) {
+	aliases, structs, enums := im.declarations()
+
+	b := backends.Filebuilder{}
+	for _, d := range aliases {
+		addDocs(&b, d.docs)
+		b.Add("typealias %s = %s", d.name, render(d.underlying))
+		b.AddNL()
+	}
+	for _, d := range structs {
+		addDocs(&b, d.docs)
+		b.AddI("struct %s {", d.name)
+		for _, f := range d.fields {
+			addDocs(&b, f.docs)
+			b.Add("let %s", im.declaration(f))
+		}
+		b.AddD("}")
+		b.AddNL()
+	}
+	for _, d := range enums {
+		addDocs(&b, d.docs)
+		b.AddI("enum %s {", d.name)
+		for _, esac := range d.cases {
+			addDocs(&b, esac.docs)
+			if len(esac.fields) == 0 {
+				b.Add("case %s", esac.name)
+				continue
+			}
+			var fields []string
+			for _, f := range esac.fields {
+				fields = append(fields, im.declaration(f))
+			}
+			b.Add("case %s(%s)", esac.name, strings.Join(fields, ", "))
+		}
+		b.AddD("}")
+		b.AddNL()
+	}
+	for _, fn := range im.functions {
+		addDocs(&b, functionDocs(fn))
+		var arguments []string
+		for _, f := range fn.arguments {
+			arguments = append(arguments, im.declaration(f))
+		}
+		signature := "func " + fn.name + "(" + strings.Join(arguments, ", ") + ")"
+		if fn.throws != nil {
+			signature += " throws " + render(fn.throws)
+		}
+		if fn.returns != nil {
+			signature += " -> " + render(fn.returns)
+		}
+		b.Add("%s", signature)
+		b.AddNL()
+	}
+
+	return format.Format([]byte(b.String()))
+}
+
+// oneLine returns text with its lines joined, for putting it in a list.
+func oneLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// functionDocs returns the documentation of fn, listing what its arguments,
+// return value and error are.
+func functionDocs(fn *function) string {
+	var paragraphs []string
+	if fn.docs != "" {
+		paragraphs = append(paragraphs, fn.docs)
+	}
+
+	var params []string
+	for _, f := range fn.arguments {
+		if text := oneLine(f.docs); text != "" {
+			params = append(params, "  - "+f.name+": "+text)
+		}
+	}
+	if len(params) > 0 {
+		paragraphs = append(paragraphs, "- Parameters:\n\n"+strings.Join(params, "\n\n"))
+	}
+	if text := oneLine(fn.returnsDocs); text != "" && fn.returns != nil {
+		paragraphs = append(paragraphs, "- Returns: "+text)
+	}
+	if text := oneLine(fn.throwsDocs); text != "" && fn.throws != nil {
+		paragraphs = append(paragraphs, "- Throws: "+text)
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
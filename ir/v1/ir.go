@@ -0,0 +1,195 @@
+// Package ir is the representation of checked Lugma workspaces that tools
+// outside lugmac consume, which `lugmac dump --format json` writes out. Unlike
+// the typechecker's model, it has no cycles or unexported state, so it can be
+// serialised as JSON and decoded back into these types.
+//
+// This is version 1 of the representation. Fields may be added to it, but
+// none are removed or change meaning without a new version of the package,
+// and Workspace.IRVersion says which version a dump is of.
+//
+// Paths identify declarations across modules and workspaces. A module's path
+// is the workspace's name and the module's, as in "Chat/Text", and a
+// declaration's is its module's path followed by its name, as in
+// "Chat/Text/Message". Fields, cases, flags, events and signals have their
+// parent's path followed by their name.
+package ir
+
+import "encoding/json"
+
+// Version is the version of the representation this package describes.
+const Version = 1
+
+type Workspace struct {
+	// IRVersion is the version of the representation, which is Version.
+	IRVersion int    `json:"irVersion"`
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	// Modules are the workspace's own modules, in the order its lugma.yaml
+	// lists them.
+	Modules []*Module `json:"modules"`
+	// Dependencies are the modules of the workspaces it depends on that its
+	// own modules import, directly or not, so that every path the dump
+	// refers to is in it.
+	Dependencies []*Module `json:"dependencies"`
+}
+
+type Module struct {
+	Name          string         `json:"name"`
+	Path          string         `json:"path"`
+	Documentation *Documentation `json:"documentation,omitempty"`
+	// Imports are the paths of the modules the module imports, keyed by the
+	// names they're imported as.
+	Imports map[string]string `json:"imports"`
+
+	TypeAliases []*TypeAlias `json:"typeAliases"`
+	Constants   []*Constant  `json:"constants"`
+	Structs     []*Struct    `json:"structs"`
+	Enums       []*Enum      `json:"enums"`
+	Flagsets    []*Flagset   `json:"flagsets"`
+	Funcs       []*Func      `json:"funcs"`
+	Streams     []*Stream    `json:"streams"`
+}
+
+// Item is what every declaration and member of one has.
+type Item struct {
+	Name          string         `json:"name"`
+	Path          string         `json:"path"`
+	Documentation *Documentation `json:"documentation,omitempty"`
+	Annotations   []*Annotation  `json:"annotations,omitempty"`
+	Location      *Location      `json:"location,omitempty"`
+}
+
+type TypeAlias struct {
+	Item
+	// Newtype is whether the alias was declared with newtype, making a
+	// distinct type, rather than typealias.
+	Newtype    bool  `json:"newtype"`
+	Underlying *Type `json:"underlying"`
+}
+
+type Constant struct {
+	Item
+	Type  *Type           `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+type Struct struct {
+	Item
+	Fields []*Field `json:"fields"`
+}
+
+// Field is a field of a struct or case, or an argument of a function, event
+// or signal.
+type Field struct {
+	Item
+	Type *Type `json:"type"`
+	// Default is the value the field takes when it's missing, written as it's
+	// sent in JSON, or nothing if it has to be present.
+	Default json.RawMessage `json:"default,omitempty"`
+	// Tag is the number given to the field with @tag, or 0 if it wasn't
+	// given one.
+	Tag int `json:"tag,omitempty"`
+}
+
+type Enum struct {
+	Item
+	// Simple is whether none of the enum's cases have fields.
+	Simple bool    `json:"simple"`
+	Cases  []*Case `json:"cases"`
+}
+
+type Case struct {
+	Item
+	// Tag is the number given to the case with @tag, or 0 if it wasn't given
+	// one.
+	Tag    int      `json:"tag,omitempty"`
+	Fields []*Field `json:"fields"`
+}
+
+type Flagset struct {
+	Item
+	Optional bool    `json:"optional"`
+	Flags    []*Flag `json:"flags"`
+}
+
+type Flag struct {
+	Item
+	// Bit is the flag's bit in the flagset's bitmask, counting from 0 for the
+	// least significant.
+	Bit int `json:"bit"`
+}
+
+type Func struct {
+	Item
+	Arguments []*Field `json:"arguments"`
+	// Returns and Throws are nil if the function returns or throws nothing.
+	Returns *Type `json:"returns,omitempty"`
+	Throws  *Type `json:"throws,omitempty"`
+}
+
+type Stream struct {
+	Item
+	Events  []*Message `json:"events"`
+	Signals []*Message `json:"signals"`
+}
+
+// Message is an event or signal of a stream.
+type Message struct {
+	Item
+	Arguments []*Field `json:"arguments"`
+}
+
+// TypeKind is what kind of type a Type is.
+type TypeKind string
+
+const (
+	PrimitiveKind  TypeKind = "primitive"
+	ArrayKind      TypeKind = "array"
+	DictionaryKind TypeKind = "dictionary"
+	OptionalKind   TypeKind = "optional"
+	StructKind     TypeKind = "struct"
+	EnumKind       TypeKind = "enum"
+	FlagsetKind    TypeKind = "flagset"
+	TypeAliasKind  TypeKind = "typeAlias"
+)
+
+// Type is a reference to a type. Primitives have a Name, such as "UInt64";
+// arrays and optionals an Element; dictionaries a Key and an Element; and
+// declarations a Name and the Path they're declared at.
+type Type struct {
+	Kind    TypeKind `json:"kind"`
+	Name    string   `json:"name,omitempty"`
+	Path    string   `json:"path,omitempty"`
+	Key     *Type    `json:"key,omitempty"`
+	Element *Type    `json:"element,omitempty"`
+}
+
+// Documentation is a documentation comment. Source is its Markdown, and the
+// rest are the parts of it that have meanings of their own, as plain text.
+type Documentation struct {
+	Source     string            `json:"source"`
+	Summary    string            `json:"summary,omitempty"`
+	Parameters map[string]string `json:"parameters,omitempty"`
+	Returns    string            `json:"returns,omitempty"`
+	Throws     string            `json:"throws,omitempty"`
+}
+
+type Annotation struct {
+	Name string `json:"name"`
+	// Arguments are the values the annotation was given, in JSON.
+	Arguments []json.RawMessage `json:"arguments"`
+}
+
+// Location is where something was declared. File is relative to the
+// workspace's directory and uses forward slashes, and rows and columns count
+// from 0, with columns in bytes.
+type Location struct {
+	File  string   `json:"file"`
+	Start Position `json:"start"`
+	End   Position `json:"end"`
+}
+
+type Position struct {
+	Row    uint32 `json:"row"`
+	Column uint32 `json:"column"`
+}
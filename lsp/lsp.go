@@ -0,0 +1,15 @@
+package lsp
+
+import (
+	"os"
+
+	"github.com/urfave/cli/v2"
+)
+
+var Command = &cli.Command{
+	Name:  "lsp",
+	Usage: "Run a language server for Lugma IDL definitions over stdio",
+	Action: func(cCtx *cli.Context) error {
+		return Serve(os.Stdin, os.Stdout)
+	},
+}
@@ -0,0 +1,446 @@
+package lsp
+
+import (
+	"fmt"
+	"lugmac/ast"
+	"lugmac/typechecking"
+	"sort"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// pointToPosition converts a tree-sitter point, whose column counts bytes, to
+// a protocol position, whose character counts UTF-16 code units.
+func pointToPosition(text []byte, p sitter.Point) Position {
+	lines := strings.Split(string(text), "\n")
+	if int(p.Row) >= len(lines) {
+		return Position{int(p.Row), int(p.Column)}
+	}
+	line := lines[p.Row]
+	column := int(p.Column)
+	if column > len(line) {
+		column = len(line)
+	}
+
+	return Position{int(p.Row), len(utf16.Encode([]rune(line[:column])))}
+}
+
+// positionToPoint is the inverse of pointToPosition.
+func positionToPoint(text []byte, p Position) sitter.Point {
+	lines := strings.Split(string(text), "\n")
+	if p.Line >= len(lines) {
+		return sitter.Point{Row: uint32(p.Line), Column: uint32(p.Character)}
+	}
+	line := lines[p.Line]
+
+	units, column := 0, 0
+	for units < p.Character && column < len(line) {
+		r, size := utf8.DecodeRuneInString(line[column:])
+		units += len(utf16.Encode([]rune{r}))
+		column += size
+	}
+
+	return sitter.Point{Row: uint32(p.Line), Column: uint32(column)}
+}
+
+func spanToRange(text []byte, span ast.Span) Range {
+	return Range{pointToPosition(text, span.Start), pointToPosition(text, span.End)}
+}
+
+func before(a, b sitter.Point) bool {
+	return a.Row < b.Row || (a.Row == b.Row && a.Column < b.Column)
+}
+
+// contains reports whether p is within span, counting its end so that a
+// cursor just after a name still counts as being on it.
+func contains(span ast.Span, p sitter.Point) bool {
+	return !before(p, span.Start) && !before(span.End, p)
+}
+
+// typesIn returns every type written in file.
+func typesIn(file *ast.File) []ast.Type {
+	var ret []ast.Type
+
+	args := func(args []ast.Argument) {
+		for _, arg := range args {
+			ret = append(ret, arg.Type)
+		}
+	}
+
+	for _, item := range file.TypeAliases {
+		ret = append(ret, item.Type)
+	}
+	for _, item := range file.Constants {
+		ret = append(ret, item.Type)
+	}
+	for _, item := range file.Structs {
+		for _, field := range item.Fields {
+			ret = append(ret, field.Type)
+		}
+	}
+	for _, item := range file.Enums {
+		for _, cas := range item.Cases {
+			args(cas.Values)
+		}
+	}
+	for _, item := range file.Funcs {
+		args(item.Arguments)
+		if item.Returns != nil {
+			ret = append(ret, item.Returns)
+		}
+		if item.Throws != nil {
+			ret = append(ret, item.Throws)
+		}
+	}
+	for _, item := range file.Streams {
+		for _, ev := range item.Events {
+			args(ev.Arguments)
+		}
+		for _, sig := range item.Signals {
+			args(sig.Arguments)
+		}
+	}
+
+	return ret
+}
+
+// innermost returns the named type within typ that p is on, if any.
+func innermost(typ ast.Type, p sitter.Point) ast.Type {
+	if typ == nil || !contains(typ.GetSpan(), p) {
+		return nil
+	}
+
+	switch typ := typ.(type) {
+	case ast.TypeIdent:
+		return typ
+	case ast.TypeSubscript:
+		if inner := innermost(typ.Inner, p); inner != nil {
+			return inner
+		}
+		return typ
+	case ast.TypeArray:
+		return innermost(typ.Inner, p)
+	case ast.TypeOptional:
+		return innermost(typ.Inner, p)
+	case ast.TypeDictionary:
+		if key := innermost(typ.Key, p); key != nil {
+			return key
+		}
+		return innermost(typ.Value, p)
+	default:
+		return nil
+	}
+}
+
+// typeAt returns the named type written in file that p is on, if any.
+func typeAt(file *ast.File, p sitter.Point) ast.Type {
+	for _, typ := range typesIn(file) {
+		if found := innermost(typ, p); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// resolve looks up the object a named type refers to, the same way the
+// typechecker does.
+func resolve(env *typechecking.Environment, typ ast.Type) typechecking.Object {
+	switch typ := typ.(type) {
+	case ast.TypeIdent:
+		object, _ := env.Search(typ.Name)
+		return object
+	case ast.TypeSubscript:
+		inner := resolve(env, typ.Inner)
+		if inner == nil {
+			return nil
+		}
+		return inner.Child(typ.Field)
+	default:
+		return nil
+	}
+}
+
+// declarationAt returns the innermost object declared in file that p is
+// within, if any.
+func declarationAt(m *typechecking.Module, file *ast.File, p sitter.Point) typechecking.Object {
+	child := func(parent typechecking.Object, name string) typechecking.Object {
+		if parent == nil {
+			return nil
+		}
+		return parent.Child(name)
+	}
+
+	for _, item := range file.Structs {
+		if !contains(item.Span, p) {
+			continue
+		}
+		object := m.Child(item.Name)
+		for _, field := range item.Fields {
+			if contains(field.Span, p) {
+				return child(object, field.Name)
+			}
+		}
+		return object
+	}
+	for _, item := range file.Enums {
+		if !contains(item.Span, p) {
+			continue
+		}
+		object := m.Child(item.Name)
+		for _, cas := range item.Cases {
+			if contains(cas.Span, p) {
+				return child(object, cas.Name)
+			}
+		}
+		return object
+	}
+	for _, item := range file.Flagsets {
+		if !contains(item.Span, p) {
+			continue
+		}
+		object := m.Child(item.Name)
+		for _, flag := range item.Flags {
+			if contains(flag.Span, p) {
+				return child(object, flag.Name)
+			}
+		}
+		return object
+	}
+	for _, item := range file.Funcs {
+		if contains(item.Span, p) {
+			return m.Child(item.Name)
+		}
+	}
+	for _, item := range file.TypeAliases {
+		if contains(item.Span, p) {
+			return m.Child(item.Name)
+		}
+	}
+	for _, item := range file.Constants {
+		if contains(item.Span, p) {
+			return m.Child(item.Name)
+		}
+	}
+	for _, item := range file.Streams {
+		if !contains(item.Span, p) {
+			continue
+		}
+		object := m.Child(item.Name)
+		for _, ev := range item.Events {
+			if contains(ev.Span, p) {
+				return child(object, ev.Name)
+			}
+		}
+		for _, sig := range item.Signals {
+			if contains(sig.Span, p) {
+				return child(object, sig.Name)
+			}
+		}
+		return object
+	}
+
+	return nil
+}
+
+func typeString(typ typechecking.Type) string {
+	if typ == nil {
+		return "?"
+	}
+	return typ.String()
+}
+
+func argumentsString(args []*typechecking.Field) string {
+	var strs []string
+	for _, arg := range args {
+		strs = append(strs, fmt.Sprintf("%s: %s", arg.Name, typeString(arg.Type))+defaultString(arg))
+	}
+	return "(" + strings.Join(strs, ", ") + ")"
+}
+
+// defaultString returns the default value of field as it'd be declared, or
+// nothing if it doesn't have one.
+func defaultString(field *typechecking.Field) string {
+	if field.Default == nil {
+		return ""
+	}
+	return " = " + field.Default.String()
+}
+
+// signatureOf returns how object would be declared, without its contents.
+func signatureOf(object typechecking.Object) string {
+	switch t := object.(type) {
+	case typechecking.PrimitiveType:
+		return t.String()
+	case *typechecking.Struct:
+		return "struct " + t.ObjectName()
+	case *typechecking.Enum:
+		return "enum " + t.ObjectName()
+	case *typechecking.Case:
+		return "case " + t.ObjectName() + argumentsString(t.Fields)
+	case *typechecking.Flagset:
+		return "flagset " + t.ObjectName()
+	case *typechecking.Flag:
+		return "flag " + t.ObjectName()
+	case *typechecking.Field:
+		return fmt.Sprintf("let %s: %s", t.Name, typeString(t.Type)) + defaultString(t)
+	case *typechecking.Func:
+		s := "func " + t.ObjectName() + argumentsString(t.Arguments)
+		if t.Throws != nil {
+			s += " throws " + typeString(t.Throws)
+		}
+		if t.Returns != nil {
+			s += " -> " + typeString(t.Returns)
+		}
+		return s
+	case *typechecking.Stream:
+		return "stream " + t.ObjectName()
+	case *typechecking.Event:
+		return "event " + t.ObjectName() + argumentsString(t.Arguments)
+	case *typechecking.Signal:
+		return "signal " + t.ObjectName() + argumentsString(t.Arguments)
+	case *typechecking.TypeAlias:
+		keyword := "typealias"
+		if t.Newtype {
+			keyword = "newtype"
+		}
+		return fmt.Sprintf("%s %s = %s", keyword, t.ObjectName(), typeString(t.Underlying))
+	case *typechecking.Constant:
+		if t.Value == nil {
+			return fmt.Sprintf("const %s: %s", t.ObjectName(), typeString(t.Type))
+		}
+		return fmt.Sprintf("const %s: %s = %s", t.ObjectName(), typeString(t.Type), t.Value)
+	case *typechecking.Module:
+		return "module " + t.ObjectName()
+	default:
+		return object.ObjectName()
+	}
+}
+
+// symbolsIn returns the outline of file.
+func symbolsIn(text []byte, file *ast.File) []DocumentSymbol {
+	var ret []DocumentSymbol
+
+	symbol := func(name string, kind SymbolKind, span ast.Span) DocumentSymbol {
+		r := spanToRange(text, span)
+		return DocumentSymbol{Name: name, Kind: kind, Range: r, SelectionRange: r}
+	}
+
+	for _, item := range file.Structs {
+		s := symbol(item.Name, SymbolKindStruct, item.Span)
+		for _, field := range item.Fields {
+			s.Children = append(s.Children, symbol(field.Name, SymbolKindField, field.Span))
+		}
+		ret = append(ret, s)
+	}
+	for _, item := range file.Enums {
+		s := symbol(item.Name, SymbolKindEnum, item.Span)
+		for _, cas := range item.Cases {
+			s.Children = append(s.Children, symbol(cas.Name, SymbolKindEnumMember, cas.Span))
+		}
+		ret = append(ret, s)
+	}
+	for _, item := range file.Flagsets {
+		s := symbol(item.Name, SymbolKindEnum, item.Span)
+		for _, flag := range item.Flags {
+			s.Children = append(s.Children, symbol(flag.Name, SymbolKindConstant, flag.Span))
+		}
+		ret = append(ret, s)
+	}
+	for _, item := range file.TypeAliases {
+		ret = append(ret, symbol(item.Name, SymbolKindClass, item.Span))
+	}
+	for _, item := range file.Constants {
+		ret = append(ret, symbol(item.Name, SymbolKindConstant, item.Span))
+	}
+	for _, item := range file.Funcs {
+		ret = append(ret, symbol(item.Name, SymbolKindFunction, item.Span))
+	}
+	for _, item := range file.Streams {
+		s := symbol(item.Name, SymbolKindInterface, item.Span)
+		for _, ev := range item.Events {
+			s.Children = append(s.Children, symbol(ev.Name, SymbolKindEvent, ev.Span))
+		}
+		for _, sig := range item.Signals {
+			s.Children = append(s.Children, symbol(sig.Name, SymbolKindMethod, sig.Span))
+		}
+		ret = append(ret, s)
+	}
+
+	return ret
+}
+
+func completionKindOf(object typechecking.Object) (CompletionItemKind, bool) {
+	switch object.(type) {
+	case *typechecking.Struct:
+		return CompletionItemKindStruct, true
+	case *typechecking.Enum, *typechecking.Flagset:
+		return CompletionItemKindEnum, true
+	case *typechecking.TypeAlias:
+		return CompletionItemKindClass, true
+	case *typechecking.Module:
+		return CompletionItemKindModule, true
+	case typechecking.Type:
+		return CompletionItemKindKeyword, true
+	default:
+		return 0, false
+	}
+}
+
+// completionsIn returns the types and modules that can be named in env.
+func completionsIn(env *typechecking.Environment) []CompletionItem {
+	var ret []CompletionItem
+	seen := map[string]struct{}{}
+
+	for ; env != nil; env = env.Parent {
+		var names []string
+		for name := range env.Items {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+
+			object := env.Items[name]
+			kind, ok := completionKindOf(object)
+			if !ok {
+				continue
+			}
+			ret = append(ret, CompletionItem{Label: name, Kind: kind, Detail: signatureOf(object)})
+		}
+	}
+
+	return ret
+}
+
+// completionsWithin returns the types that can be named through object, such
+// as the types of an imported module.
+func completionsWithin(object typechecking.Object) []CompletionItem {
+	var ret []CompletionItem
+
+	m, ok := object.(*typechecking.Module)
+	if !ok {
+		return nil
+	}
+
+	for _, item := range m.Structs {
+		ret = append(ret, CompletionItem{Label: item.ObjectName(), Kind: CompletionItemKindStruct, Detail: signatureOf(item)})
+	}
+	for _, item := range m.Enums {
+		ret = append(ret, CompletionItem{Label: item.ObjectName(), Kind: CompletionItemKindEnum, Detail: signatureOf(item)})
+	}
+	for _, item := range m.Flagsets {
+		ret = append(ret, CompletionItem{Label: item.ObjectName(), Kind: CompletionItemKindEnum, Detail: signatureOf(item)})
+	}
+	for _, item := range m.TypeAliases {
+		ret = append(ret, CompletionItem{Label: item.ObjectName(), Kind: CompletionItemKindClass, Detail: signatureOf(item)})
+	}
+
+	return ret
+}
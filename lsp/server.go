@@ -0,0 +1,411 @@
+package lsp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"lugmac/ast"
+	"lugmac/docgen"
+	"lugmac/modules"
+	"lugmac/typechecking"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+type server struct {
+	conn *conn
+
+	// documents holds the text of every open file, keyed by path.
+	documents map[string][]byte
+
+	// modules holds the module each file was last successfully checked as
+	// part of, keyed by path.
+	modules map[string]*typechecking.Module
+
+	// published holds the files each workspace has published diagnostics
+	// for, so that they can be cleared once they're fixed.
+	published map[string]map[string]struct{}
+
+	shutdown bool
+}
+
+// Serve speaks the language server protocol over in and out until the client
+// asks it to exit.
+func Serve(in io.Reader, out io.Writer) error {
+	s := &server{
+		conn:      newConn(in, out),
+		documents: map[string][]byte{},
+		modules:   map[string]*typechecking.Module{},
+		published: map[string]map[string]struct{}{},
+	}
+
+	for {
+		msg, err := s.conn.read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		var rerr *responseError
+		if errors.As(err, &rerr) {
+			s.conn.reply(nil, nil, rerr)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return fmt.Errorf("exited without being shut down")
+			}
+			return nil
+		}
+
+		result, err := s.handle(msg)
+		if msg.ID == nil {
+			if err != nil {
+				log.Printf("%s: %s", msg.Method, err)
+			}
+			continue
+		}
+		err = s.conn.reply(msg.ID, result, err)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *server) handle(msg *message) (interface{}, error) {
+	switch msg.Method {
+	case "initialize":
+		var result InitializeResult
+		result.Capabilities = ServerCapabilities{
+			TextDocumentSync:       textDocumentSyncFull,
+			HoverProvider:          true,
+			DefinitionProvider:     true,
+			DocumentSymbolProvider: true,
+			CompletionProvider:     &CompletionOptions{TriggerCharacters: []string{"."}},
+		}
+		result.ServerInfo.Name = "lugmac"
+		return result, nil
+	case "initialized":
+		return nil, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		return s.withParams(msg, &params, func() (interface{}, error) {
+			file, err := pathFromURI(params.TextDocument.URI)
+			if err != nil {
+				return nil, err
+			}
+			s.documents[file] = []byte(params.TextDocument.Text)
+			return nil, s.check(file)
+		})
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		return s.withParams(msg, &params, func() (interface{}, error) {
+			file, err := pathFromURI(params.TextDocument.URI)
+			if err != nil {
+				return nil, err
+			}
+			if len(params.ContentChanges) == 0 {
+				return nil, nil
+			}
+			s.documents[file] = []byte(params.ContentChanges[len(params.ContentChanges)-1].Text)
+			return nil, s.check(file)
+		})
+	case "textDocument/didSave":
+		var params DocumentSymbolParams
+		return s.withParams(msg, &params, func() (interface{}, error) {
+			file, err := pathFromURI(params.TextDocument.URI)
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.check(file)
+		})
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		return s.withParams(msg, &params, func() (interface{}, error) {
+			file, err := pathFromURI(params.TextDocument.URI)
+			if err != nil {
+				return nil, err
+			}
+			delete(s.documents, file)
+			return nil, s.check(file)
+		})
+	case "textDocument/definition":
+		var params TextDocumentPositionParams
+		return s.withParams(msg, &params, func() (interface{}, error) {
+			return s.definition(params)
+		})
+	case "textDocument/hover":
+		var params TextDocumentPositionParams
+		return s.withParams(msg, &params, func() (interface{}, error) {
+			return s.hover(params)
+		})
+	case "textDocument/documentSymbol":
+		var params DocumentSymbolParams
+		return s.withParams(msg, &params, func() (interface{}, error) {
+			return s.documentSymbol(params)
+		})
+	case "textDocument/completion":
+		var params TextDocumentPositionParams
+		return s.withParams(msg, &params, func() (interface{}, error) {
+			return s.completion(params)
+		})
+	default:
+		if strings.HasPrefix(msg.Method, "$/") {
+			return nil, nil
+		}
+		return nil, &responseError{codeMethodNotFound, fmt.Sprintf("%s isn't supported", msg.Method)}
+	}
+}
+
+func (s *server) withParams(msg *message, params interface{}, handler func() (interface{}, error)) (interface{}, error) {
+	err := json.Unmarshal(msg.Params, params)
+	if err != nil {
+		return nil, &responseError{codeInvalidParams, err.Error()}
+	}
+	return handler()
+}
+
+func pathFromURI(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", &responseError{codeInvalidParams, err.Error()}
+	}
+	if u.Scheme != "file" {
+		return "", &responseError{codeInvalidParams, fmt.Sprintf("%s isn't a file", uri)}
+	}
+	return filepath.Clean(filepath.FromSlash(u.Path)), nil
+}
+
+func uriFromPath(path string) string {
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+}
+
+// text returns the contents of file, preferring what the client has open
+// over what's on disk.
+func (s *server) text(file string) []byte {
+	if data, ok := s.documents[file]; ok {
+		return data
+	}
+	data, _ := os.ReadFile(file)
+	return data
+}
+
+// workspaceRootFor returns the nearest directory above file with a
+// lugma.yaml in it.
+func workspaceRootFor(file string) (string, bool) {
+	dir := filepath.Dir(file)
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "lugma.yaml")); err == nil {
+			return dir, true
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// check typechecks the workspace file belongs to and publishes what was
+// found in it.
+func (s *server) check(file string) error {
+	root, ok := workspaceRootFor(file)
+	if !ok {
+		return nil
+	}
+
+	w, err := modules.LoadWorkspaceFrom(root)
+	if err != nil {
+		return err
+	}
+	for path, text := range s.documents {
+		if strings.HasPrefix(path, root+string(filepath.Separator)) {
+			w.Overlay[path] = text
+		}
+	}
+
+	var diags typechecking.Diagnostics
+	err = w.GenerateModules()
+	if errors.As(err, &diags) {
+		err = nil
+	}
+
+	for _, m := range w.KnownModules {
+		for _, f := range m.Files {
+			s.modules[f.Path] = m
+		}
+	}
+
+	byFile := map[string][]Diagnostic{}
+	for _, diag := range diags {
+		if diag.File == "" {
+			continue
+		}
+		byFile[diag.File] = append(byFile[diag.File], s.convert(diag))
+	}
+
+	for file := range s.published[root] {
+		if _, ok := byFile[file]; !ok {
+			byFile[file] = []Diagnostic{}
+		}
+	}
+	published := map[string]struct{}{}
+	for file, diags := range byFile {
+		if len(diags) > 0 {
+			published[file] = struct{}{}
+		}
+		perr := s.conn.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{uriFromPath(file), diags})
+		if perr != nil {
+			return perr
+		}
+	}
+	s.published[root] = published
+
+	return err
+}
+
+func (s *server) convert(diag typechecking.Diagnostic) Diagnostic {
+	ret := Diagnostic{
+		Range:   spanToRange(s.text(diag.File), diag.Span),
+		Source:  "lugmac",
+		Message: diag.Message,
+	}
+
+	switch diag.Severity {
+	case typechecking.Error:
+		ret.Severity = SeverityError
+	case typechecking.Warning:
+		ret.Severity = SeverityWarning
+	case typechecking.Note:
+		ret.Severity = SeverityInformation
+	}
+
+	for _, note := range diag.Notes {
+		ret.RelatedInformation = append(ret.RelatedInformation, DiagnosticRelatedInformation{
+			Location: Location{uriFromPath(note.File), spanToRange(s.text(note.File), note.Span)},
+			Message:  note.Message,
+		})
+	}
+
+	return ret
+}
+
+// lookup returns the module file was last checked as part of, along with its
+// syntax tree and the point params refers to.
+func (s *server) lookup(params TextDocumentPositionParams) (*typechecking.Module, *ast.File, []byte, error) {
+	file, err := pathFromURI(params.TextDocument.URI)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	m := s.modules[file]
+	if m == nil {
+		return nil, nil, nil, nil
+	}
+
+	for _, f := range m.Files {
+		if f.Path == file {
+			return m, f, s.text(file), nil
+		}
+	}
+
+	return nil, nil, nil, nil
+}
+
+// objectAt returns the object named or declared at the position params refers
+// to.
+func (s *server) objectAt(params TextDocumentPositionParams) (typechecking.Object, error) {
+	m, file, text, err := s.lookup(params)
+	if m == nil || err != nil {
+		return nil, err
+	}
+	point := positionToPoint(text, params.Position)
+
+	if typ := typeAt(file, point); typ != nil {
+		return resolve(m.InEnv, typ), nil
+	}
+	return declarationAt(m, file, point), nil
+}
+
+func (s *server) definition(params TextDocumentPositionParams) (interface{}, error) {
+	object, err := s.objectAt(params)
+	if object == nil || err != nil {
+		return nil, err
+	}
+
+	loc, ok := typechecking.LocationOf(object)
+	if !ok || loc.File == "" {
+		return nil, nil
+	}
+
+	return Location{uriFromPath(loc.File), spanToRange(s.text(loc.File), loc.Span)}, nil
+}
+
+func (s *server) hover(params TextDocumentPositionParams) (interface{}, error) {
+	object, err := s.objectAt(params)
+	if object == nil || err != nil {
+		return nil, err
+	}
+
+	value := "```lugma\n" + signatureOf(object) + "\n```"
+	if summary := docgen.SummaryFor(object); summary != "" {
+		value += "\n\n" + summary
+	}
+
+	return Hover{Contents: MarkupContent{"markdown", value}}, nil
+}
+
+func (s *server) documentSymbol(params DocumentSymbolParams) (interface{}, error) {
+	file, err := pathFromURI(params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	text := s.text(file)
+	tree, err := ast.Parse(text)
+	if err != nil {
+		return []DocumentSymbol{}, nil
+	}
+
+	return symbolsIn(text, tree), nil
+}
+
+var qualifier = regexp.MustCompile(`([A-Za-z_][A-Za-z0-9_]*)\.[A-Za-z0-9_]*$`)
+
+func (s *server) completion(params TextDocumentPositionParams) (interface{}, error) {
+	file, err := pathFromURI(params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	env := typechecking.World
+	if m := s.modules[file]; m != nil {
+		env = m.InEnv
+	}
+
+	text := s.text(file)
+	point := positionToPoint(text, params.Position)
+	lines := strings.Split(string(text), "\n")
+	if int(point.Row) < len(lines) && int(point.Column) <= len(lines[point.Row]) {
+		line := lines[point.Row][:point.Column]
+		if match := qualifier.FindStringSubmatch(line); match != nil {
+			object, _ := env.Search(match[1])
+			if object == nil {
+				return []CompletionItem{}, nil
+			}
+			return completionsWithin(object), nil
+		}
+	}
+
+	return completionsIn(env), nil
+}
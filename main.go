@@ -1,14 +1,28 @@
 package main
 
 import (
+	"errors"
+	"fmt"
 	"log"
 	"lugmac/backends"
+	"lugmac/compat"
 	"lugmac/docgen"
+	"lugmac/dump"
+	"lugmac/format"
+	"lugmac/importer"
+	"lugmac/lsp"
 	"lugmac/modules"
+	"lugmac/typechecking"
 	"os"
 
 	"github.com/urfave/cli/v2"
 
+	_ "lugmac/backends/asyncapi"
+	_ "lugmac/backends/golang"
+	_ "lugmac/backends/jsonschema"
+	_ "lugmac/backends/openapi"
+	_ "lugmac/backends/protobuf"
+	_ "lugmac/backends/rust"
 	_ "lugmac/backends/typescript"
 )
 
@@ -25,6 +39,12 @@ func main() {
 		Commands: []*cli.Command{
 			gen,
 			docgen.Command,
+			lsp.Command,
+			format.Command,
+			compat.Command,
+			importer.Command,
+			dump.Command,
+			modules.DepsCommand,
 			{
 				Name:  "verify",
 				Usage: "Verify a Lugma file",
@@ -35,7 +55,28 @@ func main() {
 					}
 
 					err = mod.GenerateModules()
-					if err != nil {
+					var diags typechecking.Diagnostics
+					if errors.As(err, &diags) {
+						sources := map[string][]byte{}
+						source := func(file string) []byte {
+							if _, ok := sources[file]; !ok {
+								sources[file], _ = os.ReadFile(file)
+							}
+							return sources[file]
+						}
+
+						errs := 0
+						for _, diag := range diags {
+							fmt.Fprint(os.Stderr, diag.Render(source))
+							if diag.Severity == typechecking.Error {
+								errs++
+							}
+						}
+						if errs == 1 {
+							return cli.Exit("1 error found", 1)
+						}
+						return cli.Exit(fmt.Sprintf("%d errors found", errs), 1)
+					} else if err != nil {
 						return err
 					}
 
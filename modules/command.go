@@ -0,0 +1,60 @@
+package modules
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v2"
+)
+
+var workspaceFlag = &cli.StringFlag{
+	Name:    "workspace",
+	Aliases: []string{"w"},
+	Usage:   "The directory to load a workspace from",
+	Value:   ".",
+}
+
+var DepsCommand = &cli.Command{
+	Name:  "deps",
+	Usage: "Manage the workspaces a workspace depends on",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "vendor",
+			Usage: "Copy every dependency into " + VendorDir + " and record them in " + LockfileName,
+			Flags: []cli.Flag{workspaceFlag},
+			Action: func(cCtx *cli.Context) error {
+				w, err := LoadWorkspaceFrom(cCtx.String("workspace"))
+				if err != nil {
+					return err
+				}
+
+				return w.Vendor()
+			},
+		},
+		{
+			Name:  "verify",
+			Usage: "Check that every dependency is the same as recorded in " + LockfileName,
+			Flags: []cli.Flag{workspaceFlag},
+			Action: func(cCtx *cli.Context) error {
+				w, err := LoadWorkspaceFrom(cCtx.String("workspace"))
+				if err != nil {
+					return err
+				}
+
+				problems, err := w.Verify()
+				if err != nil {
+					return err
+				}
+
+				for _, problem := range problems {
+					fmt.Fprintln(os.Stderr, problem)
+				}
+				if len(problems) > 0 {
+					return cli.Exit(fmt.Sprintf("%s doesn't match the dependencies", LockfileName), 1)
+				}
+
+				return nil
+			},
+		},
+	},
+}
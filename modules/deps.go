@@ -0,0 +1,452 @@
+package modules
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"lugmac/ast"
+	"lugmac/typechecking"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// VendorDir is where dependencies are copied to by lugmac deps vendor, and
+// where they're loaded from instead of their paths if they're there. It's
+// named for Lugma so as not to be taken for the vendor directories of other
+// tools in the same repository.
+const VendorDir = "lugma_vendor"
+
+// LockfileName is the name of the file recording the dependencies a
+// workspace was last vendored with.
+const LockfileName = "lugma.lock"
+
+type DependencyDefinition struct {
+	// Name is the name of the workspace depended on, and what its modules
+	// are imported under, as in import "Name/Module".
+	Name string `yaml:"name"`
+	// Path is where the workspace is, relative to the one depending on it.
+	Path string `yaml:"path"`
+
+	span ast.Span
+}
+
+func (d *DependencyDefinition) UnmarshalYAML(value *yaml.Node) error {
+	type plain DependencyDefinition
+	err := value.Decode((*plain)(d))
+	if err != nil {
+		return err
+	}
+
+	d.span = spanOf(value)
+	return nil
+}
+
+// spanOf returns where value starts in its file.
+func spanOf(value *yaml.Node) ast.Span {
+	// yaml counts from 1, but spans count from 0
+	return pointSpan(uint32(value.Line-1), uint32(value.Column-1))
+}
+
+// resolver finds the workspaces a workspace depends on, recursively. Each
+// dependency is loaded once and shared by everything depending on it.
+type resolver struct {
+	root *Workspace
+
+	// vendored is whether to load dependencies from the root's vendor
+	// directory when they're there.
+	vendored bool
+
+	workspaces map[string]*Workspace
+	stack      []string
+}
+
+func (r *resolver) resolve(w *Workspace) typechecking.Diagnostics {
+	var diags typechecking.Diagnostics
+	file := path.Join(w.Dir, "lugma.yaml")
+
+	r.stack = append(r.stack, w.Module.Name)
+	defer func() { r.stack = r.stack[:len(r.stack)-1] }()
+
+	w.resolver = r
+	w.Dependencies = map[string]*Workspace{}
+
+	for _, dep := range w.Module.Dependencies {
+		errorAt := func(format string, args ...interface{}) {
+			diags = append(diags, typechecking.Diagnostic{
+				File:     file,
+				Span:     dep.span,
+				Severity: typechecking.Error,
+				Message:  fmt.Sprintf(format, args...),
+			})
+		}
+
+		if contains(r.stack, dep.Name) {
+			cycle := append(append([]string{}, r.stack...), dep.Name)
+			errorAt("workspaces depend on each other in a cycle: %s", strings.Join(cycle, " → "))
+			continue
+		}
+
+		dir, err := r.dirFor(w, dep)
+		if err != nil {
+			errorAt("%s", err)
+			continue
+		}
+
+		if existing, ok := r.workspaces[dep.Name]; ok {
+			if !sameDir(existing.Dir, dir) {
+				errorAt("%s is at %s, but it's already been loaded from %s for another workspace", dep.Name, dir, existing.Dir)
+				continue
+			}
+			w.Dependencies[dep.Name] = existing
+			continue
+		}
+
+		depWorkspace, err := LoadWorkspaceFrom(dir)
+		if err != nil {
+			errorAt("failed to load dependency %s: %s", dep.Name, err)
+			continue
+		}
+		if depWorkspace.Module.Name != dep.Name {
+			errorAt("the workspace at %s is named %s, not %s", dir, depWorkspace.Module.Name, dep.Name)
+			continue
+		}
+
+		r.workspaces[dep.Name] = depWorkspace
+		w.Dependencies[dep.Name] = depWorkspace
+		diags = append(diags, r.resolve(depWorkspace)...)
+	}
+
+	return diags
+}
+
+// dirFor returns where the workspace dep declared by w is.
+func (r *resolver) dirFor(w *Workspace, dep DependencyDefinition) (string, error) {
+	if r.vendored {
+		dir := filepath.Join(r.root.Dir, VendorDir, dep.Name)
+		if _, err := os.Stat(filepath.Join(dir, "lugma.yaml")); err == nil {
+			return dir, nil
+		}
+	}
+	if dep.Path == "" {
+		return "", fmt.Errorf("%s has no path, and hasn't been vendored", dep.Name)
+	}
+	return filepath.Join(w.Dir, dep.Path), nil
+}
+
+func sameDir(a, b string) bool {
+	a, errA := filepath.Abs(a)
+	b, errB := filepath.Abs(b)
+	return errA == nil && errB == nil && a == b
+}
+
+// ResolveDependencies finds every workspace m depends on, recursively,
+// preferring vendored copies of them.
+func (m *Workspace) ResolveDependencies() error {
+	return m.resolveDependencies(true)
+}
+
+func (m *Workspace) resolveDependencies(vendored bool) error {
+	if m.resolver != nil {
+		return nil
+	}
+
+	r := &resolver{root: m, vendored: vendored, workspaces: map[string]*Workspace{}}
+	if diags := r.resolve(m); len(diags) > 0 {
+		return diags
+	}
+	return nil
+}
+
+// AllDependencies returns every workspace m depends on, directly or not,
+// sorted by name.
+func (m *Workspace) AllDependencies() []*Workspace {
+	var ret []*Workspace
+	for _, w := range m.resolver.workspaces {
+		ret = append(ret, w)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Module.Name < ret[j].Module.Name
+	})
+	return ret
+}
+
+// generateDependencies checks the workspaces m depends on that haven't
+// been checked yet.
+func (m *Workspace) generateDependencies() (typechecking.Diagnostics, error) {
+	var diags typechecking.Diagnostics
+
+	names := make([]string, 0, len(m.Dependencies))
+	for name := range m.Dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		dep := m.Dependencies[name]
+		if dep.checked {
+			continue
+		}
+
+		err := dep.GenerateModules()
+		var depDiags typechecking.Diagnostics
+		if errors.As(err, &depDiags) {
+			diags = append(diags, depDiags...)
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to load dependency %s: %w", name, err)
+		}
+	}
+
+	return diags, nil
+}
+
+// moduleInDependency returns the module imported by path, which is of the
+// form "Workspace/Module".
+func (m *Workspace) moduleInDependency(path string) (*typechecking.Module, error) {
+	workspace, product, _ := strings.Cut(path, "/")
+
+	dep, ok := m.Dependencies[workspace]
+	if !ok {
+		return nil, fmt.Errorf("%s isn't a dependency of this workspace; add it to the dependencies in lugma.yaml", workspace)
+	}
+	if dep.product(product) == nil {
+		return nil, fmt.Errorf("there's no module named %s in %s", product, workspace)
+	}
+
+	v, ok := dep.KnownModules[product]
+	if !ok {
+		return nil, fmt.Errorf("module %s couldn't be loaded", path)
+	}
+	return v, nil
+}
+
+// HashWorkspace returns a hash of the files making up the workspace at dir:
+// its lugma.yaml and everything in Sources.
+func HashWorkspace(dir string) (string, error) {
+	files, err := workspaceFiles(dir)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	for _, file := range files {
+		data, err := os.ReadFile(filepath.Join(dir, file))
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%s\x00%d\x00", filepath.ToSlash(file), len(data))
+		h.Write(data)
+	}
+
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// workspaceFiles returns the files making up the workspace at dir, relative
+// to it and sorted.
+func workspaceFiles(dir string) ([]string, error) {
+	files := []string{"lugma.yaml"}
+
+	sources := filepath.Join(dir, "Sources")
+	err := filepath.WalkDir(sources, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && file == sources {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		files = append(files, rel)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+type Lockfile struct {
+	Dependencies []LockedDependency `yaml:"dependencies"`
+}
+
+type LockedDependency struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+	Hash    string `yaml:"hash"`
+}
+
+func LoadLockfileFrom(dir string) (*Lockfile, error) {
+	data, err := os.ReadFile(filepath.Join(dir, LockfileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", LockfileName, err)
+	}
+
+	var l Lockfile
+	err = yaml.Unmarshal(data, &l)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", LockfileName, err)
+	}
+
+	return &l, nil
+}
+
+// Lock returns the lockfile recording the dependencies of m as they are
+// now.
+func (m *Workspace) Lock() (*Lockfile, error) {
+	err := m.ResolveDependencies()
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Lockfile{Dependencies: []LockedDependency{}}
+
+	for _, dep := range m.AllDependencies() {
+		hash, err := HashWorkspace(dep.Dir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash %s: %w", dep.Module.Name, err)
+		}
+		l.Dependencies = append(l.Dependencies, LockedDependency{dep.Module.Name, dep.Module.Version, hash})
+	}
+
+	return l, nil
+}
+
+func (l *Lockfile) Write(dir string) error {
+	var sb strings.Builder
+	sb.WriteString("# Generated by lugmac deps vendor. Do not edit.\n")
+
+	enc := yaml.NewEncoder(&sb)
+	enc.SetIndent(2)
+	err := enc.Encode(l)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, LockfileName), []byte(sb.String()), 0644)
+}
+
+// Vendor copies every dependency of m from its path into m's vendor
+// directory, replacing the copies recorded in its lockfile, and records them
+// in its lockfile.
+func (m *Workspace) Vendor() error {
+	err := m.resolveDependencies(false)
+	if err != nil {
+		return err
+	}
+
+	// only what was vendored is removed, leaving anything else that's been
+	// put in the directory alone
+	vendor := filepath.Join(m.Dir, VendorDir)
+	var stale []string
+	if locked, err := LoadLockfileFrom(m.Dir); err == nil {
+		for _, dep := range locked.Dependencies {
+			stale = append(stale, dep.Name)
+		}
+	}
+	for _, dep := range m.AllDependencies() {
+		stale = append(stale, dep.Module.Name)
+	}
+	for _, name := range stale {
+		if name == "" || name == "." || name == ".." || name != filepath.Base(name) {
+			continue
+		}
+		err = os.RemoveAll(filepath.Join(vendor, name))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, dep := range m.AllDependencies() {
+		files, err := workspaceFiles(dep.Dir)
+		if err != nil {
+			return fmt.Errorf("failed to vendor %s: %w", dep.Module.Name, err)
+		}
+
+		for _, file := range files {
+			data, err := os.ReadFile(filepath.Join(dep.Dir, file))
+			if err != nil {
+				return fmt.Errorf("failed to vendor %s: %w", dep.Module.Name, err)
+			}
+
+			to := filepath.Join(vendor, dep.Module.Name, file)
+			err = os.MkdirAll(filepath.Dir(to), 0755)
+			if err != nil {
+				return err
+			}
+			err = os.WriteFile(to, data, 0644)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	lock, err := m.Lock()
+	if err != nil {
+		return err
+	}
+	return lock.Write(m.Dir)
+}
+
+// Verify checks that the dependencies of m are the same as when they were
+// locked, returning what's different about them.
+func (m *Workspace) Verify() ([]string, error) {
+	err := m.ResolveDependencies()
+	if err != nil {
+		return nil, err
+	}
+
+	locked, err := LoadLockfileFrom(m.Dir)
+	if err != nil {
+		return nil, err
+	}
+	current, err := m.Lock()
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+
+	for _, cur := range current.Dependencies {
+		var lock *LockedDependency
+		for i := range locked.Dependencies {
+			if locked.Dependencies[i].Name == cur.Name {
+				lock = &locked.Dependencies[i]
+			}
+		}
+
+		switch {
+		case lock == nil:
+			problems = append(problems, fmt.Sprintf("%s isn't in %s", cur.Name, LockfileName))
+		case lock.Version != cur.Version:
+			problems = append(problems, fmt.Sprintf("%s is version %s, but %s is locked", cur.Name, cur.Version, lock.Version))
+		case lock.Hash != cur.Hash:
+			problems = append(problems, fmt.Sprintf("%s has changed since it was locked", cur.Name))
+		}
+	}
+	for _, lock := range locked.Dependencies {
+		found := false
+		for _, cur := range current.Dependencies {
+			if cur.Name == lock.Name {
+				found = true
+			}
+		}
+		if !found {
+			problems = append(problems, fmt.Sprintf("%s is locked, but nothing depends on it", lock.Name))
+		}
+	}
+
+	return problems, nil
+}
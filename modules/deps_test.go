@@ -0,0 +1,262 @@
+package modules
+
+import (
+	"errors"
+	"lugmac/typechecking"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeFiles writes files, keyed by their paths relative to dir.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		file := filepath.Join(dir, name)
+		err := os.MkdirAll(filepath.Dir(file), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(file, []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// workspaces writes an App workspace depending on a Lib workspace into a
+// new directory, returning it.
+func workspaces(t *testing.T) string {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"lib/lugma.yaml": `name: Lib
+version: 1.0.0
+products:
+  - type: module
+    name: L
+`,
+		"lib/Sources/L/L.lugma": "struct Thing {\n    let name: String\n}\n",
+		"app/lugma.yaml": `name: App
+version: 1.0.0
+dependencies:
+  - name: Lib
+    path: ../lib
+products:
+  - type: module
+    name: A
+    depends: [Lib/L]
+`,
+		"app/Sources/A/A.lugma": "import \"Lib/L\" as L\nfunc get() -> L.Thing\n",
+	})
+	return dir
+}
+
+func load(t *testing.T, dir string) *Workspace {
+	t.Helper()
+	w, err := LoadWorkspaceFrom(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+func TestVendor(t *testing.T) {
+	dir := workspaces(t)
+	app := filepath.Join(dir, "app")
+	// what other tools keep alongside shouldn't be touched
+	writeFiles(t, app, map[string]string{
+		"vendor/modules.txt":          "# github.com/some/module v1.0.0\n",
+		VendorDir + "/Notes/notes.md": "not a dependency\n",
+	})
+
+	err := load(t, app).Vendor()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range []string{
+		"vendor/modules.txt",
+		VendorDir + "/Notes/notes.md",
+		VendorDir + "/Lib/lugma.yaml",
+		VendorDir + "/Lib/Sources/L/L.lugma",
+		LockfileName,
+	} {
+		if _, err := os.Stat(filepath.Join(app, file)); err != nil {
+			t.Errorf("expected %s to be there: %s", file, err)
+		}
+	}
+
+	lock, err := LoadLockfileFrom(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lock.Dependencies) != 1 || lock.Dependencies[0].Name != "Lib" || lock.Dependencies[0].Version != "1.0.0" {
+		t.Errorf("expected Lib 1.0.0 to be locked, got %+v", lock.Dependencies)
+	}
+
+	// the vendored copy is used even once the original's gone
+	err = os.RemoveAll(filepath.Join(dir, "lib"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = load(t, app).GenerateModules()
+	if err != nil {
+		t.Errorf("expected the vendored copy to be loaded, got %s", err)
+	}
+}
+
+func TestVendorReplacesStaleCopies(t *testing.T) {
+	dir := workspaces(t)
+	app := filepath.Join(dir, "app")
+
+	err := load(t, app).Vendor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFiles(t, app, map[string]string{VendorDir + "/Lib/Sources/L/Old.lugma": "struct Old {}\n"})
+
+	err = load(t, app).Vendor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(app, VendorDir, "Lib/Sources/L/Old.lugma")); !os.IsNotExist(err) {
+		t.Errorf("expected files no longer in Lib to be removed from its copy, got %v", err)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	cases := []struct {
+		name   string
+		change func(t *testing.T, dir string)
+		want   []string
+	}{
+		{
+			name:   "unchanged",
+			change: func(t *testing.T, dir string) {},
+		},
+		{
+			name: "source changed",
+			change: func(t *testing.T, dir string) {
+				writeFiles(t, dir, map[string]string{"lib/Sources/L/L.lugma": "struct Thing {\n    let id: String\n}\n"})
+			},
+			want: []string{"Lib has changed since it was locked"},
+		},
+		{
+			name: "version changed",
+			change: func(t *testing.T, dir string) {
+				writeFiles(t, dir, map[string]string{"lib/lugma.yaml": "name: Lib\nversion: 1.1.0\nproducts:\n  - type: module\n    name: L\n"})
+			},
+			want: []string{"Lib is version 1.1.0, but 1.0.0 is locked"},
+		},
+		{
+			name: "dependency locked but gone",
+			change: func(t *testing.T, dir string) {
+				writeFiles(t, dir, map[string]string{
+					"app/lugma.yaml":        "name: App\nversion: 1.0.0\nproducts:\n  - type: module\n    name: A\n",
+					"app/Sources/A/A.lugma": "func get()\n",
+				})
+			},
+			want: []string{"Lib is locked, but nothing depends on it"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			dir := workspaces(t)
+			app := filepath.Join(dir, "app")
+			lock, err := load(t, app).Lock()
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = lock.Write(app)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			c.change(t, dir)
+			problems, err := load(t, app).Verify()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Join(problems, "\n") != strings.Join(c.want, "\n") {
+				t.Errorf("expected %q, got %q", c.want, problems)
+			}
+		})
+	}
+}
+
+func TestHashWorkspace(t *testing.T) {
+	dir := workspaces(t)
+	lib := filepath.Join(dir, "lib")
+
+	first, err := HashWorkspace(lib)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := HashWorkspace(lib)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != again || !strings.HasPrefix(first, "sha256:") {
+		t.Errorf("expected the same sha256 hash twice, got %s and %s", first, again)
+	}
+
+	// files outside of lugma.yaml and Sources aren't part of the workspace
+	writeFiles(t, lib, map[string]string{"README.md": "hello\n"})
+	if hash, _ := HashWorkspace(lib); hash != first {
+		t.Errorf("expected a README not to change the hash")
+	}
+
+	writeFiles(t, lib, map[string]string{"Sources/L/More.lugma": "struct More {}\n"})
+	if hash, _ := HashWorkspace(lib); hash == first {
+		t.Errorf("expected a new source file to change the hash")
+	}
+}
+
+func TestDependencyProblems(t *testing.T) {
+	cases := []struct {
+		name  string
+		files map[string]string
+		want  string
+	}{
+		{
+			name: "import without depending",
+			files: map[string]string{
+				"app/lugma.yaml":        "name: App\nversion: 1.0.0\ndependencies:\n  - name: Lib\n    path: ../lib\nproducts:\n  - type: module\n    name: A\n",
+				"app/Sources/A/A.lugma": "import \"Lib/L\" as L\nfunc get() -> L.Thing\n",
+			},
+			want: "A imports Lib/L without depending on it",
+		},
+		{
+			name: "products depending on each other",
+			files: map[string]string{
+				"app/lugma.yaml":        "name: App\nversion: 1.0.0\nproducts:\n  - type: module\n    name: A\n    depends: [B]\n  - type: module\n    name: B\n    depends: [A]\n",
+				"app/Sources/A/A.lugma": "func a()\n",
+				"app/Sources/B/B.lugma": "func b()\n",
+			},
+			want: "products depend on each other in a cycle: A → B → A",
+		},
+		{
+			name: "workspace named differently",
+			files: map[string]string{
+				"app/lugma.yaml": "name: App\nversion: 1.0.0\ndependencies:\n  - name: Library\n    path: ../lib\nproducts:\n  - type: module\n    name: A\n",
+			},
+			want: "is named Lib, not Library",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			dir := workspaces(t)
+			writeFiles(t, dir, c.files)
+
+			err := load(t, filepath.Join(dir, "app")).GenerateModules()
+			var diags typechecking.Diagnostics
+			if !errors.As(err, &diags) {
+				t.Fatalf("expected diagnostics, got %v", err)
+			}
+			if !strings.Contains(diags.Error(), c.want) {
+				t.Errorf("expected %q, got:\n%s", c.want, diags)
+			}
+		})
+	}
+}
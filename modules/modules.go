@@ -1,6 +1,7 @@
 package modules
 
 import (
+	"errors"
 	"fmt"
 	"io/fs"
 	"io/ioutil"
@@ -9,10 +10,9 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 	"sync"
 
-	lugma "lugmac/parser"
-
 	sitter "github.com/smacker/go-tree-sitter"
 	"gopkg.in/yaml.v3"
 )
@@ -24,19 +24,49 @@ type Workspace struct {
 	KnownModules map[string]*typechecking.Module
 	Context      *typechecking.Context
 
-	once sync.Once
+	// Overlay holds contents to use instead of what's on disk, keyed by the
+	// path of the file they replace.
+	Overlay map[string][]byte
+
+	// Dependencies holds the workspaces this one depends on, keyed by name,
+	// once they've been resolved.
+	Dependencies map[string]*Workspace
+
+	resolver *resolver
+	checked  bool
+	once     sync.Once
 }
 
 type ModuleDefinition struct {
-	Name     string              `yaml:"name"`
-	Version  string              `yaml:"version"`
-	Products []ProductDefinition `yaml:"products"`
+	Name         string                 `yaml:"name"`
+	Version      string                 `yaml:"version"`
+	Dependencies []DependencyDefinition `yaml:"dependencies,omitempty"`
+	Products     []ProductDefinition    `yaml:"products"`
 }
 
 type ProductDefinition struct {
 	Type    string   `yaml:"type"`
 	Name    string   `yaml:"name"`
-	Depends []string `yaml:"depends"`
+	Depends []string `yaml:"depends,omitempty"`
+
+	// span is where the product is defined in lugma.yaml.
+	span ast.Span
+}
+
+func (p *ProductDefinition) UnmarshalYAML(value *yaml.Node) error {
+	type plain ProductDefinition
+	err := value.Decode((*plain)(p))
+	if err != nil {
+		return err
+	}
+
+	p.span = spanOf(value)
+	return nil
+}
+
+func pointSpan(row, column uint32) ast.Span {
+	start := sitter.Point{Row: row, Column: column}
+	return ast.Span{Start: start, End: start}
 }
 
 func LoadModuleDefinitionFrom(dir string) (*ModuleDefinition, error) {
@@ -62,34 +92,219 @@ func LoadWorkspaceFrom(dir string) (*Workspace, error) {
 		return nil, err
 	}
 
-	return &Workspace{dir, &typechecking.Workspace{
-		Name:      mod.Name,
-		DefinedAt: typechecking.Path{},
-		InEnv:     nil,
+	return &Workspace{
+		Dir: dir,
+		Workspace: &typechecking.Workspace{
+			Name:      mod.Name,
+			DefinedAt: typechecking.Path{},
+			InEnv:     nil,
 
-		Modules: map[string]*typechecking.Module{},
-	}, mod, map[string]*typechecking.Module{}, nil, sync.Once{}}, nil
+			Modules: map[string]*typechecking.Module{},
+		},
+		Module:       mod,
+		KnownModules: map[string]*typechecking.Module{},
+		Overlay:      map[string][]byte{},
+	}, nil
+}
+
+func (m *Workspace) product(name string) *ProductDefinition {
+	for i := range m.Module.Products {
+		if m.Module.Products[i].Name == name {
+			return &m.Module.Products[i]
+		}
+	}
+	return nil
 }
 
 func (m *Workspace) ModuleFor(context *typechecking.Context, path string, from string) (*typechecking.Module, error) {
+	importer := m.product(strings.TrimPrefix(from, m.Workspace.Name+"/"))
+
+	if strings.Contains(path, "/") {
+		v, err := m.moduleInDependency(path)
+		if err == nil && importer != nil && !contains(importer.Depends, path) {
+			return v, fmt.Errorf("%s imports %s without depending on it; add it to the depends of %s in lugma.yaml", importer.Name, path, importer.Name)
+		}
+		return v, err
+	}
+
+	if m.product(path) == nil {
+		return nil, fmt.Errorf("there's no module named %s in this workspace", path)
+	}
+
 	v, ok := m.KnownModules[path]
+
+	if importer != nil && importer.Name != path && !contains(importer.Depends, path) {
+		// the module's still returned if it's loaded, so that the importer
+		// doesn't fail on everything it uses from it too
+		return v, fmt.Errorf("%s imports %s without depending on it; add it to the depends of %s in lugma.yaml", importer.Name, path, importer.Name)
+	}
+
 	if !ok {
-		return nil, fmt.Errorf("idk where %s is", path)
+		return nil, fmt.Errorf("module %s couldn't be loaded", path)
 	}
 
 	return v, nil
 }
 
+func (m *Workspace) dependsOn(workspace string) bool {
+	for _, dep := range m.Module.Dependencies {
+		if dep.Name == workspace {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, item string) bool {
+	for _, it := range list {
+		if it == item {
+			return true
+		}
+	}
+	return false
+}
+
+// productOrder returns the products of the workspace in an order where each
+// comes after the ones it depends on, keeping them in the order they're
+// defined in where it can. Products depending on missing products or on each
+// other are left out, and reported.
+func (m *Workspace) productOrder() ([]ProductDefinition, typechecking.Diagnostics) {
+	var diags typechecking.Diagnostics
+	file := path.Join(m.Dir, "lugma.yaml")
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+		broken
+	)
+	state := map[string]int{}
+	var stack []string
+	var order []ProductDefinition
+
+	var visit func(product *ProductDefinition) bool
+	visit = func(product *ProductDefinition) bool {
+		switch state[product.Name] {
+		case visiting:
+			// the stack from where this product was first visited is a cycle
+			start := 0
+			for stack[start] != product.Name {
+				start++
+			}
+			cycle := append(append([]string{}, stack[start:]...), product.Name)
+
+			diag := typechecking.Diagnostic{
+				File:     file,
+				Span:     product.span,
+				Severity: typechecking.Error,
+				Message:  fmt.Sprintf("products depend on each other in a cycle: %s", strings.Join(cycle, " → ")),
+			}
+			for i := 0; i < len(cycle)-1; i++ {
+				diag.Notes = append(diag.Notes, typechecking.Diagnostic{
+					File:     file,
+					Span:     m.product(cycle[i]).span,
+					Severity: typechecking.Note,
+					Message:  fmt.Sprintf("%s depends on %s", cycle[i], cycle[i+1]),
+				})
+			}
+			diags = append(diags, diag)
+
+			for _, name := range cycle {
+				state[name] = broken
+			}
+			return false
+		case visited:
+			return true
+		case broken:
+			return false
+		}
+
+		state[product.Name] = visiting
+		stack = append(stack, product.Name)
+		defer func() { stack = stack[:len(stack)-1] }()
+
+		ok := true
+		for _, dep := range product.Depends {
+			if workspace, _, ok := strings.Cut(dep, "/"); ok {
+				// modules in other workspaces are loaded before any in this
+				// one, so they don't need ordering
+				if !m.dependsOn(workspace) {
+					diags = append(diags, typechecking.Diagnostic{
+						File:     file,
+						Span:     product.span,
+						Severity: typechecking.Error,
+						Message:  fmt.Sprintf("%s depends on %s, but %s isn't in the dependencies of this workspace", product.Name, dep, workspace),
+					})
+				}
+				continue
+			}
+
+			depends := m.product(dep)
+			if depends == nil {
+				diags = append(diags, typechecking.Diagnostic{
+					File:     file,
+					Span:     product.span,
+					Severity: typechecking.Error,
+					Message:  fmt.Sprintf("%s depends on %s, but there's no product named %s", product.Name, dep, dep),
+				})
+				ok = false
+				continue
+			}
+			if !visit(depends) {
+				ok = false
+			}
+		}
+
+		if state[product.Name] == broken {
+			return false
+		}
+		if !ok {
+			state[product.Name] = broken
+			return false
+		}
+
+		state[product.Name] = visited
+		order = append(order, *product)
+		return true
+	}
+
+	for i := range m.Module.Products {
+		visit(&m.Module.Products[i])
+	}
+
+	return order, diags
+}
+
+func (m *Workspace) readFile(path string) ([]byte, error) {
+	if data, ok := m.Overlay[path]; ok {
+		return data, nil
+	}
+	return ioutil.ReadFile(path)
+}
+
+// GenerateModules loads and checks every module in the workspace. Problems
+// found in them are returned together as typechecking.Diagnostics once every
+// module has been checked.
 func (m *Workspace) GenerateModules() error {
 	m.once.Do(func() {
 		m.Context = typechecking.NewContext(m)
 	})
 	ctx := m.Context
+	m.checked = true
+
+	err := m.ResolveDependencies()
+	if err != nil {
+		return err
+	}
+	diags, err := m.generateDependencies()
+	if err != nil {
+		return err
+	}
 
-	parser := sitter.NewParser()
-	parser.SetLanguage(lugma.GetLanguage())
+	products, orderDiags := m.productOrder()
+	diags = append(diags, orderDiags...)
 
-	for _, product := range m.Module.Products {
+	for _, product := range products {
 		var files []string
 		filepath.WalkDir(path.Join(m.Dir, "Sources", product.Name), func(path string, d fs.DirEntry, err error) error {
 			if filepath.Ext(path) == ".lugma" && !d.IsDir() {
@@ -99,17 +314,25 @@ func (m *Workspace) GenerateModules() error {
 		})
 
 		var astFiles []*ast.File
+		var syntaxErrors typechecking.Diagnostics
 
 		for _, path := range files {
-			file, err := ioutil.ReadFile(path)
+			file, err := m.readFile(path)
 			if err != nil {
 				return fmt.Errorf("failed to load module at %s: %w", path, err)
 			}
 
-			tree := parser.Parse(nil, file)
-
-			fileAST := ast.FileFromNode(tree.RootNode(), file)
-			astFiles = append(astFiles, &fileAST)
+			fileAST, err := ast.Parse(file)
+			if err != nil {
+				syntaxErrors = append(syntaxErrors, typechecking.DiagnosticsFor(path, err)...)
+				continue
+			}
+			fileAST.Path = path
+			astFiles = append(astFiles, fileAST)
+		}
+		if len(syntaxErrors) > 0 {
+			diags = append(diags, syntaxErrors...)
+			continue
 		}
 
 		docs, err := ioutil.ReadFile(path.Join(m.Dir, "Sources", product.Name, "Docs.md"))
@@ -118,7 +341,10 @@ func (m *Workspace) GenerateModules() error {
 		}
 
 		module, err := ctx.MultiFileModule(astFiles, m.Workspace, m.Workspace.Name+"/"+product.Name)
-		if err != nil {
+		var moduleDiags typechecking.Diagnostics
+		if errors.As(err, &moduleDiags) {
+			diags = append(diags, moduleDiags...)
+		} else if err != nil {
 			return err
 		}
 
@@ -130,5 +356,9 @@ func (m *Workspace) GenerateModules() error {
 		m.KnownModules[product.Name] = module
 	}
 
+	if len(diags) > 0 {
+		return diags
+	}
+
 	return nil
 }
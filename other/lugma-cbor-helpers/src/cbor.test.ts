@@ -0,0 +1,154 @@
+import { test } from "node:test"
+import * as assert from "node:assert/strict"
+import { BigInteger, Data, decodeCBOR, encodeCBOR, Float } from "./cbor"
+import { codecArray, codecBytes, codecCases, codecDictionary, codecFields, codecFloat32, codecInt64, codecOneOf, codecOptional, codecString, codecUInt8, codecUUID, DecodeError, numericKey } from "./codecs"
+
+function hex(bytes: Uint8Array): string {
+    return Array.from(bytes).map((b) => (b < 16 ? "0" : "") + b.toString(16)).join("")
+}
+
+function unhex(text: string): Uint8Array {
+    const bytes = new Uint8Array(text.length / 2)
+    for (let i = 0; i < bytes.length; i++) {
+        bytes[i] = parseInt(text.slice(i * 2, i * 2 + 2), 16)
+    }
+    return bytes
+}
+
+// the examples from appendix A of RFC 8949 that can be written both ways
+const examples: [string, Data][] = [
+    ["00", 0],
+    ["01", 1],
+    ["0a", 10],
+    ["17", 23],
+    ["1818", 24],
+    ["1864", 100],
+    ["1903e8", 1000],
+    ["1a000f4240", 1000000],
+    ["1b000000e8d4a51000", 1000000000000],
+    ["1b001fffffffffffff", Number.MAX_SAFE_INTEGER],
+    ["1bffffffffffffffff", new BigInteger("18446744073709551615")],
+    ["3bffffffffffffffff", new BigInteger("-18446744073709551616")],
+    ["20", -1],
+    ["29", -10],
+    ["3863", -100],
+    ["3903e7", -1000],
+    ["fb3ff199999999999a", 1.1],
+    ["fa47c35000", new Float(100000, 32)],
+    ["fb7e37e43c8800759c", 1.0e+300],
+    ["f4", false],
+    ["f5", true],
+    ["f6", null],
+    ["f7", undefined],
+    ["40", new Uint8Array([])],
+    ["4401020304", new Uint8Array([1, 2, 3, 4])],
+    ["60", ""],
+    ["6161", "a"],
+    ["6449455446", "IETF"],
+    ["62225c", "\"\\"],
+    ["62c3bc", "ü"],
+    ["63e6b0b4", "水"],
+    ["80", []],
+    ["83010203", [1, 2, 3]],
+    ["8301820203820405", [1, [2, 3], [4, 5]]],
+    ["a0", new Map()],
+    ["a201020304", new Map([[1, 2], [3, 4]])],
+    ["a26161016162820203", new Map<Data, Data>([["a", 1], ["b", [2, 3]]])],
+]
+
+test("encodes the examples", () => {
+    for (const [bytes, data] of examples) {
+        assert.equal(hex(encodeCBOR(data)), bytes, `encoding ${String(data)}`)
+    }
+})
+
+test("decodes the examples", () => {
+    for (const [bytes, data] of examples) {
+        const want = data instanceof Float ? data.value : data
+        assert.deepEqual(decodeCBOR(unhex(bytes)), want, `decoding ${bytes}`)
+    }
+})
+
+test("decodes floats of every precision", () => {
+    const floats: [string, number][] = [
+        ["f90000", 0],
+        ["f98000", -0],
+        ["f93c00", 1],
+        ["f93e00", 1.5],
+        ["f97bff", 65504],
+        ["f90001", 5.960464477539063e-8],
+        ["f90400", 0.00006103515625],
+        ["f9c400", -4],
+        ["f97c00", Infinity],
+        ["f9fc00", -Infinity],
+        ["fa7f800000", Infinity],
+        ["fbc010666666666666", -4.1],
+    ]
+    for (const [bytes, n] of floats) {
+        assert.equal(decodeCBOR(unhex(bytes)), n, `decoding ${bytes}`)
+    }
+    assert.ok(Number.isNaN(decodeCBOR(unhex("f97e00"))))
+})
+
+test("skips tags", () => {
+    assert.equal(decodeCBOR(unhex("c074323031332d30332d32315432303a30343a30305a")), "2013-03-21T20:04:00Z")
+})
+
+test("rejects what it can't read", () => {
+    const invalid: [string, RegExp][] = [
+        ["", /unexpected end/],
+        ["18", /unexpected end/],
+        ["62c3", /unexpected end/],
+        ["8301", /unexpected end/],
+        ["0000", /continues after/],
+        ["5f4201020304ff", /indefinite/],
+        ["9f01ff", /indefinite/],
+        ["f0", /unknown simple value/],
+        ["5bffffffffffffffff", /too large/],
+        ["62c328", /./],
+    ]
+    for (const [bytes, problem] of invalid) {
+        assert.throws(() => decodeCBOR(unhex(bytes)), problem, `decoding ${bytes}`)
+    }
+})
+
+test("codecs round-trip values as they're sent in JSON", () => {
+    const user = codecFields([
+        ["id", 1, codecUUID],
+        ["name", 2, codecString],
+        ["age", 3, codecOptional(codecUInt8)],
+        ["avatar", 4, codecOptional(codecBytes)],
+        ["balance", 5, codecInt64],
+        ["score", 6, codecFloat32],
+        ["friends", 7, codecArray(codecString)],
+        ["visits", 8, codecDictionary(codecUInt8, codecString, numericKey)],
+        ["colour", 9, codecOneOf([["red", 0], ["green", 1]])],
+        ["contact", 10, codecCases([["email", "email", codecString], ["phone", "phone", codecString]])],
+    ])
+    const value = {
+        id: "01234567-89ab-cdef-0123-456789abcdef",
+        name: "Janet",
+        avatar: "AQID",
+        balance: "-9007199254740993",
+        score: 1.5,
+        friends: ["Derek"],
+        visits: { "1": "home" },
+        colour: "green",
+        contact: { email: "janet@example.com" },
+    }
+    const bytes = encodeCBOR(user.encode(value))
+    assert.deepEqual(user.decode(decodeCBOR(bytes), ""), value)
+})
+
+test("codecs report where data has the wrong shape", () => {
+    const codec = codecFields([["items", "items", codecArray(codecFields([["n", "n", codecUInt8]]))]])
+    const data = new Map<Data, Data>([["items", [new Map<Data, Data>([["n", 1]]), new Map<Data, Data>([["n", "one"]])]]])
+    assert.throws(() => codec.decode(data, ""), (err: unknown) => {
+        assert.ok(err instanceof DecodeError)
+        assert.equal(err.path, "items[1].n")
+        assert.equal(err.message, "items[1].n: should be an integer")
+        return true
+    })
+    assert.throws(() => codecOneOf([["red", 0]]).decode(1, "colour"), /colour: should be one of 0/)
+    assert.throws(() => codecCases([["a", "a", codecString]]).decode(new Map(), "x"), /x: should have exactly one of "a"/)
+})
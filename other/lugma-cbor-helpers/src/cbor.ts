@@ -0,0 +1,328 @@
+// Data is a value as it's written in CBOR. Integers are read as numbers,
+// unless they're too large for a number to hold exactly, when they're read
+// as BigIntegers. Floats are read as numbers, but have to be wrapped in Float
+// to be written as floats rather than integers.
+export type Data = number | BigInteger | Float | string | Uint8Array | boolean | null | undefined | Data[] | Map<Data, Data>
+
+// BigInteger is an integer too large to be held exactly by a number, written
+// in decimal.
+export class BigInteger {
+    constructor(readonly decimal: string) {}
+}
+
+// Float is a number to be written as a float with the given precision.
+export class Float {
+    constructor(readonly value: number, readonly bits: 32 | 64) {}
+}
+
+const twoTo32 = 0x100000000
+
+class Writer {
+    bytes: number[] = []
+
+    head(major: number, n: number) {
+        if (n < 24) {
+            this.bytes.push(major << 5 | n)
+        } else if (n < 0x100) {
+            this.bytes.push(major << 5 | 24, n)
+        } else if (n < 0x10000) {
+            this.bytes.push(major << 5 | 25, n >> 8, n & 0xff)
+        } else if (n < twoTo32) {
+            this.bytes.push(major << 5 | 26)
+            this.uint32(n)
+        } else {
+            this.bytes.push(major << 5 | 27)
+            this.uint32(Math.floor(n / twoTo32))
+            this.uint32(n >>> 0)
+        }
+    }
+    uint32(n: number) {
+        this.bytes.push(n >>> 24 & 0xff, n >>> 16 & 0xff, n >>> 8 & 0xff, n & 0xff)
+    }
+    float(n: number, bits: 32 | 64) {
+        const view = new DataView(new ArrayBuffer(bits / 8))
+        if (bits === 32) {
+            this.bytes.push(0xfa)
+            view.setFloat32(0, n)
+        } else {
+            this.bytes.push(0xfb)
+            view.setFloat64(0, n)
+        }
+        for (let i = 0; i < view.byteLength; i++) {
+            this.bytes.push(view.getUint8(i))
+        }
+    }
+    write(data: Data) {
+        if (data === null) {
+            this.bytes.push(0xf6)
+        } else if (data === undefined) {
+            this.bytes.push(0xf7)
+        } else if (data === false) {
+            this.bytes.push(0xf4)
+        } else if (data === true) {
+            this.bytes.push(0xf5)
+        } else if (typeof data === "number") {
+            if (Number.isInteger(data) && Math.abs(data) <= Number.MAX_SAFE_INTEGER) {
+                if (data >= 0) {
+                    this.head(0, data)
+                } else {
+                    this.head(1, -1 - data)
+                }
+            } else {
+                this.float(data, 64)
+            }
+        } else if (data instanceof Float) {
+            this.float(data.value, data.bits)
+        } else if (data instanceof BigInteger) {
+            const negative = data.decimal.startsWith("-")
+            const digits = negative ? subtractOne(data.decimal.slice(1)) : data.decimal
+            this.bytes.push((negative ? 1 : 0) << 5 | 27)
+            this.bytes.push(...decimalToBytes(digits))
+        } else if (typeof data === "string") {
+            const bytes = new TextEncoder().encode(data)
+            this.head(3, bytes.length)
+            bytes.forEach((b) => this.bytes.push(b))
+        } else if (data instanceof Uint8Array) {
+            this.head(2, data.length)
+            data.forEach((b) => this.bytes.push(b))
+        } else if (Array.isArray(data)) {
+            this.head(4, data.length)
+            data.forEach((item) => this.write(item))
+        } else {
+            this.head(5, data.size)
+            data.forEach((value, key) => {
+                this.write(key)
+                this.write(value)
+            })
+        }
+    }
+}
+
+export function encodeCBOR(data: Data): Uint8Array {
+    const writer = new Writer()
+    writer.write(data)
+    return new Uint8Array(writer.bytes)
+}
+
+class Reader {
+    view: DataView
+    offset = 0
+
+    constructor(readonly bytes: Uint8Array) {
+        this.view = new DataView(bytes.buffer, bytes.byteOffset, bytes.byteLength)
+    }
+
+    need(n: number) {
+        if (this.offset + n > this.bytes.length) {
+            throw new Error("invalid CBOR: unexpected end of data")
+        }
+    }
+    uint8(): number {
+        this.need(1)
+        return this.view.getUint8(this.offset++)
+    }
+    argument(info: number): number | BigInteger {
+        let n: number
+        switch (info) {
+        case 24:
+            return this.uint8()
+        case 25:
+            this.need(2)
+            n = this.view.getUint16(this.offset)
+            this.offset += 2
+            return n
+        case 26:
+            this.need(4)
+            n = this.view.getUint32(this.offset)
+            this.offset += 4
+            return n
+        case 27: {
+            this.need(8)
+            const high = this.view.getUint32(this.offset)
+            const low = this.view.getUint32(this.offset + 4)
+            const bytes = Array.from(this.bytes.subarray(this.offset, this.offset + 8))
+            this.offset += 8
+            if (high < 0x200000) {
+                return high * twoTo32 + low
+            }
+            return new BigInteger(bytesToDecimal(bytes))
+        }
+        default:
+            if (info < 24) {
+                return info
+            }
+            throw new Error("invalid CBOR: indefinite lengths aren't supported")
+        }
+    }
+    length(info: number): number {
+        const n = this.argument(info)
+        if (typeof n !== "number") {
+            throw new Error("invalid CBOR: length is too large")
+        }
+        return n
+    }
+    read(): Data {
+        const initial = this.uint8()
+        const major = initial >> 5, info = initial & 0x1f
+
+        switch (major) {
+        case 0:
+            return this.argument(info)
+        case 1: {
+            const n = this.argument(info)
+            if (typeof n === "number") {
+                return -1 - n
+            }
+            return new BigInteger("-" + addOne(n.decimal))
+        }
+        case 2: {
+            const n = this.length(info)
+            this.need(n)
+            const bytes = this.bytes.slice(this.offset, this.offset + n)
+            this.offset += n
+            return bytes
+        }
+        case 3: {
+            const n = this.length(info)
+            this.need(n)
+            const text = new TextDecoder("utf-8", { fatal: true }).decode(this.bytes.subarray(this.offset, this.offset + n))
+            this.offset += n
+            return text
+        }
+        case 4: {
+            const n = this.length(info)
+            const items: Data[] = []
+            for (let i = 0; i < n; i++) {
+                items.push(this.read())
+            }
+            return items
+        }
+        case 5: {
+            const n = this.length(info)
+            const map = new Map<Data, Data>()
+            for (let i = 0; i < n; i++) {
+                const key = this.read()
+                map.set(key, this.read())
+            }
+            return map
+        }
+        case 6:
+            // tags only add meaning to the value they're on, which the type
+            // it's read as already gives it
+            this.argument(info)
+            return this.read()
+        default:
+            switch (info) {
+            case 20:
+                return false
+            case 21:
+                return true
+            case 22:
+                return null
+            case 23:
+                return undefined
+            case 25: {
+                this.need(2)
+                const half = this.view.getUint16(this.offset)
+                this.offset += 2
+                return halfToNumber(half)
+            }
+            case 26: {
+                this.need(4)
+                const n = this.view.getFloat32(this.offset)
+                this.offset += 4
+                return n
+            }
+            case 27: {
+                this.need(8)
+                const n = this.view.getFloat64(this.offset)
+                this.offset += 8
+                return n
+            }
+            default:
+                throw new Error(`invalid CBOR: unknown simple value ${info}`)
+            }
+        }
+    }
+}
+
+export function decodeCBOR(bytes: Uint8Array): Data {
+    const reader = new Reader(bytes)
+    const data = reader.read()
+    if (reader.offset !== bytes.length) {
+        throw new Error("invalid CBOR: data continues after the value")
+    }
+    return data
+}
+
+function halfToNumber(half: number): number {
+    const sign = half & 0x8000 ? -1 : 1
+    const exponent = (half >> 10) & 0x1f
+    const fraction = half & 0x3ff
+    if (exponent === 0) {
+        return sign * Math.pow(2, -14) * (fraction / 1024)
+    } else if (exponent === 0x1f) {
+        return fraction ? NaN : sign * Infinity
+    }
+    return sign * Math.pow(2, exponent - 15) * (1 + fraction / 1024)
+}
+
+// decimalToBytes returns the big-endian bytes of the 64-bit unsigned integer
+// written in decimal by digits.
+function decimalToBytes(digits: string): number[] {
+    const bytes = [0, 0, 0, 0, 0, 0, 0, 0]
+    for (let i = 0; i < digits.length; i++) {
+        let carry = digits.charCodeAt(i) - 48
+        for (let j = 7; j >= 0; j--) {
+            const n = bytes[j] * 10 + carry
+            bytes[j] = n & 0xff
+            carry = n >> 8
+        }
+        if (carry !== 0) {
+            throw new RangeError(`${digits} is too large for 64 bits`)
+        }
+    }
+    return bytes
+}
+
+// bytesToDecimal returns the decimal digits of the unsigned integer with the
+// given big-endian bytes.
+function bytesToDecimal(bytes: number[]): string {
+    let out = ""
+    while (bytes.some((b) => b !== 0)) {
+        let remainder = 0
+        for (let i = 0; i < bytes.length; i++) {
+            const n = remainder * 256 + bytes[i]
+            bytes[i] = Math.floor(n / 10)
+            remainder = n % 10
+        }
+        out = String(remainder) + out
+    }
+    return out || "0"
+}
+
+function addOne(digits: string): string {
+    const out = digits.split("").map(Number)
+    let i = out.length - 1
+    while (i >= 0 && out[i] === 9) {
+        out[i] = 0
+        i--
+    }
+    if (i < 0) {
+        out.unshift(1)
+    } else {
+        out[i]++
+    }
+    return out.join("")
+}
+
+function subtractOne(digits: string): string {
+    const out = digits.split("").map(Number)
+    let i = out.length - 1
+    while (out[i] === 0) {
+        out[i] = 9
+        i--
+    }
+    out[i]--
+    return out.join("").replace(/^0+(?=.)/, "")
+}
@@ -0,0 +1,314 @@
+import { BigInteger, Data, Float } from "./cbor"
+
+// Codecs convert values between how they're sent in JSON, which is how
+// generated code holds them, and how they're sent in CBOR.
+export interface Codec {
+    encode(value: any): Data
+    // decode throws a DecodeError if data isn't shaped like the codec's
+    // type. Whether its values are valid is left to validators.
+    decode(data: Data, path: string): any
+}
+
+// MethodCodec holds the codecs for a function's arguments, which are sent
+// together as a map, and its return and thrown values.
+export interface MethodCodec {
+    arguments: Codec
+    returns: Codec
+    throws: Codec
+}
+
+// StreamCodec holds the codecs for the arguments of a stream's events and
+// signals, keyed by their names.
+export interface StreamCodec {
+    events: { [name: string]: Codec }
+    signals: { [name: string]: Codec }
+}
+
+// DecodeError is thrown when data can't be decoded as the type it should
+// have, with the path to where in it the problem is.
+export class DecodeError extends Error {
+    constructor(readonly path: string, readonly problem: string) {
+        super(path === "" ? problem : `${path}: ${problem}`)
+    }
+}
+
+function pathTo(path: string, key: string | number): string {
+    if (typeof key === "number") {
+        return `${path}[${key}]`
+    }
+    return path === "" ? key : `${path}.${key}`
+}
+
+function primitive(description: string, test: (data: Data) => boolean, encode: (value: any) => Data = (value) => value, decode: (data: any) => any = (data) => data): Codec {
+    return {
+        encode,
+        decode(data, path) {
+            if (!test(data)) {
+                throw new DecodeError(path, `should be ${description}`)
+            }
+            return decode(data)
+        },
+    }
+}
+
+const isNumber = (data: Data) => typeof data === "number"
+const isInteger = (data: Data) => typeof data === "number" && Number.isInteger(data)
+
+// integers are sent as strings in JSON if they might not fit in a number
+function bigInteger(value: string): Data {
+    const digits = value.replace(/^-/, "")
+    return digits.length < 16 ? Number(value) : new BigInteger(value)
+}
+
+export const codecUInt8 = primitive("an integer", isInteger)
+export const codecUInt16 = codecUInt8
+export const codecUInt32 = codecUInt8
+export const codecInt8 = codecUInt8
+export const codecInt16 = codecUInt8
+export const codecInt32 = codecUInt8
+export const codecUInt64 = primitive("an integer", (data) => isInteger(data) || data instanceof BigInteger, bigInteger, (data) => data instanceof BigInteger ? data.decimal : String(data))
+export const codecInt64 = codecUInt64
+export const codecFloat32 = primitive("a number", isNumber, (value) => new Float(value, 32))
+export const codecFloat64 = primitive("a number", isNumber, (value) => new Float(value, 64))
+export const codecString = primitive("a text string", (data) => typeof data === "string")
+export const codecBytes = primitive("a byte string", (data) => data instanceof Uint8Array, fromBase64, toBase64)
+export const codecBool = primitive("a boolean", (data) => typeof data === "boolean")
+export const codecTimestamp = codecString
+export const codecDuration = codecString
+export const codecUUID = primitive("a byte string of 16 bytes", (data) => data instanceof Uint8Array && data.length === 16, fromUUID, toUUID)
+
+// codecNothing is the codec of what a function that returns or throws
+// nothing sends.
+export const codecNothing: Codec = {
+    encode: () => null,
+    decode(data, path) {
+        if (data !== null && data !== undefined) {
+            throw new DecodeError(path, "should be null")
+        }
+        return undefined
+    },
+}
+
+// lazy defers getting a codec until it's needed, so that codecs can refer to
+// ones declared after them.
+export function lazy(codec: () => Codec): Codec {
+    return {
+        encode: (value) => codec().encode(value),
+        decode: (data, path) => codec().decode(data, path),
+    }
+}
+
+export function codecOptional(codec: Codec): Codec {
+    return {
+        encode: (value) => value === undefined || value === null ? null : codec.encode(value),
+        decode: (data, path) => data === undefined || data === null ? null : codec.decode(data, path),
+    }
+}
+
+export function codecArray(codec: Codec): Codec {
+    return {
+        encode: (value: any[]) => value.map((item) => codec.encode(item)),
+        decode(data, path) {
+            if (!Array.isArray(data)) {
+                throw new DecodeError(path, "should be an array")
+            }
+            return data.map((item, idx) => codec.decode(item, pathTo(path, idx)))
+        },
+    }
+}
+
+// codecDictionary converts between objects and maps. Their keys are given
+// to key as they'd be decoded from a JSON object's keys by keyOf.
+export function codecDictionary(key: Codec, value: Codec, keyOf: (key: string) => any = (key) => key): Codec {
+    return {
+        encode(dict: { [key: string]: any }) {
+            const map = new Map<Data, Data>()
+            for (const k of Object.keys(dict)) {
+                map.set(key.encode(keyOf(k)), value.encode(dict[k]))
+            }
+            return map
+        },
+        decode(data, path) {
+            const map = asMap(data, path)
+            const dict: { [key: string]: any } = {}
+            map.forEach((v, k) => {
+                const name = String(key.decode(k, path))
+                dict[name] = value.decode(v, pathTo(path, name))
+            })
+            return dict
+        },
+    }
+}
+
+// numericKey decodes a dictionary key for a type sent as a JSON number.
+export function numericKey(key: string): any {
+    return /^-?[0-9]+$/.test(key) ? Number(key) : key
+}
+
+// booleanKey decodes a dictionary key for a type sent as a JSON boolean.
+export function booleanKey(key: string): any {
+    return key === "true" ? true : key === "false" ? false : key
+}
+
+// Fields are a field's name, the key it's sent as in CBOR, which is its tag
+// if it has one and its name if not, and its codec.
+export type Field = [string, string | number, Codec]
+
+// codecFields converts between objects and maps of fields. Fields that are
+// missing or null are left out.
+export function codecFields(fields: Field[]): Codec {
+    return {
+        encode(value: any) {
+            const map = new Map<Data, Data>()
+            for (const [name, key, codec] of fields) {
+                if (value[name] !== undefined && value[name] !== null) {
+                    map.set(key, codec.encode(value[name]))
+                }
+            }
+            return map
+        },
+        decode(data, path) {
+            const map = asMap(data, path)
+            const value: any = {}
+            for (const [name, key, codec] of fields) {
+                const item = map.get(key)
+                if (item !== undefined && item !== null) {
+                    value[name] = codec.decode(item, pathTo(path, name))
+                }
+            }
+            return value
+        },
+    }
+}
+
+// Cases are a case's name, and the key it's sent as in CBOR, which is its
+// tag if it has one and its name if not.
+export type Case = [string, string | number]
+
+// codecOneOf converts between the names of the cases of a simple enum and
+// their keys.
+export function codecOneOf(cases: Case[]): Codec {
+    return {
+        encode: (value: string) => {
+            const found = cases.find(([name]) => name === value)
+            return found === undefined ? value : found[1]
+        },
+        decode(data, path) {
+            const found = cases.find(([, key]) => key === data)
+            if (found === undefined) {
+                throw new DecodeError(path, `should be one of ${cases.map(([, key]) => JSON.stringify(key)).join(", ")}`)
+            }
+            return found[0]
+        },
+    }
+}
+
+// codecCases converts between values of an enum with cases that have values,
+// which are objects and maps with one key for their case.
+export function codecCases(cases: [string, string | number, Codec][]): Codec {
+    return {
+        encode(value: any) {
+            const map = new Map<Data, Data>()
+            for (const [name, key, codec] of cases) {
+                if (Object.prototype.hasOwnProperty.call(value, name)) {
+                    map.set(key, codec.encode(value[name]))
+                }
+            }
+            return map
+        },
+        decode(data, path) {
+            const map = asMap(data, path)
+            for (const [name, key, codec] of cases) {
+                if (map.size === 1 && map.has(key)) {
+                    return { [name]: codec.decode(map.get(key), pathTo(path, name)) }
+                }
+            }
+            throw new DecodeError(path, `should have exactly one of ${cases.map(([, key]) => JSON.stringify(key)).join(", ")}`)
+        },
+    }
+}
+
+function asMap(data: Data, path: string): Map<Data, Data> {
+    if (!(data instanceof Map)) {
+        throw new DecodeError(path, "should be a map")
+    }
+    return data
+}
+
+// toData converts a value that isn't described by a type, such as the
+// problems with a bad request, to CBOR, the same way it'd be sent in JSON.
+export function toData(value: any): Data {
+    if (Array.isArray(value)) {
+        return value.map(toData)
+    } else if (typeof value === "object" && value !== null) {
+        const map = new Map<Data, Data>()
+        for (const k of Object.keys(value)) {
+            map.set(k, toData(value[k]))
+        }
+        return map
+    }
+    return value
+}
+
+// fromData converts CBOR that isn't described by a type back to how it'd
+// be sent in JSON.
+export function fromData(data: Data): any {
+    if (Array.isArray(data)) {
+        return data.map(fromData)
+    } else if (data instanceof Map) {
+        const value: any = {}
+        data.forEach((v, k) => {
+            value[String(fromData(k))] = fromData(v)
+        })
+        return value
+    } else if (data instanceof Uint8Array) {
+        return toBase64(data)
+    } else if (data instanceof BigInteger) {
+        return data.decimal
+    } else if (data instanceof Float) {
+        return data.value
+    }
+    return data
+}
+
+const base64 = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+function toBase64(bytes: Uint8Array): string {
+    let out = ""
+    for (let i = 0; i < bytes.length; i += 3) {
+        const n = bytes[i] << 16 | (bytes[i + 1] ?? 0) << 8 | (bytes[i + 2] ?? 0)
+        out += base64[n >> 18 & 63] + base64[n >> 12 & 63]
+        out += i + 1 < bytes.length ? base64[n >> 6 & 63] : "="
+        out += i + 2 < bytes.length ? base64[n & 63] : "="
+    }
+    return out
+}
+
+function fromBase64(text: string): Uint8Array {
+    const digits = text.replace(/=+$/, "")
+    const bytes: number[] = []
+    let n = 0, bits = 0
+    for (let i = 0; i < digits.length; i++) {
+        n = (n << 6 | base64.indexOf(digits[i])) & 0xffffff
+        bits += 6
+        if (bits >= 8) {
+            bits -= 8
+            bytes.push(n >> bits & 0xff)
+        }
+    }
+    return new Uint8Array(bytes)
+}
+
+function toUUID(bytes: Uint8Array): string {
+    const hex = Array.from(bytes).map((b) => (b < 16 ? "0" : "") + b.toString(16)).join("")
+    return `${hex.slice(0, 8)}-${hex.slice(8, 12)}-${hex.slice(12, 16)}-${hex.slice(16, 20)}-${hex.slice(20)}`
+}
+
+function fromUUID(text: string): Uint8Array {
+    const hex = text.replace(/-/g, "")
+    const bytes = new Uint8Array(16)
+    for (let i = 0; i < 16; i++) {
+        bytes[i] = parseInt(hex.slice(i * 2, i * 2 + 2), 16)
+    }
+    return bytes
+}
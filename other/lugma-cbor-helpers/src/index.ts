@@ -0,0 +1,2 @@
+export * from "./cbor"
+export * from "./codecs"
@@ -1,4 +1,5 @@
-import { Stream, Transport, Result } from "lugma-server-helpers"
+import { Stream, Transport, Result, Problem } from "lugma-server-helpers"
+import { Codec, DecodeError, MethodCodec, StreamCodec, decodeCBOR, encodeCBOR, fromData, toData } from "lugma-cbor-helpers"
 import * as Express from "express"
 import * as HTTP from "http"
 import * as WebSockets from "ws"
@@ -10,9 +11,15 @@ export class WebSocketStream implements Stream<HTTP.IncomingHttpHeaders> {
     eventsToNumbers: Map<string, Set<number>>
     numbersToEvents: Map<number, string>
     callbackNumber: number
+    codec: StreamCodec | undefined
+    // binary is whether frames are sent as CBOR, which they are if the
+    // initial payload was.
+    binary: boolean
 
-    constructor(socket: WebSockets.WebSocket) {
+    constructor(socket: WebSockets.WebSocket, codec: StreamCodec | undefined = undefined) {
         this.socket = socket
+        this.codec = codec
+        this.binary = false
         this.socket.binaryType = "arraybuffer"
         this.callbacks = new Map()
         this.eventsToNumbers = new Map()
@@ -30,28 +37,51 @@ export class WebSocketStream implements Stream<HTTP.IncomingHttpHeaders> {
         })
         let initialPayload: any | null = null
         this.socket.addEventListener("message", (msg) => {
-            if (typeof msg.data === "string") {
-                if (initialPayload === null) {
-                    initialPayload = JSON.parse(msg.data)
-
-                    const values = this.eventsToNumbers.get("on initial")?.values()
-                    if (values == undefined) {
-                        return
-                    }
-                    for (let value of values) {
-                        this.callbacks.get(value)?.(initialPayload)
-                    }
+            if (initialPayload === null) {
+                if (msg.data instanceof ArrayBuffer) {
+                    this.binary = true
+                    initialPayload = fromData(decodeCBOR(new Uint8Array(msg.data)))
                 } else {
-                    const item = JSON.parse(msg.data)
-    
-                    const values = this.eventsToNumbers.get(item["kind"])?.values()
-                    if (values == undefined) {
-                        return
-                    }
-                    for (let value of values) {
-                        this.callbacks.get(value)?.(item["content"])
+                    initialPayload = JSON.parse(msg.data.toString())
+                }
+
+                const values = this.eventsToNumbers.get("on initial")?.values()
+                if (values == undefined) {
+                    return
+                }
+                for (let value of values) {
+                    this.callbacks.get(value)?.(initialPayload)
+                }
+                return
+            }
+
+            let signal: string, content: any
+            if (msg.data instanceof ArrayBuffer) {
+                try {
+                    const item = decodeCBOR(new Uint8Array(msg.data))
+                    if (!(item instanceof Map)) {
+                        throw new DecodeError("", "should be a map")
                     }
+                    signal = String(item.get("type"))
+                    const codec = this.codec?.signals[signal]
+                    content = codec !== undefined ? codec.decode(item.get("content"), "content") : fromData(item.get("content"))
+                } catch (err) {
+                    // 1007 is for frames with data that isn't what it should be
+                    this.socket.close(1007, String(err))
+                    return
                 }
+            } else {
+                const item = JSON.parse(msg.data.toString())
+                signal = item["type"]
+                content = item["content"]
+            }
+
+            const values = this.eventsToNumbers.get(signal)?.values()
+            if (values == undefined) {
+                return
+            }
+            for (let value of values) {
+                this.callbacks.get(value)?.(content)
             }
         })
     }
@@ -100,6 +130,14 @@ export class WebSocketStream implements Stream<HTTP.IncomingHttpHeaders> {
         return this.callbackNumber
     }
     send(event: string, body: any): void {
+        if (this.binary) {
+            const codec = this.codec?.events[event]
+            this.socket.send(encodeCBOR(new Map([
+                ["type", event],
+                ["content", codec !== undefined ? codec.encode(body) : toData(body)],
+            ])))
+            return
+        }
         this.socket.send(JSON.stringify({
             "type": event,
             "content": body,
@@ -114,22 +152,47 @@ export class ExpressTransport implements Transport<HTTP.IncomingHttpHeaders> {
     constructor() {
         this.router = Express.default()
         this.router.use(Express.json())
+        this.router.use(Express.raw({ type: "application/cbor" }))
         this.websocket = ExpressWebsockets.default(this.router, undefined, { leaveRouterUntouched: true })
     }
-    bindMethod(path: string, slot: (content: any, extra: any) => Promise<Result<any, any>>): void {
+    bindMethod(path: string, slot: (content: any, extra: any) => Promise<Result<any, any>>, codec: MethodCodec | undefined = undefined): void {
         this.router.post(path, async (request, response) => {
-            const [kind, ret] = await slot(request.body, request.headers)
+            // CBOR is only understood for methods with codecs, and is sent
+            // back to clients that ask for it
+            const cbor = codec !== undefined && request.accepts(["application/json", "application/cbor"]) === "application/cbor"
+            const send = (status: number, value: any, codec: Codec | undefined) => {
+                if (cbor) {
+                    response.status(status).type("application/cbor").send(Buffer.from(encodeCBOR(codec !== undefined ? codec.encode(value) : toData(value))))
+                } else {
+                    response.status(status).json(value)
+                }
+            }
+
+            let content = request.body
+            if (codec !== undefined && request.is("application/cbor") === "application/cbor") {
+                try {
+                    content = codec.arguments.decode(decodeCBOR(new Uint8Array(request.body)), "")
+                } catch (err) {
+                    const problem: Problem = err instanceof DecodeError ? { path: err.path, message: err.problem } : { path: "", message: String(err) }
+                    send(400, { error: "bad request", problems: [problem] }, undefined)
+                    return
+                }
+            }
+
+            const [kind, ret] = await slot(content, request.headers)
 
             if (kind == "error") {
-                response.status(400).json(ret)
+                send(400, ret, codec?.throws)
+            } else if (kind == "bad request") {
+                send(400, { error: "bad request", problems: ret }, undefined)
             } else {
-                response.json(ret)
+                send(200, ret, codec?.returns)
             }
         })
     }
-    bindStream(path: string, slot: (stream: Stream<HTTP.IncomingHttpHeaders>) => void): void {
+    bindStream(path: string, slot: (stream: Stream<HTTP.IncomingHttpHeaders>) => void, codec: StreamCodec | undefined = undefined): void {
         this.websocket.app.ws(path, (ws) => {
-            slot(new WebSocketStream(ws))
+            slot(new WebSocketStream(ws, codec))
         })
     }
 }
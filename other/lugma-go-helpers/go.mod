@@ -0,0 +1,5 @@
+module github.com/pontaoski/lugma/other/lugma-go-helpers
+
+go 1.18
+
+require github.com/gorilla/websocket v1.5.0
@@ -0,0 +1,184 @@
+package lugma
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/websocket"
+)
+
+// HTTPTransport makes requests with a POST to each function's path, and
+// opens streams as WebSockets. The extra value holds headers to send along.
+type HTTPTransport struct {
+	BaseURL *url.URL
+	Client  *http.Client
+	Dialer  *websocket.Dialer
+}
+
+var _ Transport[http.Header] = &HTTPTransport{}
+
+func NewHTTPTransport(baseURL string) (*HTTPTransport, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HTTPTransport{u, http.DefaultClient, websocket.DefaultDialer}, nil
+}
+
+// resolve returns the URL of endpoint, which is under the whole path of the
+// base URL, even if it doesn't end in a slash.
+func (h *HTTPTransport) resolve(endpoint string) *url.URL {
+	base := *h.BaseURL
+	if !strings.HasSuffix(base.Path, "/") {
+		base.Path += "/"
+		if base.RawPath != "" {
+			base.RawPath += "/"
+		}
+	}
+	return base.ResolveReference(&url.URL{Path: endpoint})
+}
+
+func (h *HTTPTransport) MakeRequest(ctx context.Context, endpoint string, body interface{}, extra http.Header) (json.RawMessage, bool, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.resolve(endpoint).String(), bytes.NewReader(data))
+	if err != nil {
+		return nil, false, err
+	}
+	for key, values := range extra {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := h.Client.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return content, false, nil
+	case http.StatusBadRequest:
+		return content, true, nil
+	default:
+		return nil, false, fmt.Errorf("%s: %s", resp.Status, content)
+	}
+}
+
+func (h *HTTPTransport) OpenStream(ctx context.Context, endpoint string, extra http.Header) (Stream, error) {
+	u := h.resolve(endpoint)
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	default:
+		u.Scheme = "ws"
+	}
+
+	conn, _, err := h.Dialer.DialContext(ctx, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := map[string]string{}
+	for key := range extra {
+		headers[key] = extra.Get(key)
+	}
+
+	return NewWebSocketStream(conn, headers)
+}
+
+// HTTPServerTransport serves functions as POST endpoints and streams as
+// WebSockets. Methods receive the request headers as their extra value, and
+// streams the headers sent in their initial payload.
+type HTTPServerTransport struct {
+	Upgrader websocket.Upgrader
+	// OnError, if it's set, is called with the errors methods return that
+	// are neither thrown values nor bad requests. Clients are only told that
+	// there was an internal error, so this is where they can be logged.
+	OnError func(r *http.Request, err error)
+
+	mux *http.ServeMux
+}
+
+// internalError is what's sent for errors that are neither thrown values nor
+// bad requests, which keeps what they say from clients.
+var internalError = struct {
+	Error string `json:"error"`
+}{"internal error"}
+
+var _ ServerTransport[http.Header] = &HTTPServerTransport{}
+var _ http.Handler = &HTTPServerTransport{}
+
+func NewHTTPServerTransport() *HTTPServerTransport {
+	return &HTTPServerTransport{mux: http.NewServeMux()}
+}
+
+func (h *HTTPServerTransport) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *HTTPServerTransport) BindMethod(path string, slot func(ctx context.Context, content json.RawMessage, extra http.Header) (interface{}, error)) {
+	h.mux.HandleFunc("/"+path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var ret interface{}
+		content, err := io.ReadAll(r.Body)
+		if err != nil {
+			err = &BadRequest{Err: err}
+		} else {
+			ret, err = slot(r.Context(), content, r.Header)
+		}
+
+		var thrown interface{ ThrownValue() interface{} }
+		var bad *BadRequest
+		status := http.StatusOK
+		switch {
+		case errors.As(err, &thrown):
+			ret, status = thrown.ThrownValue(), http.StatusBadRequest
+		case errors.As(err, &bad):
+			ret, status = bad.body(), http.StatusBadRequest
+		case err != nil:
+			if h.OnError != nil {
+				h.OnError(r, err)
+			}
+			ret, status = internalError, http.StatusInternalServerError
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(ret)
+	})
+}
+
+func (h *HTTPServerTransport) BindStream(path string, slot func(stream ServerStream[http.Header])) {
+	h.mux.HandleFunc("/"+path, func(w http.ResponseWriter, r *http.Request) {
+		conn, err := h.Upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		stream := NewWebSocketServerStream(conn)
+		slot(stream)
+		stream.Run()
+	})
+}
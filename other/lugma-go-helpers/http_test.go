@@ -0,0 +1,141 @@
+package lugma
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type failure struct {
+	Error    string `json:"error"`
+	Problems int    `json:"problems"`
+}
+
+func TestBindMethod(t *testing.T) {
+	server := NewHTTPServerTransport()
+	server.BindMethod("Echo", func(ctx context.Context, content json.RawMessage, extra http.Header) (interface{}, error) {
+		var args struct {
+			Say  string `json:"say"`
+			Fail bool   `json:"fail"`
+		}
+		if err := json.Unmarshal(content, &args); err != nil {
+			return nil, &BadRequest{Err: err}
+		}
+		if args.Fail {
+			return nil, &Thrown[failure]{failure{"bad request", 1}}
+		}
+		return args.Say, nil
+	})
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	client, err := NewHTTPTransport(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	call := func(body interface{}) (string, error) {
+		return Call[http.Header, string, failure](context.Background(), client, "Echo", body, nil)
+	}
+
+	ret, err := call(map[string]interface{}{"say": "hi"})
+	if err != nil || ret != "hi" {
+		t.Errorf("got %q, %v, want the argument echoed", ret, err)
+	}
+
+	_, err = call(map[string]interface{}{"fail": true})
+	var thrown *Thrown[failure]
+	if !errors.As(err, &thrown) || thrown.Value != (failure{"bad request", 1}) {
+		t.Errorf("got %v, want the thrown value", err)
+	}
+
+	_, err = call(map[string]interface{}{"say": 1})
+	var bad *BadRequest
+	if !errors.As(err, &bad) {
+		t.Fatalf("got %v, want a bad request", err)
+	}
+	if len(bad.Problems) != 1 || bad.Problems[0].Path != "" || bad.Problems[0].Message == "" {
+		t.Errorf("got problems %+v, want the decoding error", bad.Problems)
+	}
+}
+
+func TestBadRequestBody(t *testing.T) {
+	data, err := json.Marshal((&BadRequest{Problems: []Problem{{"name", "is required"}}}).body())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"error":"bad request","problems":[{"path":"name","message":"is required"}]}`
+	if string(data) != want {
+		t.Errorf("got %s, want %s", data, want)
+	}
+
+	bad, ok := asBadRequest(data)
+	if !ok || bad.Error() != "bad request: name: is required" {
+		t.Errorf("got %v, %v, want the problems back", bad, ok)
+	}
+	if _, ok := asBadRequest(json.RawMessage(`"bad request"`)); ok {
+		t.Error("a thrown string was taken for a bad request")
+	}
+}
+
+func TestInternalError(t *testing.T) {
+	var logged error
+	server := NewHTTPServerTransport()
+	server.OnError = func(r *http.Request, err error) {
+		logged = err
+	}
+	server.BindMethod("Fail", func(ctx context.Context, content json.RawMessage, extra http.Header) (interface{}, error) {
+		return nil, errors.New("open /srv/db/users.db: permission denied")
+	})
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	resp, err := http.Post(ts.URL+"/Fail", "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("got status %d, want %d", resp.StatusCode, http.StatusInternalServerError)
+	}
+	if want := `{"error":"internal error"}`; strings.TrimSpace(string(body)) != want {
+		t.Errorf("got body %s, want %s", body, want)
+	}
+	if logged == nil || !strings.Contains(logged.Error(), "permission denied") {
+		t.Errorf("got %v passed to OnError, want the method's error", logged)
+	}
+}
+
+func TestBaseURLWithPath(t *testing.T) {
+	server := NewHTTPServerTransport()
+	server.BindMethod("Mod/Echo", func(ctx context.Context, content json.RawMessage, extra http.Header) (interface{}, error) {
+		return "echoed", nil
+	})
+	mux := http.NewServeMux()
+	mux.Handle("/api/", http.StripPrefix("/api", server))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	for _, base := range []string{ts.URL + "/api", ts.URL + "/api/"} {
+		client, err := NewHTTPTransport(base)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := client.resolve("Mod/Echo").String(), ts.URL+"/api/Mod/Echo"; got != want {
+			t.Errorf("resolved against %s to %s, want %s", base, got, want)
+		}
+		ret, err := Call[http.Header, string, failure](context.Background(), client, "Mod/Echo", map[string]interface{}{}, nil)
+		if err != nil || ret != "echoed" {
+			t.Errorf("got %q, %v from %s, want the method called", ret, err, base)
+		}
+	}
+}
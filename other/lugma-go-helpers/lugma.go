@@ -0,0 +1,324 @@
+// Package lugma is the runtime for Go code generated by lugmac.
+package lugma
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Transport carries a client's requests to a server.
+type Transport[T any] interface {
+	// MakeRequest sends body to endpoint, returning the encoded response. If
+	// thrown is true, content is the encoded value thrown by the function
+	// instead of its result.
+	MakeRequest(ctx context.Context, endpoint string, body interface{}, extra T) (content json.RawMessage, thrown bool, err error)
+	// OpenStream opens the stream at endpoint.
+	OpenStream(ctx context.Context, endpoint string, extra T) (Stream, error)
+}
+
+// Stream is a client's end of an open stream.
+type Stream interface {
+	// On registers callback for every occurrence of event, returning an ID
+	// that can be passed to Unon.
+	On(event string, callback func(content json.RawMessage)) int
+	// OnClose registers callback to be called when the stream closes.
+	OnClose(callback func()) int
+	// Unon unregisters the callback with the given ID.
+	Unon(id int)
+	// Send sends signal to the server.
+	Send(signal string, content interface{}) error
+	// Close closes the stream.
+	Close() error
+}
+
+// ServerTransport exposes a server's functions and streams to clients.
+type ServerTransport[T any] interface {
+	// BindMethod routes requests for path to slot. Errors returned by slot
+	// that carry a ThrownValue are sent to the caller as thrown values.
+	BindMethod(path string, slot func(ctx context.Context, content json.RawMessage, extra T) (interface{}, error))
+	// BindStream calls slot with every stream opened at path.
+	BindStream(path string, slot func(stream ServerStream[T]))
+}
+
+// ServerStream is a server's end of an open stream.
+type ServerStream[T any] interface {
+	// On registers callback for every occurrence of signal, returning an ID
+	// that can be passed to Unon.
+	On(signal string, callback func(content json.RawMessage)) int
+	// OnOpen registers callback to be called with the stream's initial
+	// payload.
+	OnOpen(callback func(initialPayload T)) int
+	// OnClose registers callback to be called when the stream closes.
+	OnClose(callback func()) int
+	// Unon unregisters the callback with the given ID.
+	Unon(id int)
+	// Send sends event to the client.
+	Send(event string, content interface{}) error
+}
+
+// Thrown is the error carrying a value thrown by a function.
+type Thrown[E any] struct {
+	Value E
+}
+
+func (t *Thrown[E]) Error() string {
+	return fmt.Sprintf("thrown: %v", t.Value)
+}
+
+// ThrownValue returns the thrown value, to be sent to the caller.
+func (t *Thrown[E]) ThrownValue() interface{} {
+	return t.Value
+}
+
+// Problem is a problem with a request's content, and where in it the problem
+// is.
+type Problem struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// BadRequest is returned when a request's content can't be decoded. Servers
+// send it as {"error": "bad request", "problems": [...]}, which clients tell
+// apart from thrown values by its error.
+type BadRequest struct {
+	Err      error
+	Problems []Problem
+}
+
+func (b *BadRequest) Error() string {
+	if b.Err != nil {
+		return "bad request: " + b.Err.Error()
+	}
+	msgs := []string{}
+	for _, problem := range b.Problems {
+		if problem.Path == "" {
+			msgs = append(msgs, problem.Message)
+		} else {
+			msgs = append(msgs, problem.Path+": "+problem.Message)
+		}
+	}
+	return "bad request: " + strings.Join(msgs, "; ")
+}
+
+func (b *BadRequest) Unwrap() error {
+	return b.Err
+}
+
+// badRequestBody is how a BadRequest is sent.
+type badRequestBody struct {
+	Error    string    `json:"error"`
+	Problems []Problem `json:"problems"`
+}
+
+const badRequestError = "bad request"
+
+func (b *BadRequest) body() badRequestBody {
+	problems := b.Problems
+	if len(problems) == 0 && b.Err != nil {
+		problems = []Problem{{Path: "", Message: b.Err.Error()}}
+	}
+	if problems == nil {
+		problems = []Problem{}
+	}
+	return badRequestBody{badRequestError, problems}
+}
+
+// asBadRequest returns the BadRequest sent as content, if it is one.
+func asBadRequest(content json.RawMessage) (*BadRequest, bool) {
+	var body badRequestBody
+	if json.Unmarshal(content, &body) != nil || body.Error != badRequestError || body.Problems == nil {
+		return nil, false
+	}
+	return &BadRequest{Problems: body.Problems}, true
+}
+
+// Call makes a request through transport, decoding its result as R and any
+// thrown value as a *Thrown[E]. If the server couldn't make sense of the
+// request, the error is a *BadRequest.
+func Call[T, R, E any](ctx context.Context, transport Transport[T], endpoint string, body interface{}, extra T) (R, error) {
+	var ret R
+
+	content, thrown, err := transport.MakeRequest(ctx, endpoint, body, extra)
+	if err != nil {
+		return ret, err
+	}
+	if thrown {
+		if bad, ok := asBadRequest(content); ok {
+			return ret, bad
+		}
+		var value E
+		if err := json.Unmarshal(content, &value); err != nil {
+			return ret, err
+		}
+		return ret, &Thrown[E]{value}
+	}
+
+	if len(content) > 0 {
+		err = json.Unmarshal(content, &ret)
+	}
+	return ret, err
+}
+
+// Int64 is a 64-bit signed integer, encoded as a decimal string so that
+// JavaScript peers don't lose precision.
+type Int64 int64
+
+func (i Int64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatInt(int64(i), 10))
+}
+
+func (i *Int64) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*i = Int64(v)
+	return nil
+}
+
+// UInt64 is a 64-bit unsigned integer, encoded as a decimal string so that
+// JavaScript peers don't lose precision.
+type UInt64 uint64
+
+func (i UInt64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatUint(uint64(i), 10))
+}
+
+func (i *UInt64) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*i = UInt64(v)
+	return nil
+}
+
+// Duration is a span of time, encoded as a decimal number of seconds followed
+// by "s", such as "-1.5s".
+type Duration time.Duration
+
+func (d Duration) MarshalText() ([]byte, error) {
+	abs := uint64(d)
+	sign := ""
+	if d < 0 {
+		abs = -abs
+		sign = "-"
+	}
+
+	secs, nanos := abs/uint64(time.Second), abs%uint64(time.Second)
+	if nanos == 0 {
+		return []byte(fmt.Sprintf("%s%ds", sign, secs)), nil
+	}
+	frac := strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")
+	return []byte(fmt.Sprintf("%s%d.%ss", sign, secs, frac)), nil
+}
+
+func (d *Duration) UnmarshalText(data []byte) error {
+	s := string(data)
+	bad := fmt.Errorf("%q isn't a duration in seconds", s)
+
+	if !strings.HasSuffix(s, "s") {
+		return bad
+	}
+	s = strings.TrimSuffix(s, "s")
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	whole, frac, dotted := strings.Cut(s, ".")
+	if whole == "" || (dotted && frac == "") || len(frac) > 9 || strings.ContainsAny(whole+frac, "+-") {
+		return bad
+	}
+	secs, err := strconv.ParseUint(whole, 10, 64)
+	if err != nil {
+		return bad
+	}
+	var nanos uint64
+	if frac != "" {
+		nanos, err = strconv.ParseUint(frac+strings.Repeat("0", 9-len(frac)), 10, 64)
+		if err != nil {
+			return bad
+		}
+	}
+
+	if secs > (1<<63)/uint64(time.Second) {
+		return fmt.Errorf("%q is too long a duration", string(data))
+	}
+	abs := secs*uint64(time.Second) + nanos
+	if abs > 1<<63 || (abs == 1<<63 && !negative) {
+		return fmt.Errorf("%q is too long a duration", string(data))
+	}
+	if negative {
+		*d = Duration(-abs)
+	} else {
+		*d = Duration(abs)
+	}
+	return nil
+}
+
+// BytesKey holds bytes used as a dictionary key, which slices can't be,
+// encoded as base64 the way other bytes are.
+type BytesKey struct {
+	data string
+}
+
+// NewBytesKey returns the key holding b.
+func NewBytesKey(b []byte) BytesKey {
+	return BytesKey{string(b)}
+}
+
+// Bytes returns the bytes k holds.
+func (k BytesKey) Bytes() []byte {
+	return []byte(k.data)
+}
+
+func (k BytesKey) MarshalText() ([]byte, error) {
+	return []byte(base64.StdEncoding.EncodeToString([]byte(k.data))), nil
+}
+
+func (k *BytesKey) UnmarshalText(data []byte) error {
+	b, err := base64.StdEncoding.DecodeString(string(data))
+	if err != nil {
+		return fmt.Errorf("%q isn't base64", string(data))
+	}
+	k.data = string(b)
+	return nil
+}
+
+// UUID is a universally unique identifier, encoded as a string of its
+// hyphenated hex digits.
+type UUID [16]byte
+
+func (u UUID) String() string {
+	h := hex.EncodeToString(u[:])
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:32]
+}
+
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+func (u *UUID) UnmarshalText(data []byte) error {
+	s := string(data)
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return fmt.Errorf("%q isn't a UUID", s)
+	}
+	_, err := hex.Decode(u[:], []byte(s[0:8]+s[9:13]+s[14:18]+s[19:23]+s[24:36]))
+	if err != nil {
+		return fmt.Errorf("%q isn't a UUID", s)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package lugma
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBytesKey(t *testing.T) {
+	dict := map[BytesKey]int{NewBytesKey([]byte{0xff, 0x00}): 1}
+	data, err := json.Marshal(dict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"/wA=":1}` {
+		t.Errorf("got %s, want the key in base64", data)
+	}
+
+	var back map[BytesKey]int
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatal(err)
+	}
+	if back[NewBytesKey([]byte{0xff, 0x00})] != 1 {
+		t.Errorf("got %v, want the key back", back)
+	}
+	if err := json.Unmarshal([]byte(`{"not base64!":1}`), &back); err == nil {
+		t.Error("a key that isn't base64 was accepted")
+	}
+}
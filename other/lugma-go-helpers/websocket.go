@@ -0,0 +1,207 @@
+package lugma
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// message is the frame carrying events and signals over a WebSocket.
+type message struct {
+	Type    string          `json:"type"`
+	Content json.RawMessage `json:"content"`
+}
+
+const (
+	onClosed  = "on closed"
+	onInitial = "on initial"
+)
+
+// callbacks keeps the callbacks registered on a stream, keyed by the event
+// or signal they're waiting for.
+type callbacks struct {
+	mu sync.Mutex
+
+	next     int
+	byID     map[int]func(json.RawMessage)
+	byName   map[string]map[int]struct{}
+	namesFor map[int]string
+}
+
+func (c *callbacks) on(name string, callback func(json.RawMessage)) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.byID == nil {
+		c.byID = map[int]func(json.RawMessage){}
+		c.byName = map[string]map[int]struct{}{}
+		c.namesFor = map[int]string{}
+	}
+
+	c.next++
+	c.byID[c.next] = callback
+	if c.byName[name] == nil {
+		c.byName[name] = map[int]struct{}{}
+	}
+	c.byName[name][c.next] = struct{}{}
+	c.namesFor[c.next] = name
+
+	return c.next
+}
+
+func (c *callbacks) unon(id int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	name, ok := c.namesFor[id]
+	if !ok {
+		return
+	}
+	delete(c.byID, id)
+	delete(c.namesFor, id)
+	delete(c.byName[name], id)
+}
+
+func (c *callbacks) dispatch(name string, content json.RawMessage) {
+	c.mu.Lock()
+	var toCall []func(json.RawMessage)
+	for id := range c.byName[name] {
+		toCall = append(toCall, c.byID[id])
+	}
+	c.mu.Unlock()
+
+	for _, callback := range toCall {
+		callback(content)
+	}
+}
+
+// socket is the half of a stream shared by clients and servers.
+type socket struct {
+	conn      *websocket.Conn
+	callbacks callbacks
+
+	writeMu sync.Mutex
+}
+
+func (s *socket) Unon(id int) {
+	s.callbacks.unon(id)
+}
+
+func (s *socket) OnClose(callback func()) int {
+	return s.callbacks.on(onClosed, func(json.RawMessage) { callback() })
+}
+
+func (s *socket) On(name string, callback func(content json.RawMessage)) int {
+	return s.callbacks.on(name, callback)
+}
+
+func (s *socket) Send(name string, content interface{}) error {
+	data, err := json.Marshal(content)
+	if err != nil {
+		return err
+	}
+
+	return s.write(message{name, data})
+}
+
+func (s *socket) write(v interface{}) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	return s.conn.WriteJSON(v)
+}
+
+func (s *socket) Close() error {
+	return s.conn.Close()
+}
+
+// run reads frames until the connection closes, calling handle with the
+// content of every text frame.
+func (s *socket) run(handle func(data []byte)) {
+	defer s.callbacks.dispatch(onClosed, nil)
+
+	for {
+		kind, data, err := s.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if kind != websocket.TextMessage {
+			continue
+		}
+		handle(data)
+	}
+}
+
+func (s *socket) handleMessage(data []byte) {
+	var msg message
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return
+	}
+	s.callbacks.dispatch(msg.Type, msg.Content)
+}
+
+// WebSocketStream is a client's end of a stream carried over a WebSocket.
+type WebSocketStream struct {
+	socket
+}
+
+var _ Stream = &WebSocketStream{}
+
+// NewWebSocketStream starts a stream over conn, sending initialPayload
+// before anything else.
+func NewWebSocketStream(conn *websocket.Conn, initialPayload interface{}) (*WebSocketStream, error) {
+	s := &WebSocketStream{socket{conn: conn}}
+
+	err := s.write(initialPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	go s.run(s.handleMessage)
+
+	return s, nil
+}
+
+// WebSocketServerStream is a server's end of a stream carried over a
+// WebSocket. Its initial payload holds the headers sent by the client.
+type WebSocketServerStream struct {
+	socket
+
+	initialPayload http.Header
+}
+
+var _ ServerStream[http.Header] = &WebSocketServerStream{}
+
+// NewWebSocketServerStream wraps conn. Callbacks are only called once Run
+// has been called.
+func NewWebSocketServerStream(conn *websocket.Conn) *WebSocketServerStream {
+	return &WebSocketServerStream{socket: socket{conn: conn}}
+}
+
+func (s *WebSocketServerStream) OnOpen(callback func(initialPayload http.Header)) int {
+	return s.callbacks.on(onInitial, func(json.RawMessage) { callback(s.initialPayload) })
+}
+
+// Run reads from the stream until it closes. The first frame is the
+// initial payload, and every one after it a signal.
+func (s *WebSocketServerStream) Run() {
+	s.run(func(data []byte) {
+		if s.initialPayload != nil {
+			s.handleMessage(data)
+			return
+		}
+
+		var headers map[string]string
+		if err := json.Unmarshal(data, &headers); err != nil {
+			s.Close()
+			return
+		}
+		s.initialPayload = http.Header{}
+		for key, value := range headers {
+			s.initialPayload.Set(key, value)
+		}
+		s.callbacks.dispatch(onInitial, nil)
+	})
+}
@@ -1,4 +1,4 @@
-use super::{Stream, Transport, StreamError, TransportError};
+use super::{Problem, Stream, Transport, StreamError, TransportError};
 use async_trait::async_trait;
 
 pub struct WebsocketStream {
@@ -118,7 +118,11 @@ impl Transport for HTTPSTransport {
             let resp: Out = serde_json::from_str(&txt).map_err(TransportError::SerdeError)?;
             return Ok(Ok(resp));
         } else {
-            let resp: Error = serde_json::from_str(&txt).map_err(TransportError::SerdeError)?;
+            let value: serde_json::Value = serde_json::from_str(&txt).map_err(TransportError::SerdeError)?;
+            if let Some(problems) = bad_request_problems(&value) {
+                return Err(TransportError::BadRequest(problems));
+            }
+            let resp: Error = serde_json::from_value(value).map_err(TransportError::SerdeError)?;
             return Ok(Err(resp));
         }
     }
@@ -139,3 +143,16 @@ impl Transport for HTTPSTransport {
         return Ok(WebsocketStream::init(socket));
     }
 }
+
+// bad requests are sent as {"error": "bad request", "problems": [...]}, which
+// sets them apart from thrown values
+fn bad_request_problems(value: &serde_json::Value) -> Option<Vec<Problem>> {
+    if value["error"].as_str() != Some("bad request") {
+        return None;
+    }
+    let problems = value["problems"].as_array()?;
+    Some(problems.iter().map(|problem| Problem {
+        path: problem["path"].as_str().unwrap_or_default().to_string(),
+        message: problem["message"].as_str().unwrap_or_default().to_string(),
+    }).collect())
+}
@@ -3,6 +3,13 @@ use async_trait::async_trait;
 #[cfg(feature = "http_impl")]
 pub mod http;
 
+// generated flagsets are declared with bitflags, which is re-exported so
+// that crates using them don't have to depend on the same version
+pub use bitflags;
+
+mod wire;
+pub use wire::{Bytes, Duration, Int64, Timestamp, UInt64, UUID};
+
 pub enum StreamError<T> {
     SelfError(T),
     SerdeError(serde_json::Error),
@@ -19,6 +26,15 @@ pub trait Stream {
 pub enum TransportError<T> {
     SelfError(T),
     SerdeError(serde_json::Error),
+    /// The server couldn't make sense of the request, for these problems.
+    BadRequest(Vec<Problem>),
+}
+
+/// A problem with a request's content, and where in it the problem is.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct Problem {
+    pub path: String,
+    pub message: String,
 }
 
 #[async_trait]
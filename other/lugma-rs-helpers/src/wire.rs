@@ -0,0 +1,404 @@
+use serde::{Deserialize, Deserializer, Serialize, Serializer};
+
+/// A 64-bit signed integer, carried as a decimal string so that JavaScript
+/// peers don't lose precision.
+#[derive(Debug, Default, Clone, Copy, PartialEq, Eq, PartialOrd, Ord, Hash)]
+pub struct Int64(pub i64);
+
+impl Serialize for Int64 {
+    fn serialize<S: Serializer>(&self, serializer: S) -> Result<S::Ok, S::Error> {
+        serializer.collect_str(&self.0)
+    }
+}
+
+impl<'de> Deserialize<'de> for Int64 {
+    fn deserialize<D: Deserializer<'de>>(deserializer: D) -> Result<Self, D::Error> {
+        let it = String::deserialize(deserializer)?;
+        it.parse().map(Int64).map_err(serde::de::Error::custom)
+    }
+}
+
+/// A 64-bit unsigned integer, carried as a decimal string so that JavaScript
+/// peers don't lose precision.
+#[derive(Debug, Default, Clone, Copy, PartialEq, Eq, PartialOrd, Ord, Hash)]
+pub struct UInt64(pub u64);
+
+impl Serialize for UInt64 {
+    fn serialize<S: Serializer>(&self, serializer: S) -> Result<S::Ok, S::Error> {
+        serializer.collect_str(&self.0)
+    }
+}
+
+impl<'de> Deserialize<'de> for UInt64 {
+    fn deserialize<D: Deserializer<'de>>(deserializer: D) -> Result<Self, D::Error> {
+        let it = String::deserialize(deserializer)?;
+        it.parse().map(UInt64).map_err(serde::de::Error::custom)
+    }
+}
+
+/// Binary data, carried as a base64 string.
+#[derive(Debug, Default, Clone, PartialEq, Eq, PartialOrd, Ord, Hash)]
+pub struct Bytes(pub Vec<u8>);
+
+impl Serialize for Bytes {
+    fn serialize<S: Serializer>(&self, serializer: S) -> Result<S::Ok, S::Error> {
+        serializer.serialize_str(&base64::encode(&self.0))
+    }
+}
+
+impl<'de> Deserialize<'de> for Bytes {
+    fn deserialize<D: Deserializer<'de>>(deserializer: D) -> Result<Self, D::Error> {
+        let it = String::deserialize(deserializer)?;
+        base64::decode(it).map(Bytes).map_err(serde::de::Error::custom)
+    }
+}
+
+/// A point in time, counted from the Unix epoch and carried as an RFC 3339
+/// string.
+#[derive(Debug, Default, Clone, Copy, PartialEq, Eq, PartialOrd, Ord, Hash)]
+pub struct Timestamp {
+    pub seconds: i64,
+    /// Always less than a billion.
+    pub nanos: u32,
+}
+
+// days_from_civil and civil_from_days convert between dates and days since
+// the Unix epoch in the proleptic Gregorian calendar.
+fn days_from_civil(y: i64, m: i64, d: i64) -> i64 {
+    let y = if m <= 2 { y - 1 } else { y };
+    let era = if y >= 0 { y } else { y - 399 } / 400;
+    let yoe = y - era * 400;
+    let doy = (153 * (if m > 2 { m - 3 } else { m + 9 }) + 2) / 5 + d - 1;
+    let doe = yoe * 365 + yoe / 4 - yoe / 100 + doy;
+    era * 146097 + doe - 719468
+}
+
+fn civil_from_days(z: i64) -> (i64, i64, i64) {
+    let z = z + 719468;
+    let era = if z >= 0 { z } else { z - 146096 } / 146097;
+    let doe = z - era * 146097;
+    let yoe = (doe - doe / 1460 + doe / 36524 - doe / 146096) / 365;
+    let doy = doe - (365 * yoe + yoe / 4 - yoe / 100);
+    let mp = (5 * doy + 2) / 153;
+    let d = doy - (153 * mp + 2) / 5 + 1;
+    let m = if mp < 10 { mp + 3 } else { mp - 9 };
+    (if m <= 2 { yoe + era * 400 + 1 } else { yoe + era * 400 }, m, d)
+}
+
+/// Parses up to nine digits after a decimal point as nanoseconds.
+fn parse_nanos(frac: &str) -> Option<u32> {
+    if frac.is_empty() || frac.len() > 9 || !frac.bytes().all(|b| b.is_ascii_digit()) {
+        return None;
+    }
+    format!("{:0<9}", frac).parse().ok()
+}
+
+fn format_nanos(nanos: u32) -> String {
+    if nanos == 0 {
+        return String::new();
+    }
+    format!(".{:09}", nanos).trim_end_matches('0').to_string()
+}
+
+/// Parses a UTC offset written as +hh:mm or -hh:mm as seconds.
+fn parse_offset(s: &str) -> Option<i64> {
+    let b = s.as_bytes();
+    if b.len() != 6 || b[3] != b':' || ![1, 2, 4, 5].iter().all(|&i| b[i].is_ascii_digit()) {
+        return None;
+    }
+    let sign = match b[0] {
+        b'+' => 1,
+        b'-' => -1,
+        _ => return None,
+    };
+    let (h, m) = ((b[1] - b'0') as i64 * 10 + (b[2] - b'0') as i64, (b[4] - b'0') as i64 * 10 + (b[5] - b'0') as i64);
+    if h > 23 || m > 59 {
+        return None;
+    }
+    Some(sign * (h * 3600 + m * 60))
+}
+
+impl Timestamp {
+    fn parse(s: &str) -> Option<Timestamp> {
+        let number = |from: usize, to: usize| -> Option<i64> {
+            let it = s.get(from..to)?;
+            if !it.bytes().all(|b| b.is_ascii_digit()) {
+                return None;
+            }
+            it.parse().ok()
+        };
+        let sep = |at: usize, chars: &[u8]| s.as_bytes().get(at).map_or(false, |c| chars.contains(c));
+
+        if !(sep(4, b"-") && sep(7, b"-") && sep(10, b"Tt") && sep(13, b":") && sep(16, b":")) {
+            return None;
+        }
+        let (year, month, day) = (number(0, 4)?, number(5, 7)?, number(8, 10)?);
+        let (hour, minute, second) = (number(11, 13)?, number(14, 16)?, number(17, 19)?);
+        if !(1..=12).contains(&month) || !(1..=31).contains(&day) || hour > 23 || minute > 59 || second > 60 {
+            return None;
+        }
+
+        let mut rest = &s[19..];
+        let mut nanos = 0;
+        if let Some(after) = rest.strip_prefix('.') {
+            let end = after.find(|c: char| !c.is_ascii_digit()).unwrap_or(after.len());
+            nanos = parse_nanos(&after[..end])?;
+            rest = &after[end..];
+        }
+
+        let offset = match rest {
+            "Z" | "z" => 0,
+            _ => parse_offset(rest)?,
+        };
+
+        let days = days_from_civil(year, month, day);
+        if civil_from_days(days) != (year, month, day) {
+            return None;
+        }
+        Some(Timestamp {
+            seconds: days * 86400 + hour * 3600 + minute * 60 + second - offset,
+            nanos,
+        })
+    }
+}
+
+impl std::fmt::Display for Timestamp {
+    fn fmt(&self, f: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+        let (days, secs) = (self.seconds.div_euclid(86400), self.seconds.rem_euclid(86400));
+        let (year, month, day) = civil_from_days(days);
+        write!(
+            f,
+            "{:04}-{:02}-{:02}T{:02}:{:02}:{:02}{}Z",
+            year,
+            month,
+            day,
+            secs / 3600,
+            secs / 60 % 60,
+            secs % 60,
+            format_nanos(self.nanos)
+        )
+    }
+}
+
+impl Serialize for Timestamp {
+    fn serialize<S: Serializer>(&self, serializer: S) -> Result<S::Ok, S::Error> {
+        serializer.collect_str(self)
+    }
+}
+
+impl<'de> Deserialize<'de> for Timestamp {
+    fn deserialize<D: Deserializer<'de>>(deserializer: D) -> Result<Self, D::Error> {
+        let it = String::deserialize(deserializer)?;
+        Timestamp::parse(&it).ok_or_else(|| serde::de::Error::custom(format!("{:?} isn't an RFC 3339 timestamp", it)))
+    }
+}
+
+/// A span of time, carried as a decimal number of seconds followed by "s".
+#[derive(Debug, Default, Clone, Copy, PartialEq, Eq, PartialOrd, Ord, Hash)]
+pub struct Duration {
+    pub seconds: i64,
+    /// Always less than a billion, and negative only when seconds is zero
+    /// or negative.
+    pub nanos: i32,
+}
+
+impl Duration {
+    fn parse(s: &str) -> Option<Duration> {
+        let s = s.strip_suffix('s')?;
+        let (negative, s) = match s.strip_prefix('-') {
+            Some(s) => (true, s),
+            None => (false, s),
+        };
+        let (whole, frac) = match s.split_once('.') {
+            Some((whole, frac)) => (whole, Some(frac)),
+            None => (s, None),
+        };
+        if whole.is_empty() || !whole.bytes().all(|b| b.is_ascii_digit()) {
+            return None;
+        }
+        let seconds: i64 = whole.parse().ok()?;
+        let nanos = match frac {
+            Some(frac) => parse_nanos(frac)? as i32,
+            None => 0,
+        };
+        Some(if negative {
+            Duration { seconds: -seconds, nanos: -nanos }
+        } else {
+            Duration { seconds, nanos }
+        })
+    }
+}
+
+impl std::fmt::Display for Duration {
+    fn fmt(&self, f: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+        let sign = if self.seconds < 0 || self.nanos < 0 { "-" } else { "" };
+        write!(f, "{}{}{}s", sign, self.seconds.unsigned_abs(), format_nanos(self.nanos.unsigned_abs()))
+    }
+}
+
+impl Serialize for Duration {
+    fn serialize<S: Serializer>(&self, serializer: S) -> Result<S::Ok, S::Error> {
+        serializer.collect_str(self)
+    }
+}
+
+impl<'de> Deserialize<'de> for Duration {
+    fn deserialize<D: Deserializer<'de>>(deserializer: D) -> Result<Self, D::Error> {
+        let it = String::deserialize(deserializer)?;
+        Duration::parse(&it).ok_or_else(|| serde::de::Error::custom(format!("{:?} isn't a duration in seconds", it)))
+    }
+}
+
+/// A universally unique identifier, carried as a string of its hyphenated hex
+/// digits.
+#[derive(Debug, Default, Clone, Copy, PartialEq, Eq, PartialOrd, Ord, Hash)]
+pub struct UUID(pub [u8; 16]);
+
+impl UUID {
+    fn parse(s: &str) -> Option<UUID> {
+        if s.len() != 36 || [8, 13, 18, 23].iter().any(|&i| s.as_bytes()[i] != b'-') {
+            return None;
+        }
+        let digits: Vec<u8> = s.bytes().filter(|&b| b != b'-').collect();
+        if digits.len() != 32 || !digits.iter().all(|b| b.is_ascii_hexdigit()) {
+            return None;
+        }
+        let mut out = [0u8; 16];
+        for (i, pair) in digits.chunks(2).enumerate() {
+            out[i] = u8::from_str_radix(std::str::from_utf8(pair).ok()?, 16).ok()?;
+        }
+        Some(UUID(out))
+    }
+}
+
+impl std::fmt::Display for UUID {
+    fn fmt(&self, f: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+        for (i, b) in self.0.iter().enumerate() {
+            if [4, 6, 8, 10].contains(&i) {
+                write!(f, "-")?;
+            }
+            write!(f, "{:02x}", b)?;
+        }
+        Ok(())
+    }
+}
+
+impl Serialize for UUID {
+    fn serialize<S: Serializer>(&self, serializer: S) -> Result<S::Ok, S::Error> {
+        serializer.collect_str(self)
+    }
+}
+
+impl<'de> Deserialize<'de> for UUID {
+    fn deserialize<D: Deserializer<'de>>(deserializer: D) -> Result<Self, D::Error> {
+        let it = String::deserialize(deserializer)?;
+        UUID::parse(&it).ok_or_else(|| serde::de::Error::custom(format!("{:?} isn't a UUID", it)))
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn timestamp_round_trips() {
+        let cases = [
+            ("1970-01-01T00:00:00Z", 0, 0),
+            ("2022-07-13T12:34:56Z", 1657715696, 0),
+            ("2022-07-13T12:34:56.5Z", 1657715696, 500_000_000),
+            ("2022-07-13T12:34:56.000000001Z", 1657715696, 1),
+            ("1969-12-31T23:59:59.999Z", -1, 999_000_000),
+            ("2000-02-29T00:00:00Z", 951782400, 0),
+            ("0001-01-01T00:00:00Z", -62135596800, 0),
+            ("9999-12-31T23:59:59Z", 253402300799, 0),
+        ];
+        for (text, seconds, nanos) in cases {
+            let parsed = Timestamp::parse(text).unwrap_or_else(|| panic!("{} didn't parse", text));
+            assert_eq!(parsed, Timestamp { seconds, nanos }, "parsing {}", text);
+            assert_eq!(parsed.to_string(), text);
+        }
+    }
+
+    #[test]
+    fn timestamp_offsets_are_applied() {
+        let cases = [
+            ("2022-07-13T14:34:56+02:00", "2022-07-13T12:34:56Z"),
+            ("2022-07-13T07:04:56-05:30", "2022-07-13T12:34:56Z"),
+            ("2022-07-13T12:34:56.25+00:00", "2022-07-13T12:34:56.25Z"),
+            ("2022-07-13t12:34:56z", "2022-07-13T12:34:56Z"),
+            ("2022-12-31T23:59:59-23:59", "2023-01-01T23:58:59Z"),
+        ];
+        for (text, utc) in cases {
+            let parsed = Timestamp::parse(text).unwrap_or_else(|| panic!("{} didn't parse", text));
+            assert_eq!(parsed.to_string(), utc, "parsing {}", text);
+        }
+    }
+
+    #[test]
+    fn timestamp_rejects_invalid() {
+        let cases = [
+            "",
+            "2022-07-13",
+            "2022-07-13 12:34:56Z",
+            "2022-07-13T12:34:56",
+            "2022-13-01T00:00:00Z",
+            "2022-02-30T00:00:00Z",
+            "2021-02-29T00:00:00Z",
+            "2022-07-13T24:00:00Z",
+            "2022-07-13T12:60:00Z",
+            "2022-07-13T12:34:56.Z",
+            "2022-07-13T12:34:56.1234567890Z",
+            "2022-07-13T12:34:56+24:00",
+            "2022-07-13T12:34:56+02:60",
+            "2022-07-13T12:34:56+2:00",
+            "2022-07-13T12:34:56++1:00",
+            "2022-07-13T12:34:56+0200",
+            "2022-07-13T12:34:56+02:00:00",
+            "2022-07-13T12:34:56 02:00",
+            "2022-07-13T12:34:56+é:00",
+            "+022-07-13T12:34:56Z",
+        ];
+        for text in cases {
+            assert_eq!(Timestamp::parse(text), None, "parsing {:?}", text);
+        }
+    }
+
+    #[test]
+    fn duration_round_trips() {
+        let cases = [
+            ("0s", 0, 0),
+            ("1s", 1, 0),
+            ("-1s", -1, 0),
+            ("1.5s", 1, 500_000_000),
+            ("-1.5s", -1, -500_000_000),
+            ("-0.000000001s", 0, -1),
+            ("315576000000.999999999s", 315576000000, 999_999_999),
+        ];
+        for (text, seconds, nanos) in cases {
+            let parsed = Duration::parse(text).unwrap_or_else(|| panic!("{} didn't parse", text));
+            assert_eq!(parsed, Duration { seconds, nanos }, "parsing {}", text);
+            assert_eq!(parsed.to_string(), text);
+        }
+        assert_eq!(Duration::parse("1.50s").unwrap().to_string(), "1.5s");
+    }
+
+    #[test]
+    fn duration_rejects_invalid() {
+        let cases = ["", "s", "1", "1m", "-s", "--1s", "+1s", "1.s", ".5s", "1.0000000001s", "1e3s", " 1s", "99999999999999999999s"];
+        for text in cases {
+            assert_eq!(Duration::parse(text), None, "parsing {:?}", text);
+        }
+    }
+
+    #[test]
+    fn uuid_round_trips() {
+        let text = "01234567-89ab-cdef-0123-456789abcdef";
+        let parsed = UUID::parse(text).unwrap();
+        assert_eq!(parsed.0[0], 0x01);
+        assert_eq!(parsed.0[15], 0xef);
+        assert_eq!(parsed.to_string(), text);
+        for text in ["", "01234567-89ab-cdef-0123-456789abcdeg", "0123456789ab-cdef-0123-456789abcdef0", "01234567-89ab-cdef-0123-456789abcde", "+1234567-89ab-cdef-0123-456789abcdef"] {
+            assert_eq!(UUID::parse(text), None, "parsing {:?}", text);
+        }
+    }
+}
@@ -1,6 +1,13 @@
+import { MethodCodec, StreamCodec } from "lugma-cbor-helpers"
+import { Problem } from "./validation"
+
+export * from "./validation"
+
+// Transports are given codecs for what's sent, which they can use to accept
+// and send it as CBOR when clients ask for it.
 export interface Transport<T> {
-    bindMethod(path: string, slot: (content: any, extra: T) => Promise<Result<any, any>>): void
-    bindStream(path: string, slot: (stream: Stream<T>) => void): void
+    bindMethod(path: string, slot: (content: any, extra: T) => Promise<Result<any, any>>, codec?: MethodCodec): void
+    bindStream(path: string, slot: (stream: Stream<T>) => void, codec?: StreamCodec): void
 }
 
 export interface Stream<T> {
@@ -11,4 +18,10 @@ export interface Stream<T> {
     send(event: string, body: any): void
 }
 
-export type Result<T, Err> = ["ok", T] | ["error", Err]
+// Results are what methods return: either their return value, what they threw,
+// or the problems with a request that wasn't valid for them.
+export type Result<T, Err> = ["ok", T] | ["error", Err] | ["bad request", Problem[]]
+
+export function badRequest(problems: Problem[]): ["bad request", Problem[]] {
+    return ["bad request", problems]
+}
@@ -0,0 +1,190 @@
+// A problem with a value received over the wire, and where in it the problem is.
+export interface Problem {
+    path: string
+    message: string
+}
+
+// Validators add any problems with a value at path to problems.
+export type Validator = (value: any, path: string, problems: Problem[]) => void
+
+export function pathTo(path: string, key: string | number): string {
+    if (typeof key === "number") {
+        return `${path}[${key}]`
+    }
+    return path === "" ? key : `${path}.${key}`
+}
+
+function isMissing(value: any): boolean {
+    return value === undefined || value === null
+}
+
+function primitive(description: string, test: (value: any) => boolean): Validator {
+    return (value, path, problems) => {
+        if (isMissing(value)) {
+            problems.push({ path, message: "is required" })
+        } else if (!test(value)) {
+            problems.push({ path, message: `should be ${description}` })
+        }
+    }
+}
+
+function integer(min: number, max: number): (value: any) => boolean {
+    return (value) => typeof value === "number" && Number.isInteger(value) && value >= min && value <= max
+}
+
+// compareDecimal compares two decimal integers written as strings without
+// losing precision.
+function compareDecimal(a: string, b: string): number {
+    const negativeA = a.startsWith("-"), negativeB = b.startsWith("-")
+    if (negativeA !== negativeB) {
+        return negativeA ? -1 : 1
+    }
+    const digitsA = a.replace(/^-?0*/, ""), digitsB = b.replace(/^-?0*/, "")
+    let cmp = digitsA.length - digitsB.length
+    if (cmp === 0) {
+        cmp = digitsA < digitsB ? -1 : digitsA > digitsB ? 1 : 0
+    }
+    return negativeA ? -cmp : cmp
+}
+
+function decimal(min: string, max: string): (value: any) => boolean {
+    return (value) => typeof value === "string" && /^-?[0-9]+$/.test(value) && compareDecimal(value, min) >= 0 && compareDecimal(value, max) <= 0
+}
+
+export const validateUInt8 = primitive("an integer from 0 to 255", integer(0, 255))
+export const validateUInt16 = primitive("an integer from 0 to 65535", integer(0, 65535))
+export const validateUInt32 = primitive("an integer from 0 to 4294967295", integer(0, 4294967295))
+export const validateUInt64 = primitive("a string of an integer from 0 to 18446744073709551615", decimal("0", "18446744073709551615"))
+export const validateInt8 = primitive("an integer from -128 to 127", integer(-128, 127))
+export const validateInt16 = primitive("an integer from -32768 to 32767", integer(-32768, 32767))
+export const validateInt32 = primitive("an integer from -2147483648 to 2147483647", integer(-2147483648, 2147483647))
+export const validateInt64 = primitive("a string of an integer from -9223372036854775808 to 9223372036854775807", decimal("-9223372036854775808", "9223372036854775807"))
+export const validateFloat32 = primitive("a number in the range of a 32-bit float", (value) => typeof value === "number" && Number.isFinite(value) && Math.abs(value) <= 3.4028234663852886e38)
+export const validateFloat64 = primitive("a number", (value) => typeof value === "number" && Number.isFinite(value))
+export const validateString = primitive("a string", (value) => typeof value === "string")
+export const validateBytes = primitive("a base64 string", (value) => typeof value === "string" && /^[A-Za-z0-9+/]*={0,2}$/.test(value) && value.length % 4 === 0)
+export const validateBool = primitive("a boolean", (value) => typeof value === "boolean")
+export const validateTimestamp = primitive("an RFC 3339 timestamp", isTimestamp)
+export const validateDuration = primitive("a number of seconds followed by \"s\"", (value) => typeof value === "string" && /^-?[0-9]+(\.[0-9]{1,9})?s$/.test(value))
+export const validateUUID = primitive("a UUID", (value) => typeof value === "string" && /^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$/.test(value))
+
+function isTimestamp(value: any): boolean {
+    const match = typeof value === "string" && /^([0-9]{4})-([0-9]{2})-([0-9]{2})[Tt]([0-9]{2}):([0-9]{2}):([0-9]{2})(\.[0-9]{1,9})?([Zz]|[+-]([0-9]{2}):([0-9]{2}))$/.exec(value)
+    if (!match) {
+        return false
+    }
+    const [year, month, day, hour, minute, second] = match.slice(1, 7).map(Number)
+    const daysInMonth = new Date(Date.UTC(year, month, 0)).getUTCDate()
+    return month >= 1 && month <= 12 && day >= 1 && day <= daysInMonth && hour <= 23 && minute <= 59 && second <= 60
+}
+
+export function validateOptional(validator: Validator): Validator {
+    return (value, path, problems) => {
+        if (!isMissing(value)) {
+            validator(value, path, problems)
+        }
+    }
+}
+
+export function validateArray(validator: Validator): Validator {
+    return (value, path, problems) => {
+        if (isMissing(value)) {
+            problems.push({ path, message: "is required" })
+        } else if (!Array.isArray(value)) {
+            problems.push({ path, message: "should be an array" })
+        } else {
+            value.forEach((item, idx) => validator(item, pathTo(path, idx), problems))
+        }
+    }
+}
+
+// validateDictionary checks an object's keys with key, which is given them
+// as they'd be decoded from a string, and its values with value.
+export function validateDictionary(key: Validator, value: Validator, keyOf: (key: string) => any = (key) => key): Validator {
+    return (dict, path, problems) => {
+        if (validateObject(dict, path, problems)) {
+            for (const k of Object.keys(dict)) {
+                key(keyOf(k), pathTo(path, k), problems)
+                value(dict[k], pathTo(path, k), problems)
+            }
+        }
+    }
+}
+
+// numericKey decodes a dictionary key for a type sent as a JSON number.
+export function numericKey(key: string): any {
+    return /^-?[0-9]+$/.test(key) ? Number(key) : key
+}
+
+// booleanKey decodes a dictionary key for a type sent as a JSON boolean.
+export function booleanKey(key: string): any {
+    return key === "true" ? true : key === "false" ? false : key
+}
+
+// validateObject checks that value is an object, returning whether it is.
+export function validateObject(value: any, path: string, problems: Problem[]): boolean {
+    if (isMissing(value)) {
+        problems.push({ path, message: "is required" })
+        return false
+    } else if (typeof value !== "object" || Array.isArray(value)) {
+        problems.push({ path, message: "should be an object" })
+        return false
+    }
+    return true
+}
+
+export function validateField(value: any, name: string, validator: Validator, path: string, problems: Problem[]) {
+    validator(value[name], pathTo(path, name), problems)
+}
+
+export function validateOneOf(cases: string[]): Validator {
+    return primitive(`one of ${cases.map((c) => `"${c}"`).join(", ")}`, (value) => typeof value === "string" && cases.indexOf(value) !== -1)
+}
+
+// validateCases checks a value of an enum with cases that have values,
+// which is an object with one key naming its case.
+export function validateCases(cases: { [name: string]: Validator }): Validator {
+    return (value, path, problems) => {
+        if (!validateObject(value, path, problems)) {
+            return
+        }
+        const keys = Object.keys(value)
+        if (keys.length !== 1 || !Object.prototype.hasOwnProperty.call(cases, keys[0])) {
+            problems.push({ path, message: `should have exactly one of ${Object.keys(cases).map((c) => `"${c}"`).join(", ")}` })
+            return
+        }
+        cases[keys[0]](value[keys[0]], pathTo(path, keys[0]), problems)
+    }
+}
+
+// validateFlags checks a value of a flagset with count flags, which is the
+// decimal string of its bitmask.
+export function validateFlags(count: number): Validator {
+    let max = "1"
+    for (let i = 0; i < count; i++) {
+        max = doubleDecimal(max)
+    }
+    const limit = subtractOne(max)
+    return primitive(`a string of a bitmask of ${count} flags`, decimal("0", limit))
+}
+
+function doubleDecimal(s: string): string {
+    let out = "", carry = 0
+    for (let i = s.length - 1; i >= 0; i--) {
+        const d = Number(s[i]) * 2 + carry
+        out = String(d % 10) + out
+        carry = d >= 10 ? 1 : 0
+    }
+    return carry ? "1" + out : out
+}
+
+function subtractOne(s: string): string {
+    const digits = s.split("").map(Number)
+    let i = digits.length - 1
+    while (digits[i] === 0) {
+        digits[i] = 9
+        i--
+    }
+    digits[i]--
+    return digits.join("").replace(/^0+(?=.)/, "")
+}
@@ -1,6 +1,10 @@
+import { MethodCodec, StreamCodec, decodeCBOR, encodeCBOR, fromData, toData } from "lugma-cbor-helpers"
+
+// Transports are given codecs for what's sent, which they can use to send it
+// as CBOR rather than JSON.
 export interface Transport<T> {
-    makeRequest(endpoint: string, body: any, extra: T | undefined): Promise<any>
-    openStream(endpoint: string, extra: T | undefined): Stream
+    makeRequest(endpoint: string, body: any, extra: T | undefined, codec?: MethodCodec): Promise<any>
+    openStream(endpoint: string, extra: T | undefined, codec?: StreamCodec): Stream
 }
 export interface Stream {
     unon(item: number): void
@@ -15,9 +19,12 @@ export class WebSocketStream implements Stream {
     eventsToNumbers: Map<string, Set<number>>
     numbersToEvents: Map<number, string>
     callbackNumber: number
+    // codec is given if frames are sent as CBOR.
+    codec: StreamCodec | undefined
 
-    constructor(url: URL, initialPayload: any) {
+    constructor(url: URL, initialPayload: any, codec: StreamCodec | undefined = undefined) {
         this.socket = new WebSocket(url)
+        this.codec = codec
         this.socket.binaryType = "arraybuffer"
         this.callbacks = new Map()
         this.eventsToNumbers = new Map()
@@ -25,7 +32,12 @@ export class WebSocketStream implements Stream {
         this.callbackNumber = 0
 
         this.socket.addEventListener("open", () => {
-            this.socket.send(JSON.stringify(initialPayload))
+            // the server sends binary frames if the initial payload is one
+            if (this.codec !== undefined) {
+                this.socket.send(encodeCBOR(toData(initialPayload)))
+            } else {
+                this.socket.send(JSON.stringify(initialPayload))
+            }
         })
         this.socket.addEventListener("close", () => {
             const values = this.eventsToNumbers.get("on closed")?.values()
@@ -37,18 +49,27 @@ export class WebSocketStream implements Stream {
             }
         })
         this.socket.addEventListener("message", (msg) => {
+            let event: string, content: any
             if (msg.data instanceof ArrayBuffer) {
-
+                const item = decodeCBOR(new Uint8Array(msg.data))
+                if (!(item instanceof Map)) {
+                    return
+                }
+                event = String(item.get("type"))
+                const codec = this.codec?.events[event]
+                content = codec !== undefined ? codec.decode(item.get("content"), "") : fromData(item.get("content"))
             } else {
                 const item = JSON.parse(msg.data)
+                event = item["type"]
+                content = item["content"]
+            }
 
-                const values = this.eventsToNumbers.get(item["kind"])?.values()
-                if (values == undefined) {
-                    return
-                }
-                for (let value of values) {
-                    this.callbacks.get(value)?.(item["content"])
-                }
+            const values = this.eventsToNumbers.get(event)?.values()
+            if (values == undefined) {
+                return
+            }
+            for (let value of values) {
+                this.callbacks.get(value)?.(content)
             }
         })
     }
@@ -86,21 +107,38 @@ export class WebSocketStream implements Stream {
         return this.callbackNumber
     }
     send(signal: string, body: any): void {
+        if (this.codec !== undefined) {
+            const codec = this.codec.signals[signal]
+            this.socket.send(encodeCBOR(new Map([
+                ["type", signal],
+                ["content", codec !== undefined ? codec.encode(body) : toData(body)],
+            ])))
+            return
+        }
         this.socket.send(JSON.stringify({
             "type": signal,
             "content": body,
         }))
     }
 }
+// Formats are how an HTTPSTransport sends requests and asks for responses.
+export type Format = "json" | "cbor"
+
 export class HTTPSTransport implements Transport<Headers> {
     baseURL: URL
+    format: Format
 
-    constructor(baseURL: URL) {
+    constructor(baseURL: URL, format: Format = "json") {
         this.baseURL = baseURL
+        this.format = format
     }
-    async makeRequest(endpoint: string, body: any, extra: Headers | undefined = undefined): Promise<any> {
+    async makeRequest(endpoint: string, body: any, extra: Headers | undefined = undefined, codec: MethodCodec | undefined = undefined): Promise<any> {
         const path = new URL(endpoint, this.baseURL)
-        const headers: {[key: string]: string} = {
+        const cbor = this.format === "cbor" && codec !== undefined
+        const headers: {[key: string]: string} = cbor ? {
+            'Content-Type': 'application/cbor',
+            'Accept': 'application/cbor',
+        } : {
             'Content-Type': 'application/json'
         }
         extra?.forEach((val, key) => {
@@ -108,17 +146,31 @@ export class HTTPSTransport implements Transport<Headers> {
         })
         const response = await fetch(path.toString(), {
             method: 'POST',
-            body: JSON.stringify(body),
+            body: cbor ? encodeCBOR(codec.arguments.encode(body)) : JSON.stringify(body),
             headers: headers
         })
-        const json = await response.json()
+        // servers that don't speak CBOR answer in JSON
+        if (codec === undefined || response.headers.get('Content-Type')?.split(';')[0].trim() !== 'application/cbor') {
+            const json = await response.json()
+            if (response.status === 200) {
+                return json
+            } else {
+                throw json
+            }
+        }
+
+        const data = decodeCBOR(new Uint8Array(await response.arrayBuffer()))
         if (response.status === 200) {
-            return json
-        } else {
-            throw json
+            return codec.returns.decode(data, "")
+        }
+        // bad requests aren't what the function throws, so they're decoded
+        // as they come
+        if (data instanceof Map && data.get("error") === "bad request") {
+            throw fromData(data)
         }
+        throw codec.throws.decode(data, "")
     }
-    openStream(endpoint: string, extra: Headers | undefined): Stream {
+    openStream(endpoint: string, extra: Headers | undefined, codec: StreamCodec | undefined = undefined): Stream {
         const path = new URL(endpoint, this.baseURL)
 
         const headers: {[key: string]: string} = {}
@@ -126,6 +178,6 @@ export class HTTPSTransport implements Transport<Headers> {
             headers[key] = val
         })
 
-        return new WebSocketStream(path, headers)
+        return new WebSocketStream(path, headers, this.format === "cbor" ? codec : undefined)
     }
 }
@@ -0,0 +1,294 @@
+// Based on the string, comment, number and identifier rules of
+// tree-sitter-javascript.
+
+const commaSep1 = (rule) => seq(rule, repeat(seq(',', rule)))
+
+const annotations = ($) => field('annotations', repeat($.annotation))
+
+module.exports = grammar({
+  name: 'lugma',
+
+  externals: $ => [
+    $._automatic_semicolon,
+    $._template_chars,
+    $._ternary_qmark,
+  ],
+
+  extras: $ => [
+    $.comment,
+    /[\s\p{Zs}\uFEFF\u2060\u200B]/,
+  ],
+
+  rules: {
+    file: $ => repeat($.statement),
+
+    statement: $ => choice(
+      $.import,
+      $.stream_declaration,
+      $.struct_declaration,
+      $.enum_declaration,
+      $.flagset_declaration,
+      $.typealias_declaration,
+      $.const_declaration,
+      $.func_declaration,
+    ),
+
+    import: $ => seq(
+      'import',
+      field('path', $.string),
+      'as',
+      field('alias', $.identifier),
+      $._semicolon,
+    ),
+
+    stream_declaration: $ => seq(
+      annotations($),
+      'stream',
+      field('name', $.identifier),
+      '{',
+      repeat(choice($.event_declaration, $.signal_declaration)),
+      '}',
+    ),
+
+    struct_declaration: $ => seq(
+      annotations($),
+      'struct',
+      field('name', $.identifier),
+      '{',
+      field('fields', repeat($.field_declaration)),
+      '}',
+    ),
+
+    field_declaration: $ => seq(
+      annotations($),
+      'let',
+      field('name', $.identifier),
+      ':',
+      field('type', $.type),
+      optional(seq('=', field('default', $.literal))),
+    ),
+
+    enum_declaration: $ => seq(
+      annotations($),
+      'enum',
+      field('name', $.identifier),
+      '{',
+      field('cases', repeat($.case_declaration)),
+      '}',
+    ),
+
+    flagset_declaration: $ => seq(
+      annotations($),
+      'flagset',
+      field('name', $.identifier),
+      optional(field('optional', seq(':', $.optional))),
+      '{',
+      field('flags', repeat($.flag_declaration)),
+      '}',
+    ),
+
+    optional: $ => 'optional',
+
+    flag_declaration: $ => seq(
+      annotations($),
+      'flag',
+      field('name', $.identifier),
+    ),
+
+    case_declaration: $ => seq(
+      annotations($),
+      'case',
+      field('name', $.identifier),
+      optional(seq('(', optional(field('values', commaSep1($.arg))), ')')),
+    ),
+
+    typealias_declaration: $ => seq(
+      annotations($),
+      field('kind', choice('typealias', 'newtype')),
+      field('name', $.identifier),
+      '=',
+      field('type', $.type),
+      $._semicolon,
+    ),
+
+    const_declaration: $ => seq(
+      annotations($),
+      'const',
+      field('name', $.identifier),
+      ':',
+      field('type', $.type),
+      '=',
+      field('value', $.literal),
+      $._semicolon,
+    ),
+
+    arg: $ => seq(
+      annotations($),
+      field('name', $.identifier),
+      ':',
+      field('type', $.type),
+      optional(seq('=', field('default', $.literal))),
+    ),
+
+    func_declaration: $ => seq(
+      annotations($),
+      'func',
+      field('name', $.identifier),
+      '(',
+      optional(field('arguments', commaSep1($.arg))),
+      ')',
+      optional(seq('throws', field('throws', $.type))),
+      optional(seq('->', field('returns', $.type))),
+      $._semicolon,
+    ),
+
+    event_declaration: $ => seq(
+      annotations($),
+      'event',
+      field('name', $.identifier),
+      '(',
+      optional(field('arguments', commaSep1($.arg))),
+      ')',
+      $._semicolon,
+    ),
+
+    signal_declaration: $ => seq(
+      annotations($),
+      'signal',
+      field('name', $.identifier),
+      '(',
+      optional(field('arguments', commaSep1($.arg))),
+      ')',
+      $._semicolon,
+    ),
+
+    type: $ => choice(
+      $.identifier,
+      seq('[', $.type, ']'),
+      seq('[', $.type, ':', $.type, ']'),
+      seq($.type, '.', $.identifier),
+      seq($.type, '?'),
+    ),
+
+    annotation: $ => seq(
+      '@',
+      field('name', $.identifier),
+      '(',
+      optional(field('value', commaSep1($.literal))),
+      ')',
+    ),
+
+    literal: $ => choice(
+      $.bool,
+      $.number,
+      $.list,
+      $.dictionary,
+      $.string,
+    ),
+
+    bool: $ => choice('Yes', 'No'),
+
+    list: $ => seq(
+      '[',
+      optional(commaSep1($.literal)),
+      ']',
+    ),
+
+    dictionary: $ => seq(
+      '[',
+      choice(':', commaSep1(seq($.identifier, ':', $.literal))),
+      ']',
+    ),
+
+    string: $ => choice(
+      seq(
+        '"',
+        repeat(choice(
+          alias($.unescaped_double_string_fragment, $.string_fragment),
+          $.escape_sequence,
+        )),
+        '"',
+      ),
+      seq(
+        "'",
+        repeat(choice(
+          alias($.unescaped_single_string_fragment, $.string_fragment),
+          $.escape_sequence,
+        )),
+        "'",
+      ),
+    ),
+
+    comment: $ => token(choice(
+      seq('//', /.*/),
+      seq(
+        '/*',
+        /[^*]*\*+([^/*][^*]*\*+)*/,
+        '/',
+      ),
+    )),
+
+    unescaped_double_string_fragment: $ => token.immediate(prec(1, /[^"\\]+/)),
+
+    unescaped_single_string_fragment: $ => token.immediate(prec(1, /[^'\\]+/)),
+
+    escape_sequence: $ => token.immediate(seq(
+      '\\',
+      choice(
+        /[^xu0-7]/,
+        /[0-7]{1,3}/,
+        /x[0-9a-fA-F]{2}/,
+        /u[0-9a-fA-F]{4}/,
+        /u{[0-9a-fA-F]+}/,
+      ),
+    )),
+
+    number: $ => {
+      const hex_literal = seq(
+        choice('0x', '0X'),
+        /[\da-fA-F](_?[\da-fA-F])*/,
+      )
+
+      const decimal_digits = /\d(_?\d)*/
+      const signed_integer = seq(optional(choice('-', '+')), decimal_digits)
+      const exponent_part = seq(choice('e', 'E'), signed_integer)
+
+      const binary_literal = seq(choice('0b', '0B'), /[0-1](_?[0-1])*/)
+
+      const octal_literal = seq(choice('0o', '0O'), /[0-7](_?[0-7])*/)
+
+      const bigint_literal = seq(choice(hex_literal, binary_literal, octal_literal, decimal_digits), 'n')
+
+      const decimal_integer_literal = choice(
+        '0',
+        seq(optional('0'), /[1-9]/, optional(seq(optional('_'), decimal_digits))),
+      )
+
+      const decimal_literal = choice(
+        seq(decimal_integer_literal, '.', optional(decimal_digits), optional(exponent_part)),
+        seq('.', decimal_digits, optional(exponent_part)),
+        seq(decimal_integer_literal, exponent_part),
+        seq(decimal_digits),
+      )
+
+      return token(seq(
+        optional('-'),
+        choice(
+          hex_literal,
+          decimal_literal,
+          binary_literal,
+          octal_literal,
+          bigint_literal,
+        ),
+      ))
+    },
+
+    identifier: $ => {
+      const alpha = /[^\x00-\x1F\s\p{Zs}0-9:;`"'@#.,|^&<=>+\-*/\\%?!~()\[\]{}\uFEFF\u2060\u200B]|\\u[0-9a-fA-F]{4}|\\u\{[0-9a-fA-F]+\}/
+      const alphanumeric = /[^\x00-\x1F\s\p{Zs}:;`"'@#.,|^&<=>+\-*/\\%?!~()\[\]{}\uFEFF\u2060\u200B]|\\u[0-9a-fA-F]{4}|\\u\{[0-9a-fA-F]+\}/
+      return token(seq(alpha, repeat(alphanumeric)))
+    },
+
+    _semicolon: $ => choice(';', $._automatic_semicolon),
+  },
+})
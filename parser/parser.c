@@ -6,15 +6,15 @@
 #endif
 
 #define LANGUAGE_VERSION 13
-#define STATE_COUNT 315
+#define STATE_COUNT 415
 #define LARGE_STATE_COUNT 2
-#define SYMBOL_COUNT 74
+#define SYMBOL_COUNT 80
 #define ALIAS_COUNT 0
-#define TOKEN_COUNT 41
+#define TOKEN_COUNT 45
 #define EXTERNAL_TOKEN_COUNT 3
-#define FIELD_COUNT 14
+#define FIELD_COUNT 16
 #define MAX_ALIAS_SEQUENCE_LENGTH 12
-#define PRODUCTION_ID_COUNT 46
+#define PRODUCTION_ID_COUNT 54
 
 enum {
   anon_sym_import = 1,
@@ -25,71 +25,77 @@ enum {
   anon_sym_struct = 6,
   anon_sym_let = 7,
   anon_sym_COLON = 8,
-  anon_sym_enum = 9,
-  anon_sym_flagset = 10,
-  sym_optional = 11,
-  anon_sym_flag = 12,
-  anon_sym_case = 13,
-  anon_sym_LPAREN = 14,
-  anon_sym_COMMA = 15,
-  anon_sym_RPAREN = 16,
-  anon_sym_func = 17,
-  anon_sym_throws = 18,
-  anon_sym_DASH_GT = 19,
-  anon_sym_event = 20,
-  anon_sym_signal = 21,
-  anon_sym_LBRACK = 22,
-  anon_sym_RBRACK = 23,
-  anon_sym_DOT = 24,
-  anon_sym_QMARK = 25,
-  anon_sym_AT = 26,
-  anon_sym_Yes = 27,
-  anon_sym_No = 28,
-  anon_sym_DQUOTE = 29,
-  anon_sym_SQUOTE = 30,
-  sym_comment = 31,
-  sym_unescaped_double_string_fragment = 32,
-  sym_unescaped_single_string_fragment = 33,
-  sym_escape_sequence = 34,
-  sym_number = 35,
-  sym_identifier = 36,
-  anon_sym_SEMI = 37,
-  sym__automatic_semicolon = 38,
-  sym__template_chars = 39,
-  sym__ternary_qmark = 40,
-  sym_file = 41,
-  sym_statement = 42,
-  sym_import = 43,
-  sym_stream_declaration = 44,
-  sym_struct_declaration = 45,
-  sym_field_declaration = 46,
-  sym_enum_declaration = 47,
-  sym_flagset_declaration = 48,
-  sym_flag_declaration = 49,
-  sym_case_declaration = 50,
-  sym_arg = 51,
-  sym_func_declaration = 52,
-  sym_event_declaration = 53,
-  sym_signal_declaration = 54,
-  sym_type = 55,
-  sym_annotation = 56,
-  sym_literal = 57,
-  sym_bool = 58,
-  sym_list = 59,
-  sym_dictionary = 60,
-  sym_string = 61,
-  sym__semicolon = 62,
-  aux_sym_file_repeat1 = 63,
-  aux_sym_stream_declaration_repeat1 = 64,
-  aux_sym_stream_declaration_repeat2 = 65,
-  aux_sym_struct_declaration_repeat1 = 66,
-  aux_sym_enum_declaration_repeat1 = 67,
-  aux_sym_flagset_declaration_repeat1 = 68,
-  aux_sym_case_declaration_repeat1 = 69,
-  aux_sym_annotation_repeat1 = 70,
-  aux_sym_dictionary_repeat1 = 71,
-  aux_sym_string_repeat1 = 72,
-  aux_sym_string_repeat2 = 73,
+  anon_sym_EQ = 9,
+  anon_sym_enum = 10,
+  anon_sym_flagset = 11,
+  sym_optional = 12,
+  anon_sym_flag = 13,
+  anon_sym_case = 14,
+  anon_sym_LPAREN = 15,
+  anon_sym_COMMA = 16,
+  anon_sym_RPAREN = 17,
+  anon_sym_typealias = 18,
+  anon_sym_newtype = 19,
+  anon_sym_const = 20,
+  anon_sym_func = 21,
+  anon_sym_throws = 22,
+  anon_sym_DASH_GT = 23,
+  anon_sym_event = 24,
+  anon_sym_signal = 25,
+  anon_sym_LBRACK = 26,
+  anon_sym_RBRACK = 27,
+  anon_sym_DOT = 28,
+  anon_sym_QMARK = 29,
+  anon_sym_AT = 30,
+  anon_sym_Yes = 31,
+  anon_sym_No = 32,
+  anon_sym_DQUOTE = 33,
+  anon_sym_SQUOTE = 34,
+  sym_comment = 35,
+  sym_unescaped_double_string_fragment = 36,
+  sym_unescaped_single_string_fragment = 37,
+  sym_escape_sequence = 38,
+  sym_number = 39,
+  sym_identifier = 40,
+  anon_sym_SEMI = 41,
+  sym__automatic_semicolon = 42,
+  sym__template_chars = 43,
+  sym__ternary_qmark = 44,
+  sym_file = 45,
+  sym_statement = 46,
+  sym_import = 47,
+  sym_stream_declaration = 48,
+  sym_struct_declaration = 49,
+  sym_field_declaration = 50,
+  sym_enum_declaration = 51,
+  sym_flagset_declaration = 52,
+  sym_flag_declaration = 53,
+  sym_case_declaration = 54,
+  sym_typealias_declaration = 55,
+  sym_const_declaration = 56,
+  sym_arg = 57,
+  sym_func_declaration = 58,
+  sym_event_declaration = 59,
+  sym_signal_declaration = 60,
+  sym_type = 61,
+  sym_annotation = 62,
+  sym_literal = 63,
+  sym_bool = 64,
+  sym_list = 65,
+  sym_dictionary = 66,
+  sym_string = 67,
+  sym__semicolon = 68,
+  aux_sym_file_repeat1 = 69,
+  aux_sym_stream_declaration_repeat1 = 70,
+  aux_sym_stream_declaration_repeat2 = 71,
+  aux_sym_struct_declaration_repeat1 = 72,
+  aux_sym_enum_declaration_repeat1 = 73,
+  aux_sym_flagset_declaration_repeat1 = 74,
+  aux_sym_case_declaration_repeat1 = 75,
+  aux_sym_annotation_repeat1 = 76,
+  aux_sym_dictionary_repeat1 = 77,
+  aux_sym_string_repeat1 = 78,
+  aux_sym_string_repeat2 = 79,
 };
 
 static const char * const ts_symbol_names[] = {
@@ -102,6 +108,7 @@ static const char * const ts_symbol_names[] = {
   [anon_sym_struct] = "struct",
   [anon_sym_let] = "let",
   [anon_sym_COLON] = ":",
+  [anon_sym_EQ] = "=",
   [anon_sym_enum] = "enum",
   [anon_sym_flagset] = "flagset",
   [sym_optional] = "optional",
@@ -110,6 +117,9 @@ static const char * const ts_symbol_names[] = {
   [anon_sym_LPAREN] = "(",
   [anon_sym_COMMA] = ",",
   [anon_sym_RPAREN] = ")",
+  [anon_sym_typealias] = "typealias",
+  [anon_sym_newtype] = "newtype",
+  [anon_sym_const] = "const",
   [anon_sym_func] = "func",
   [anon_sym_throws] = "throws",
   [anon_sym_DASH_GT] = "->",
@@ -144,6 +154,8 @@ static const char * const ts_symbol_names[] = {
   [sym_flagset_declaration] = "flagset_declaration",
   [sym_flag_declaration] = "flag_declaration",
   [sym_case_declaration] = "case_declaration",
+  [sym_typealias_declaration] = "typealias_declaration",
+  [sym_const_declaration] = "const_declaration",
   [sym_arg] = "arg",
   [sym_func_declaration] = "func_declaration",
   [sym_event_declaration] = "event_declaration",
@@ -179,6 +191,7 @@ static const TSSymbol ts_symbol_map[] = {
   [anon_sym_struct] = anon_sym_struct,
   [anon_sym_let] = anon_sym_let,
   [anon_sym_COLON] = anon_sym_COLON,
+  [anon_sym_EQ] = anon_sym_EQ,
   [anon_sym_enum] = anon_sym_enum,
   [anon_sym_flagset] = anon_sym_flagset,
   [sym_optional] = sym_optional,
@@ -187,6 +200,9 @@ static const TSSymbol ts_symbol_map[] = {
   [anon_sym_LPAREN] = anon_sym_LPAREN,
   [anon_sym_COMMA] = anon_sym_COMMA,
   [anon_sym_RPAREN] = anon_sym_RPAREN,
+  [anon_sym_typealias] = anon_sym_typealias,
+  [anon_sym_newtype] = anon_sym_newtype,
+  [anon_sym_const] = anon_sym_const,
   [anon_sym_func] = anon_sym_func,
   [anon_sym_throws] = anon_sym_throws,
   [anon_sym_DASH_GT] = anon_sym_DASH_GT,
@@ -221,6 +237,8 @@ static const TSSymbol ts_symbol_map[] = {
   [sym_flagset_declaration] = sym_flagset_declaration,
   [sym_flag_declaration] = sym_flag_declaration,
   [sym_case_declaration] = sym_case_declaration,
+  [sym_typealias_declaration] = sym_typealias_declaration,
+  [sym_const_declaration] = sym_const_declaration,
   [sym_arg] = sym_arg,
   [sym_func_declaration] = sym_func_declaration,
   [sym_event_declaration] = sym_event_declaration,
@@ -283,6 +301,10 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = false,
   },
+  [anon_sym_EQ] = {
+    .visible = true,
+    .named = false,
+  },
   [anon_sym_enum] = {
     .visible = true,
     .named = false,
@@ -315,6 +337,18 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = false,
   },
+  [anon_sym_typealias] = {
+    .visible = true,
+    .named = false,
+  },
+  [anon_sym_newtype] = {
+    .visible = true,
+    .named = false,
+  },
+  [anon_sym_const] = {
+    .visible = true,
+    .named = false,
+  },
   [anon_sym_func] = {
     .visible = true,
     .named = false,
@@ -451,6 +485,14 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = true,
   },
+  [sym_typealias_declaration] = {
+    .visible = true,
+    .named = true,
+  },
+  [sym_const_declaration] = {
+    .visible = true,
+    .named = true,
+  },
   [sym_arg] = {
     .visible = true,
     .named = true,
@@ -550,16 +592,18 @@ enum {
   field_annotations = 2,
   field_arguments = 3,
   field_cases = 4,
-  field_fields = 5,
-  field_flags = 6,
-  field_name = 7,
-  field_optional = 8,
-  field_path = 9,
-  field_returns = 10,
-  field_throws = 11,
-  field_type = 12,
-  field_value = 13,
-  field_values = 14,
+  field_default = 5,
+  field_fields = 6,
+  field_flags = 7,
+  field_kind = 8,
+  field_name = 9,
+  field_optional = 10,
+  field_path = 11,
+  field_returns = 12,
+  field_throws = 13,
+  field_type = 14,
+  field_value = 15,
+  field_values = 16,
 };
 
 static const char * const ts_field_names[] = {
@@ -568,8 +612,10 @@ static const char * const ts_field_names[] = {
   [field_annotations] = "annotations",
   [field_arguments] = "arguments",
   [field_cases] = "cases",
+  [field_default] = "default",
   [field_fields] = "fields",
   [field_flags] = "flags",
+  [field_kind] = "kind",
   [field_name] = "name",
   [field_optional] = "optional",
   [field_path] = "path",
@@ -581,243 +627,287 @@ static const char * const ts_field_names[] = {
 };
 
 static const TSFieldMapSlice ts_field_map_slices[PRODUCTION_ID_COUNT] = {
-  [1] = {.index = 0, .length = 1},
-  [2] = {.index = 1, .length = 2},
-  [3] = {.index = 3, .length = 2},
-  [4] = {.index = 5, .length = 2},
-  [5] = {.index = 7, .length = 2},
-  [6] = {.index = 9, .length = 2},
-  [7] = {.index = 11, .length = 2},
-  [8] = {.index = 13, .length = 3},
-  [9] = {.index = 16, .length = 2},
-  [10] = {.index = 18, .length = 2},
-  [11] = {.index = 20, .length = 3},
-  [12] = {.index = 23, .length = 3},
-  [13] = {.index = 26, .length = 3},
-  [14] = {.index = 29, .length = 3},
-  [15] = {.index = 32, .length = 2},
-  [16] = {.index = 34, .length = 4},
-  [17] = {.index = 38, .length = 2},
-  [18] = {.index = 40, .length = 2},
-  [19] = {.index = 42, .length = 3},
-  [20] = {.index = 45, .length = 3},
-  [21] = {.index = 48, .length = 4},
-  [22] = {.index = 52, .length = 3},
-  [23] = {.index = 55, .length = 3},
-  [24] = {.index = 58, .length = 2},
-  [25] = {.index = 60, .length = 3},
-  [26] = {.index = 63, .length = 3},
-  [27] = {.index = 66, .length = 5},
-  [28] = {.index = 71, .length = 3},
-  [29] = {.index = 74, .length = 3},
-  [30] = {.index = 77, .length = 4},
-  [31] = {.index = 81, .length = 3},
-  [32] = {.index = 84, .length = 3},
-  [33] = {.index = 87, .length = 3},
-  [34] = {.index = 90, .length = 4},
-  [35] = {.index = 94, .length = 4},
-  [36] = {.index = 98, .length = 4},
-  [37] = {.index = 102, .length = 4},
-  [38] = {.index = 106, .length = 4},
-  [39] = {.index = 110, .length = 4},
-  [40] = {.index = 114, .length = 4},
-  [41] = {.index = 118, .length = 5},
-  [42] = {.index = 123, .length = 5},
-  [43] = {.index = 128, .length = 5},
-  [44] = {.index = 133, .length = 5},
-  [45] = {.index = 138, .length = 6},
+  [1] = {.index = 0, .length = 2},
+  [2] = {.index = 2, .length = 2},
+  [3] = {.index = 4, .length = 1},
+  [4] = {.index = 5, .length = 3},
+  [5] = {.index = 8, .length = 2},
+  [6] = {.index = 10, .length = 4},
+  [7] = {.index = 14, .length = 3},
+  [8] = {.index = 17, .length = 3},
+  [9] = {.index = 20, .length = 2},
+  [10] = {.index = 22, .length = 3},
+  [11] = {.index = 25, .length = 2},
+  [12] = {.index = 27, .length = 5},
+  [13] = {.index = 32, .length = 4},
+  [14] = {.index = 36, .length = 3},
+  [15] = {.index = 39, .length = 2},
+  [16] = {.index = 41, .length = 4},
+  [17] = {.index = 45, .length = 3},
+  [18] = {.index = 48, .length = 4},
+  [19] = {.index = 52, .length = 3},
+  [20] = {.index = 55, .length = 3},
+  [21] = {.index = 58, .length = 2},
+  [22] = {.index = 60, .length = 4},
+  [23] = {.index = 64, .length = 3},
+  [24] = {.index = 67, .length = 4},
+  [25] = {.index = 71, .length = 3},
+  [26] = {.index = 74, .length = 4},
+  [27] = {.index = 78, .length = 3},
+  [28] = {.index = 81, .length = 3},
+  [29] = {.index = 84, .length = 2},
+  [30] = {.index = 86, .length = 6},
+  [31] = {.index = 92, .length = 5},
+  [32] = {.index = 97, .length = 5},
+  [33] = {.index = 102, .length = 4},
+  [34] = {.index = 106, .length = 4},
+  [35] = {.index = 110, .length = 3},
+  [36] = {.index = 113, .length = 5},
+  [37] = {.index = 118, .length = 4},
+  [38] = {.index = 122, .length = 4},
+  [39] = {.index = 126, .length = 3},
+  [40] = {.index = 129, .length = 3},
+  [41] = {.index = 132, .length = 2},
+  [42] = {.index = 134, .length = 5},
+  [43] = {.index = 139, .length = 4},
+  [44] = {.index = 143, .length = 4},
+  [45] = {.index = 147, .length = 3},
+  [46] = {.index = 150, .length = 3},
+  [47] = {.index = 153, .length = 2},
+  [48] = {.index = 155, .length = 4},
+  [49] = {.index = 159, .length = 3},
+  [50] = {.index = 162, .length = 3},
+  [51] = {.index = 165, .length = 2},
+  [52] = {.index = 167, .length = 3},
+  [53] = {.index = 170, .length = 2},
 };
 
 static const TSFieldMapEntry ts_field_map_entries[] = {
   [0] =
-    {field_name, 1},
-  [1] =
     {field_alias, 3},
     {field_path, 1},
-  [3] =
-    {field_fields, 3},
+  [2] =
+    {field_annotations, 0},
+    {field_name, 2},
+  [4] =
     {field_name, 1},
   [5] =
-    {field_cases, 3},
-    {field_name, 1},
-  [7] =
-    {field_flags, 3},
-    {field_name, 1},
-  [9] =
-    {field_name, 1},
-    {field_value, 3},
-  [11] =
     {field_annotations, 0},
+    {field_fields, 4},
     {field_name, 2},
-  [13] =
-    {field_name, 1},
-    {field_optional, 2},
-    {field_optional, 3},
-  [16] =
-    {field_name, 0},
-    {field_type, 2},
-  [18] =
-    {field_arguments, 3},
+  [8] =
+    {field_fields, 3},
     {field_name, 1},
-  [20] =
+  [10] =
+    {field_annotations, 0},
+    {field_default, 6},
+    {field_name, 2},
+    {field_type, 4},
+  [14] =
+    {field_default, 5},
     {field_name, 1},
-    {field_value, 3},
-    {field_value, 4},
-  [23] =
+    {field_type, 3},
+  [17] =
     {field_annotations, 0},
-    {field_fields, 4},
     {field_name, 2},
-  [26] =
+    {field_type, 4},
+  [20] =
+    {field_name, 1},
+    {field_type, 3},
+  [22] =
     {field_annotations, 0},
     {field_cases, 4},
     {field_name, 2},
-  [29] =
+  [25] =
+    {field_cases, 3},
+    {field_name, 1},
+  [27] =
     {field_annotations, 0},
-    {field_flags, 4},
+    {field_flags, 6},
     {field_name, 2},
+    {field_optional, 3},
+    {field_optional, 4},
   [32] =
-    {field_name, 1},
-    {field_type, 3},
-  [34] =
     {field_flags, 5},
     {field_name, 1},
     {field_optional, 2},
     {field_optional, 3},
-  [38] =
-    {field_name, 1},
-    {field_throws, 5},
-  [40] =
-    {field_name, 1},
-    {field_returns, 5},
-  [42] =
-    {field_arguments, 3},
-    {field_arguments, 4},
-    {field_name, 1},
-  [45] =
+  [36] =
     {field_annotations, 0},
+    {field_flags, 4},
+    {field_name, 2},
+  [39] =
+    {field_flags, 3},
     {field_name, 1},
-    {field_type, 3},
-  [48] =
+  [41] =
     {field_annotations, 0},
     {field_name, 2},
     {field_optional, 3},
     {field_optional, 4},
-  [52] =
+  [45] =
+    {field_name, 1},
+    {field_optional, 2},
+    {field_optional, 3},
+  [48] =
     {field_annotations, 0},
-    {field_arguments, 4},
     {field_name, 2},
+    {field_values, 4},
+    {field_values, 5},
+  [52] =
+    {field_name, 1},
+    {field_values, 3},
+    {field_values, 4},
   [55] =
     {field_annotations, 0},
     {field_name, 2},
-    {field_type, 4},
+    {field_values, 4},
   [58] =
     {field_name, 1},
     {field_values, 3},
   [60] =
-    {field_arguments, 3},
-    {field_name, 1},
-    {field_throws, 6},
-  [63] =
-    {field_arguments, 3},
-    {field_name, 1},
-    {field_returns, 6},
-  [66] =
     {field_annotations, 0},
-    {field_flags, 6},
+    {field_kind, 1},
     {field_name, 2},
-    {field_optional, 3},
-    {field_optional, 4},
-  [71] =
+    {field_type, 4},
+  [64] =
+    {field_kind, 0},
+    {field_name, 1},
+    {field_type, 3},
+  [67] =
     {field_annotations, 0},
     {field_name, 2},
-    {field_throws, 6},
+    {field_type, 4},
+    {field_value, 6},
+  [71] =
+    {field_name, 1},
+    {field_type, 3},
+    {field_value, 5},
   [74] =
     {field_annotations, 0},
-    {field_name, 2},
-    {field_returns, 6},
-  [77] =
-    {field_annotations, 0},
-    {field_arguments, 4},
-    {field_arguments, 5},
-    {field_name, 2},
+    {field_default, 5},
+    {field_name, 1},
+    {field_type, 3},
+  [78] =
+    {field_default, 4},
+    {field_name, 0},
+    {field_type, 2},
   [81] =
+    {field_annotations, 0},
     {field_name, 1},
-    {field_values, 3},
-    {field_values, 4},
+    {field_type, 3},
   [84] =
+    {field_name, 0},
+    {field_type, 2},
+  [86] =
     {field_annotations, 0},
+    {field_arguments, 4},
+    {field_arguments, 5},
     {field_name, 2},
-    {field_values, 4},
-  [87] =
-    {field_name, 1},
-    {field_returns, 7},
-    {field_throws, 5},
-  [90] =
+    {field_returns, 10},
+    {field_throws, 8},
+  [92] =
     {field_arguments, 3},
     {field_arguments, 4},
     {field_name, 1},
+    {field_returns, 9},
     {field_throws, 7},
-  [94] =
-    {field_arguments, 3},
-    {field_arguments, 4},
-    {field_name, 1},
-    {field_returns, 7},
-  [98] =
+  [97] =
     {field_annotations, 0},
     {field_arguments, 4},
     {field_name, 2},
+    {field_returns, 9},
     {field_throws, 7},
   [102] =
-    {field_annotations, 0},
-    {field_arguments, 4},
-    {field_name, 2},
-    {field_returns, 7},
-  [106] =
-    {field_annotations, 0},
-    {field_name, 2},
-    {field_values, 4},
-    {field_values, 5},
-  [110] =
     {field_arguments, 3},
     {field_name, 1},
     {field_returns, 8},
     {field_throws, 6},
-  [114] =
+  [106] =
     {field_annotations, 0},
     {field_name, 2},
     {field_returns, 8},
     {field_throws, 6},
-  [118] =
+  [110] =
+    {field_name, 1},
+    {field_returns, 7},
+    {field_throws, 5},
+  [113] =
     {field_annotations, 0},
     {field_arguments, 4},
     {field_arguments, 5},
     {field_name, 2},
-    {field_throws, 8},
-  [123] =
+    {field_returns, 8},
+  [118] =
+    {field_arguments, 3},
+    {field_arguments, 4},
+    {field_name, 1},
+    {field_returns, 7},
+  [122] =
+    {field_annotations, 0},
+    {field_arguments, 4},
+    {field_name, 2},
+    {field_returns, 7},
+  [126] =
+    {field_arguments, 3},
+    {field_name, 1},
+    {field_returns, 6},
+  [129] =
+    {field_annotations, 0},
+    {field_name, 2},
+    {field_returns, 6},
+  [132] =
+    {field_name, 1},
+    {field_returns, 5},
+  [134] =
     {field_annotations, 0},
     {field_arguments, 4},
     {field_arguments, 5},
     {field_name, 2},
-    {field_returns, 8},
-  [128] =
+    {field_throws, 8},
+  [139] =
     {field_arguments, 3},
     {field_arguments, 4},
     {field_name, 1},
-    {field_returns, 9},
     {field_throws, 7},
-  [133] =
+  [143] =
     {field_annotations, 0},
     {field_arguments, 4},
     {field_name, 2},
-    {field_returns, 9},
     {field_throws, 7},
-  [138] =
+  [147] =
+    {field_arguments, 3},
+    {field_name, 1},
+    {field_throws, 6},
+  [150] =
+    {field_annotations, 0},
+    {field_name, 2},
+    {field_throws, 6},
+  [153] =
+    {field_name, 1},
+    {field_throws, 5},
+  [155] =
     {field_annotations, 0},
     {field_arguments, 4},
     {field_arguments, 5},
     {field_name, 2},
-    {field_returns, 10},
-    {field_throws, 8},
+  [159] =
+    {field_arguments, 3},
+    {field_arguments, 4},
+    {field_name, 1},
+  [162] =
+    {field_annotations, 0},
+    {field_arguments, 4},
+    {field_name, 2},
+  [165] =
+    {field_arguments, 3},
+    {field_name, 1},
+  [167] =
+    {field_name, 1},
+    {field_value, 3},
+    {field_value, 4},
+  [170] =
+    {field_name, 1},
+    {field_value, 3},
 };
 
 static const TSSymbol ts_alias_sequences[PRODUCTION_ID_COUNT][MAX_ALIAS_SEQUENCE_LENGTH] = {
@@ -835,8 +925,8 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [3] = 3,
   [4] = 4,
   [5] = 5,
-  [6] = 5,
-  [7] = 5,
+  [6] = 6,
+  [7] = 7,
   [8] = 8,
   [9] = 9,
   [10] = 10,
@@ -937,33 +1027,33 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [105] = 105,
   [106] = 106,
   [107] = 107,
-  [108] = 108,
-  [109] = 109,
+  [108] = 104,
+  [109] = 105,
   [110] = 110,
   [111] = 111,
-  [112] = 112,
+  [112] = 11,
   [113] = 113,
   [114] = 114,
-  [115] = 115,
-  [116] = 19,
+  [115] = 22,
+  [116] = 116,
   [117] = 117,
   [118] = 118,
   [119] = 119,
   [120] = 120,
   [121] = 121,
-  [122] = 20,
+  [122] = 122,
   [123] = 123,
   [124] = 124,
-  [125] = 22,
+  [125] = 125,
   [126] = 126,
-  [127] = 15,
+  [127] = 127,
   [128] = 128,
   [129] = 129,
   [130] = 130,
   [131] = 131,
   [132] = 132,
-  [133] = 8,
-  [134] = 8,
+  [133] = 133,
+  [134] = 134,
   [135] = 135,
   [136] = 136,
   [137] = 137,
@@ -986,27 +1076,27 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [154] = 154,
   [155] = 155,
   [156] = 156,
-  [157] = 132,
+  [157] = 157,
   [158] = 158,
   [159] = 159,
   [160] = 160,
   [161] = 161,
   [162] = 162,
   [163] = 163,
-  [164] = 164,
+  [164] = 159,
   [165] = 165,
-  [166] = 166,
-  [167] = 167,
+  [166] = 160,
+  [167] = 161,
   [168] = 168,
   [169] = 169,
   [170] = 170,
-  [171] = 171,
+  [171] = 36,
   [172] = 172,
   [173] = 173,
   [174] = 174,
   [175] = 175,
   [176] = 176,
-  [177] = 177,
+  [177] = 46,
   [178] = 178,
   [179] = 179,
   [180] = 180,
@@ -1014,13 +1104,13 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [182] = 182,
   [183] = 183,
   [184] = 184,
-  [185] = 179,
+  [185] = 185,
   [186] = 186,
   [187] = 187,
-  [188] = 180,
+  [188] = 188,
   [189] = 189,
   [190] = 190,
-  [191] = 180,
+  [191] = 191,
   [192] = 192,
   [193] = 193,
   [194] = 194,
@@ -1028,7 +1118,7 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [196] = 196,
   [197] = 197,
   [198] = 198,
-  [199] = 173,
+  [199] = 199,
   [200] = 200,
   [201] = 201,
   [202] = 202,
@@ -1042,23 +1132,23 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [210] = 210,
   [211] = 211,
   [212] = 212,
-  [213] = 213,
-  [214] = 214,
-  [215] = 215,
-  [216] = 216,
-  [217] = 169,
-  [218] = 218,
-  [219] = 179,
-  [220] = 220,
+  [213] = 210,
+  [214] = 211,
+  [215] = 118,
+  [216] = 119,
+  [217] = 120,
+  [218] = 25,
+  [219] = 26,
+  [220] = 121,
   [221] = 221,
-  [222] = 222,
-  [223] = 223,
-  [224] = 224,
-  [225] = 225,
-  [226] = 226,
+  [222] = 123,
+  [223] = 124,
+  [224] = 125,
+  [225] = 126,
+  [226] = 212,
   [227] = 227,
   [228] = 228,
-  [229] = 229,
+  [229] = 65,
   [230] = 230,
   [231] = 231,
   [232] = 232,
@@ -1068,7 +1158,7 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [236] = 236,
   [237] = 237,
   [238] = 238,
-  [239] = 181,
+  [239] = 239,
   [240] = 240,
   [241] = 241,
   [242] = 242,
@@ -1080,12 +1170,12 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [248] = 248,
   [249] = 249,
   [250] = 250,
-  [251] = 13,
-  [252] = 14,
-  [253] = 12,
-  [254] = 13,
-  [255] = 14,
-  [256] = 12,
+  [251] = 251,
+  [252] = 252,
+  [253] = 253,
+  [254] = 254,
+  [255] = 255,
+  [256] = 256,
   [257] = 257,
   [258] = 258,
   [259] = 259,
@@ -1100,21 +1190,21 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [268] = 268,
   [269] = 269,
   [270] = 270,
-  [271] = 271,
-  [272] = 272,
-  [273] = 273,
-  [274] = 274,
-  [275] = 275,
-  [276] = 276,
-  [277] = 277,
-  [278] = 278,
-  [279] = 279,
+  [271] = 270,
+  [272] = 178,
+  [273] = 179,
+  [274] = 180,
+  [275] = 181,
+  [276] = 47,
+  [277] = 50,
+  [278] = 51,
+  [279] = 54,
   [280] = 280,
   [281] = 281,
   [282] = 282,
   [283] = 283,
-  [284] = 284,
-  [285] = 285,
+  [284] = 117,
+  [285] = 122,
   [286] = 286,
   [287] = 287,
   [288] = 288,
@@ -1133,51 +1223,231 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [301] = 301,
   [302] = 302,
   [303] = 303,
-  [304] = 301,
+  [304] = 304,
   [305] = 305,
   [306] = 306,
   [307] = 307,
   [308] = 308,
-  [309] = 274,
+  [309] = 309,
   [310] = 310,
   [311] = 311,
-  [312] = 274,
-  [313] = 291,
-  [314] = 291,
+  [312] = 312,
+  [313] = 313,
+  [314] = 314,
+  [315] = 315,
+  [316] = 316,
+  [317] = 317,
+  [318] = 318,
+  [319] = 319,
+  [320] = 320,
+  [321] = 321,
+  [322] = 322,
+  [323] = 322,
+  [324] = 238,
+  [325] = 239,
+  [326] = 240,
+  [327] = 241,
+  [328] = 74,
+  [329] = 77,
+  [330] = 330,
+  [331] = 183,
+  [332] = 184,
+  [333] = 333,
+  [334] = 334,
+  [335] = 335,
+  [336] = 336,
+  [337] = 337,
+  [338] = 338,
+  [339] = 339,
+  [340] = 340,
+  [341] = 341,
+  [342] = 342,
+  [343] = 343,
+  [344] = 344,
+  [345] = 345,
+  [346] = 346,
+  [347] = 347,
+  [348] = 348,
+  [349] = 349,
+  [350] = 350,
+  [351] = 351,
+  [352] = 352,
+  [353] = 353,
+  [354] = 354,
+  [355] = 355,
+  [356] = 356,
+  [357] = 357,
+  [358] = 358,
+  [359] = 359,
+  [360] = 360,
+  [361] = 361,
+  [362] = 362,
+  [363] = 363,
+  [364] = 364,
+  [365] = 365,
+  [366] = 366,
+  [367] = 367,
+  [368] = 294,
+  [369] = 295,
+  [370] = 370,
+  [371] = 244,
+  [372] = 372,
+  [373] = 373,
+  [374] = 374,
+  [375] = 375,
+  [376] = 376,
+  [377] = 377,
+  [378] = 378,
+  [379] = 379,
+  [380] = 380,
+  [381] = 381,
+  [382] = 382,
+  [383] = 383,
+  [384] = 384,
+  [385] = 385,
+  [386] = 386,
+  [387] = 387,
+  [388] = 388,
+  [389] = 389,
+  [390] = 390,
+  [391] = 391,
+  [392] = 392,
+  [393] = 393,
+  [394] = 341,
+  [395] = 342,
+  [396] = 396,
+  [397] = 397,
+  [398] = 398,
+  [399] = 399,
+  [400] = 400,
+  [401] = 401,
+  [402] = 402,
+  [403] = 403,
+  [404] = 404,
+  [405] = 405,
+  [406] = 406,
+  [407] = 379,
+  [408] = 408,
+  [409] = 409,
+  [410] = 410,
+  [411] = 411,
+  [412] = 412,
+  [413] = 413,
+  [414] = 414,
 };
 
-static inline bool anon_sym_DQUOTE_character_set_1(int32_t c) {
-  return (c < 8192
-    ? (c < ' '
-      ? (c < '\r'
-        ? (c >= '\t' && c <= '\n')
-        : c <= '\r')
-      : (c <= ' ' || (c < 5760
-        ? c == 160
-        : c <= 5760)))
-    : (c <= 8203 || (c < 12288
-      ? (c < 8287
-        ? c == 8239
-        : c <= 8288)
-      : (c <= 12288 || c == 65279))));
+static inline bool sym_character_set_1(int32_t c) {
+  return c == '\t' ||
+    c == '\n' ||
+    c == '\r' ||
+    c == ' ' ||
+    c == 160 ||
+    c == 5760 ||
+    (8192 <= c && c <= 8203) ||
+    c == 8239 ||
+    c == 8287 ||
+    c == 8288 ||
+    c == 12288 ||
+    c == 65279;
+}
+
+static inline bool sym_character_set_2(int32_t c) {
+  return c == '$' ||
+    ('A' <= c && c <= 'Z') ||
+    c == '_' ||
+    ('a' <= c && c <= 'z') ||
+    (127 <= c && c <= 159) ||
+    (161 <= c && c <= 5759) ||
+    (5761 <= c && c <= 8191) ||
+    (8204 <= c && c <= 8238) ||
+    (8240 <= c && c <= 8286) ||
+    (8289 <= c && c <= 12287) ||
+    (12289 <= c && c <= 65278) ||
+    (65280 <= c && c <= 1114111);
+}
+
+static inline bool sym_identifier_character_set_3(int32_t c) {
+  return c == '$' ||
+    ('0' <= c && c <= '9') ||
+    ('A' <= c && c <= 'Z') ||
+    c == '_' ||
+    ('a' <= c && c <= 'z') ||
+    (127 <= c && c <= 159) ||
+    (161 <= c && c <= 5759) ||
+    (5761 <= c && c <= 8191) ||
+    (8204 <= c && c <= 8238) ||
+    (8240 <= c && c <= 8286) ||
+    (8289 <= c && c <= 12287) ||
+    (12289 <= c && c <= 65278) ||
+    (65280 <= c && c <= 1114111);
+}
+
+static inline bool sym_character_set_4(int32_t c) {
+  return c == '$' ||
+    ('A' <= c && c <= 'M') ||
+    ('O' <= c && c <= 'X') ||
+    c == 'Z' ||
+    c == '_' ||
+    ('a' <= c && c <= 'z') ||
+    (127 <= c && c <= 159) ||
+    (161 <= c && c <= 5759) ||
+    (5761 <= c && c <= 8191) ||
+    (8204 <= c && c <= 8238) ||
+    (8240 <= c && c <= 8286) ||
+    (8289 <= c && c <= 12287) ||
+    (12289 <= c && c <= 65278) ||
+    (65280 <= c && c <= 1114111);
 }
 
-static inline bool sym_identifier_character_set_1(int32_t c) {
-  return (c < 160
-    ? (c < ':'
-      ? (c < 0
-        ? c == 0
-        : (c <= '#' || (c >= '%' && c <= '/')))
-      : (c <= '@' || (c < '`'
-        ? (c >= '[' && c <= '^')
-        : (c <= '`' || (c >= '{' && c <= '~')))))
-    : (c <= 160 || (c < 8287
-      ? (c < 8192
-        ? c == 5760
-        : (c <= 8203 || c == 8239))
-      : (c <= 8288 || (c < 65279
-        ? c == 12288
-        : c <= 65279)))));
+static inline bool sym_identifier_character_set_5(int32_t c) {
+  return c == '$' ||
+    ('0' <= c && c <= '9') ||
+    ('A' <= c && c <= 'Z') ||
+    c == '_' ||
+    ('a' <= c && c <= 'n') ||
+    ('p' <= c && c <= 'z') ||
+    (127 <= c && c <= 159) ||
+    (161 <= c && c <= 5759) ||
+    (5761 <= c && c <= 8191) ||
+    (8204 <= c && c <= 8238) ||
+    (8240 <= c && c <= 8286) ||
+    (8289 <= c && c <= 12287) ||
+    (12289 <= c && c <= 65278) ||
+    (65280 <= c && c <= 1114111);
+}
+
+static inline bool sym_identifier_character_set_6(int32_t c) {
+  return c == '$' ||
+    ('0' <= c && c <= '9') ||
+    ('A' <= c && c <= 'Z') ||
+    c == '_' ||
+    ('a' <= c && c <= 'd') ||
+    ('f' <= c && c <= 'z') ||
+    (127 <= c && c <= 159) ||
+    (161 <= c && c <= 5759) ||
+    (5761 <= c && c <= 8191) ||
+    (8204 <= c && c <= 8238) ||
+    (8240 <= c && c <= 8286) ||
+    (8289 <= c && c <= 12287) ||
+    (12289 <= c && c <= 65278) ||
+    (65280 <= c && c <= 1114111);
+}
+
+static inline bool sym_identifier_character_set_7(int32_t c) {
+  return c == '$' ||
+    ('0' <= c && c <= '9') ||
+    ('A' <= c && c <= 'Z') ||
+    c == '_' ||
+    ('a' <= c && c <= 'r') ||
+    ('t' <= c && c <= 'z') ||
+    (127 <= c && c <= 159) ||
+    (161 <= c && c <= 5759) ||
+    (5761 <= c && c <= 8191) ||
+    (8204 <= c && c <= 8238) ||
+    (8240 <= c && c <= 8286) ||
+    (8289 <= c && c <= 12287) ||
+    (12289 <= c && c <= 65278) ||
+    (65280 <= c && c <= 1114111);
 }
 
 static bool ts_lex(TSLexer *lexer, TSStateId state) {
@@ -1185,4632 +1455,7103 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
   eof = lexer->eof(lexer);
   switch (state) {
     case 0:
-      if (eof) ADVANCE(88);
-      if (lookahead == '"') ADVANCE(120);
-      if (lookahead == '\'') ADVANCE(121);
-      if (lookahead == '(') ADVANCE(102);
-      if (lookahead == ')') ADVANCE(104);
-      if (lookahead == ',') ADVANCE(103);
-      if (lookahead == '-') ADVANCE(9);
-      if (lookahead == '.') ADVANCE(113);
-      if (lookahead == '/') ADVANCE(6);
-      if (lookahead == '0') ADVANCE(140);
-      if (lookahead == ':') ADVANCE(96);
-      if (lookahead == ';') ADVANCE(153);
-      if (lookahead == '?') ADVANCE(114);
-      if (lookahead == '@') ADVANCE(115);
-      if (lookahead == 'N') ADVANCE(42);
-      if (lookahead == 'Y') ADVANCE(20);
-      if (lookahead == '[') ADVANCE(110);
-      if (lookahead == '\\') ADVANCE(62);
-      if (lookahead == ']') ADVANCE(111);
-      if (lookahead == 'a') ADVANCE(51);
-      if (lookahead == 'c') ADVANCE(13);
-      if (lookahead == 'e') ADVANCE(37);
-      if (lookahead == 'f') ADVANCE(32);
-      if (lookahead == 'i') ADVANCE(36);
-      if (lookahead == 'l') ADVANCE(18);
-      if (lookahead == 'o') ADVANCE(47);
-      if (lookahead == 's') ADVANCE(28);
-      if (lookahead == 't') ADVANCE(27);
-      if (lookahead == '{') ADVANCE(92);
-      if (lookahead == '}') ADVANCE(93);
-      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(141);
-      if (anon_sym_DQUOTE_character_set_1(lookahead)) SKIP(86)
+      if (eof) ADVANCE(1);
+      if (lookahead == '"') ADVANCE(2);
+      if (lookahead == '\'') ADVANCE(3);
+      if (lookahead == '(') ADVANCE(4);
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(6);
+      if (lookahead == '-') ADVANCE(7);
+      if (lookahead == '.') ADVANCE(63);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '0') ADVANCE(19);
+      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(61);
+      if (lookahead == ':') ADVANCE(74);
+      if (lookahead == ';') ADVANCE(75);
+      if (lookahead == '=') ADVANCE(76);
+      if (lookahead == '?') ADVANCE(77);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'N') ADVANCE(79);
+      if (lookahead == 'Y') ADVANCE(81);
+      if (lookahead == '[') ADVANCE(84);
+      if (lookahead == '\\') ADVANCE(85);
+      if (lookahead == ']') ADVANCE(101);
+      if (lookahead == 'a') ADVANCE(102);
+      if (lookahead == 'c') ADVANCE(104);
+      if (lookahead == 'e') ADVANCE(112);
+      if (lookahead == 'f') ADVANCE(120);
+      if (lookahead == 'i') ADVANCE(130);
+      if (lookahead == 'l') ADVANCE(136);
+      if (lookahead == 'n') ADVANCE(139);
+      if (lookahead == 'o') ADVANCE(146);
+      if (lookahead == 's') ADVANCE(154);
+      if (lookahead == 't') ADVANCE(168);
+      if (lookahead == '{') ADVANCE(182);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(184)
       END_STATE();
     case 1:
-      if (lookahead == '"') ADVANCE(120);
-      if (lookahead == '\'') ADVANCE(121);
-      if (lookahead == '.') ADVANCE(74);
-      if (lookahead == '/') ADVANCE(6);
-      if (lookahead == '0') ADVANCE(140);
-      if (lookahead == ':') ADVANCE(96);
-      if (lookahead == 'N') ADVANCE(150);
-      if (lookahead == 'Y') ADVANCE(149);
-      if (lookahead == '[') ADVANCE(110);
-      if (lookahead == '\\') ADVANCE(64);
-      if (lookahead == ']') ADVANCE(111);
-      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(141);
-      if (anon_sym_DQUOTE_character_set_1(lookahead)) SKIP(1)
-      if (lookahead != 0 &&
-          lookahead > '#' &&
-          (lookahead < '%' || '@' < lookahead) &&
-          lookahead != '^' &&
-          lookahead != '`' &&
-          (lookahead < '{' || '~' < lookahead)) ADVANCE(152);
+      ACCEPT_TOKEN(ts_builtin_sym_end);
       END_STATE();
     case 2:
-      if (lookahead == '"') ADVANCE(120);
-      if (lookahead == '/') ADVANCE(125);
-      if (lookahead == '\\') ADVANCE(62);
-      if (anon_sym_DQUOTE_character_set_1(lookahead)) ADVANCE(128);
-      if (lookahead != 0) ADVANCE(129);
+      ACCEPT_TOKEN(anon_sym_DQUOTE);
       END_STATE();
     case 3:
-      if (lookahead == '\'') ADVANCE(121);
-      if (lookahead == '/') ADVANCE(131);
-      if (lookahead == '\\') ADVANCE(62);
-      if (anon_sym_DQUOTE_character_set_1(lookahead)) ADVANCE(134);
-      if (lookahead != 0) ADVANCE(135);
+      ACCEPT_TOKEN(anon_sym_SQUOTE);
       END_STATE();
     case 4:
-      if (lookahead == ')') ADVANCE(104);
-      if (lookahead == ',') ADVANCE(103);
-      if (lookahead == '-') ADVANCE(9);
-      if (lookahead == '.') ADVANCE(112);
-      if (lookahead == '/') ADVANCE(6);
-      if (lookahead == ':') ADVANCE(96);
-      if (lookahead == ';') ADVANCE(153);
-      if (lookahead == '?') ADVANCE(114);
-      if (lookahead == '@') ADVANCE(115);
-      if (lookahead == ']') ADVANCE(111);
-      if (lookahead == 'l') ADVANCE(18);
-      if (lookahead == '}') ADVANCE(93);
-      if (anon_sym_DQUOTE_character_set_1(lookahead)) SKIP(4)
+      ACCEPT_TOKEN(anon_sym_LPAREN);
       END_STATE();
     case 5:
-      if (lookahead == ')') ADVANCE(104);
-      if (lookahead == '/') ADVANCE(6);
-      if (lookahead == '@') ADVANCE(115);
-      if (lookahead == '[') ADVANCE(110);
-      if (lookahead == '\\') ADVANCE(64);
-      if (anon_sym_DQUOTE_character_set_1(lookahead)) SKIP(5)
-      if (lookahead != 0 &&
-          lookahead > '#' &&
-          (lookahead < '%' || '?' < lookahead) &&
-          lookahead != ']' &&
-          lookahead != '^' &&
-          lookahead != '`' &&
-          (lookahead < '{' || '~' < lookahead)) ADVANCE(152);
+      ACCEPT_TOKEN(anon_sym_RPAREN);
       END_STATE();
     case 6:
-      if (lookahead == '*') ADVANCE(8);
-      if (lookahead == '/') ADVANCE(123);
+      ACCEPT_TOKEN(anon_sym_COMMA);
       END_STATE();
     case 7:
-      if (lookahead == '*') ADVANCE(7);
-      if (lookahead == '/') ADVANCE(122);
-      if (lookahead != 0) ADVANCE(8);
+      if (lookahead == '.') ADVANCE(8);
+      if (lookahead == '0') ADVANCE(19);
+      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(61);
+      if (lookahead == '>') ADVANCE(62);
       END_STATE();
     case 8:
-      if (lookahead == '*') ADVANCE(7);
-      if (lookahead != 0) ADVANCE(8);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(9);
       END_STATE();
     case 9:
-      if (lookahead == '>') ADVANCE(107);
+      ACCEPT_TOKEN(sym_number);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(10);
+      if (lookahead == 'E') ADVANCE(11);
+      if (lookahead == '_') ADVANCE(17);
+      if (lookahead == 'e') ADVANCE(18);
       END_STATE();
     case 10:
-      if (lookahead == 'a') ADVANCE(30);
+      ACCEPT_TOKEN(sym_number);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(10);
+      if (lookahead == 'E') ADVANCE(11);
+      if (lookahead == '_') ADVANCE(17);
+      if (lookahead == 'e') ADVANCE(18);
       END_STATE();
     case 11:
-      if (lookahead == 'a') ADVANCE(24);
+      if (lookahead == '+') ADVANCE(12);
+      if (lookahead == '-') ADVANCE(16);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(13);
       END_STATE();
     case 12:
-      if (lookahead == 'a') ADVANCE(31);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(13);
       END_STATE();
     case 13:
-      if (lookahead == 'a') ADVANCE(54);
+      ACCEPT_TOKEN(sym_number);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(14);
+      if (lookahead == '_') ADVANCE(15);
       END_STATE();
     case 14:
-      if (lookahead == 'a') ADVANCE(35);
+      ACCEPT_TOKEN(sym_number);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(14);
+      if (lookahead == '_') ADVANCE(15);
       END_STATE();
     case 15:
-      if (lookahead == 'a') ADVANCE(26);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(14);
       END_STATE();
     case 16:
-      if (lookahead == 'c') ADVANCE(105);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(13);
       END_STATE();
     case 17:
-      if (lookahead == 'c') ADVANCE(60);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(10);
       END_STATE();
     case 18:
-      if (lookahead == 'e') ADVANCE(56);
+      if (lookahead == '+') ADVANCE(12);
+      if (lookahead == '-') ADVANCE(16);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(13);
       END_STATE();
     case 19:
-      if (lookahead == 'e') ADVANCE(101);
+      ACCEPT_TOKEN(sym_number);
+      if (lookahead == '.') ADVANCE(20);
+      if (lookahead == '0') ADVANCE(31);
+      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(34);
+      if (lookahead == 'B') ADVANCE(46);
+      if (lookahead == 'E') ADVANCE(37);
+      if (lookahead == 'O') ADVANCE(50);
+      if (lookahead == 'X') ADVANCE(54);
+      if (lookahead == '_') ADVANCE(32);
+      if (lookahead == 'b') ADVANCE(58);
+      if (lookahead == 'e') ADVANCE(44);
+      if (lookahead == 'n') ADVANCE(33);
+      if (lookahead == 'o') ADVANCE(59);
+      if (lookahead == 'x') ADVANCE(60);
       END_STATE();
     case 20:
-      if (lookahead == 'e') ADVANCE(52);
+      ACCEPT_TOKEN(sym_number);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(21);
+      if (lookahead == 'E') ADVANCE(23);
+      if (lookahead == 'e') ADVANCE(30);
       END_STATE();
     case 21:
-      if (lookahead == 'e') ADVANCE(40);
+      ACCEPT_TOKEN(sym_number);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(22);
+      if (lookahead == 'E') ADVANCE(23);
+      if (lookahead == '_') ADVANCE(29);
+      if (lookahead == 'e') ADVANCE(30);
       END_STATE();
     case 22:
-      if (lookahead == 'e') ADVANCE(14);
-      if (lookahead == 'u') ADVANCE(17);
+      ACCEPT_TOKEN(sym_number);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(22);
+      if (lookahead == 'E') ADVANCE(23);
+      if (lookahead == '_') ADVANCE(29);
+      if (lookahead == 'e') ADVANCE(30);
       END_STATE();
     case 23:
-      if (lookahead == 'e') ADVANCE(61);
+      if (lookahead == '+') ADVANCE(24);
+      if (lookahead == '-') ADVANCE(28);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(25);
       END_STATE();
     case 24:
-      if (lookahead == 'g') ADVANCE(100);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(25);
       END_STATE();
     case 25:
-      if (lookahead == 'g') ADVANCE(39);
+      ACCEPT_TOKEN(sym_number);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(26);
+      if (lookahead == '_') ADVANCE(27);
       END_STATE();
     case 26:
-      if (lookahead == 'g') ADVANCE(55);
+      ACCEPT_TOKEN(sym_number);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(26);
+      if (lookahead == '_') ADVANCE(27);
       END_STATE();
     case 27:
-      if (lookahead == 'h') ADVANCE(49);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(26);
       END_STATE();
     case 28:
-      if (lookahead == 'i') ADVANCE(25);
-      if (lookahead == 't') ADVANCE(48);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(25);
       END_STATE();
     case 29:
-      if (lookahead == 'i') ADVANCE(45);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(22);
       END_STATE();
     case 30:
-      if (lookahead == 'l') ADVANCE(109);
+      if (lookahead == '+') ADVANCE(24);
+      if (lookahead == '-') ADVANCE(28);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(25);
       END_STATE();
     case 31:
-      if (lookahead == 'l') ADVANCE(99);
+      ACCEPT_TOKEN(sym_number);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(31);
+      if (lookahead == '_') ADVANCE(32);
+      if (lookahead == 'n') ADVANCE(33);
       END_STATE();
     case 32:
-      if (lookahead == 'l') ADVANCE(11);
-      if (lookahead == 'u') ADVANCE(38);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(31);
       END_STATE();
     case 33:
-      if (lookahead == 'l') ADVANCE(15);
-      if (lookahead == 'u') ADVANCE(38);
+      ACCEPT_TOKEN(sym_number);
       END_STATE();
     case 34:
-      if (lookahead == 'm') ADVANCE(97);
+      ACCEPT_TOKEN(sym_number);
+      if (lookahead == '.') ADVANCE(20);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(35);
+      if (lookahead == 'E') ADVANCE(37);
+      if (lookahead == '_') ADVANCE(45);
+      if (lookahead == 'e') ADVANCE(44);
+      if (lookahead == 'n') ADVANCE(33);
       END_STATE();
     case 35:
-      if (lookahead == 'm') ADVANCE(91);
+      ACCEPT_TOKEN(sym_number);
+      if (lookahead == '.') ADVANCE(20);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(36);
+      if (lookahead == 'E') ADVANCE(37);
+      if (lookahead == '_') ADVANCE(43);
+      if (lookahead == 'e') ADVANCE(44);
+      if (lookahead == 'n') ADVANCE(33);
       END_STATE();
     case 36:
-      if (lookahead == 'm') ADVANCE(46);
+      ACCEPT_TOKEN(sym_number);
+      if (lookahead == '.') ADVANCE(20);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(36);
+      if (lookahead == 'E') ADVANCE(37);
+      if (lookahead == '_') ADVANCE(43);
+      if (lookahead == 'e') ADVANCE(44);
+      if (lookahead == 'n') ADVANCE(33);
       END_STATE();
     case 37:
-      if (lookahead == 'n') ADVANCE(63);
-      if (lookahead == 'v') ADVANCE(21);
+      if (lookahead == '+') ADVANCE(38);
+      if (lookahead == '-') ADVANCE(42);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(39);
       END_STATE();
     case 38:
-      if (lookahead == 'n') ADVANCE(16);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(39);
       END_STATE();
     case 39:
-      if (lookahead == 'n') ADVANCE(10);
+      ACCEPT_TOKEN(sym_number);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(40);
+      if (lookahead == '_') ADVANCE(41);
       END_STATE();
     case 40:
-      if (lookahead == 'n') ADVANCE(58);
+      ACCEPT_TOKEN(sym_number);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(40);
+      if (lookahead == '_') ADVANCE(41);
       END_STATE();
     case 41:
-      if (lookahead == 'n') ADVANCE(12);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(40);
       END_STATE();
     case 42:
-      if (lookahead == 'o') ADVANCE(118);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(39);
       END_STATE();
     case 43:
-      if (lookahead == 'o') ADVANCE(65);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(36);
       END_STATE();
     case 44:
-      if (lookahead == 'o') ADVANCE(50);
+      if (lookahead == '+') ADVANCE(38);
+      if (lookahead == '-') ADVANCE(42);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(39);
       END_STATE();
     case 45:
-      if (lookahead == 'o') ADVANCE(41);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(35);
       END_STATE();
     case 46:
-      if (lookahead == 'p') ADVANCE(44);
+      if (lookahead == '0' ||
+          lookahead == '1') ADVANCE(47);
       END_STATE();
     case 47:
-      if (lookahead == 'p') ADVANCE(57);
+      ACCEPT_TOKEN(sym_number);
+      if (lookahead == '0' ||
+          lookahead == '1') ADVANCE(48);
+      if (lookahead == '_') ADVANCE(49);
+      if (lookahead == 'n') ADVANCE(33);
       END_STATE();
     case 48:
-      if (lookahead == 'r') ADVANCE(22);
+      ACCEPT_TOKEN(sym_number);
+      if (lookahead == '0' ||
+          lookahead == '1') ADVANCE(48);
+      if (lookahead == '_') ADVANCE(49);
+      if (lookahead == 'n') ADVANCE(33);
       END_STATE();
     case 49:
-      if (lookahead == 'r') ADVANCE(43);
+      if (lookahead == '0' ||
+          lookahead == '1') ADVANCE(48);
       END_STATE();
     case 50:
-      if (lookahead == 'r') ADVANCE(59);
+      if (('0' <= lookahead && lookahead <= '7')) ADVANCE(51);
       END_STATE();
     case 51:
-      if (lookahead == 's') ADVANCE(90);
+      ACCEPT_TOKEN(sym_number);
+      if (('0' <= lookahead && lookahead <= '7')) ADVANCE(52);
+      if (lookahead == '_') ADVANCE(53);
+      if (lookahead == 'n') ADVANCE(33);
       END_STATE();
     case 52:
-      if (lookahead == 's') ADVANCE(116);
+      ACCEPT_TOKEN(sym_number);
+      if (('0' <= lookahead && lookahead <= '7')) ADVANCE(52);
+      if (lookahead == '_') ADVANCE(53);
+      if (lookahead == 'n') ADVANCE(33);
       END_STATE();
     case 53:
-      if (lookahead == 's') ADVANCE(106);
+      if (('0' <= lookahead && lookahead <= '7')) ADVANCE(52);
       END_STATE();
     case 54:
-      if (lookahead == 's') ADVANCE(19);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(55);
       END_STATE();
     case 55:
-      if (lookahead == 's') ADVANCE(23);
+      ACCEPT_TOKEN(sym_number);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(56);
+      if (lookahead == '_') ADVANCE(57);
+      if (lookahead == 'n') ADVANCE(33);
       END_STATE();
     case 56:
-      if (lookahead == 't') ADVANCE(95);
+      ACCEPT_TOKEN(sym_number);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(56);
+      if (lookahead == '_') ADVANCE(57);
+      if (lookahead == 'n') ADVANCE(33);
       END_STATE();
     case 57:
-      if (lookahead == 't') ADVANCE(29);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(56);
       END_STATE();
     case 58:
-      if (lookahead == 't') ADVANCE(108);
+      if (lookahead == '0' ||
+          lookahead == '1') ADVANCE(47);
       END_STATE();
     case 59:
-      if (lookahead == 't') ADVANCE(89);
+      if (('0' <= lookahead && lookahead <= '7')) ADVANCE(51);
       END_STATE();
     case 60:
-      if (lookahead == 't') ADVANCE(94);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(55);
       END_STATE();
     case 61:
-      if (lookahead == 't') ADVANCE(98);
+      ACCEPT_TOKEN(sym_number);
+      if (lookahead == '.') ADVANCE(20);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(35);
+      if (lookahead == 'E') ADVANCE(37);
+      if (lookahead == '_') ADVANCE(45);
+      if (lookahead == 'e') ADVANCE(44);
+      if (lookahead == 'n') ADVANCE(33);
       END_STATE();
     case 62:
-      if (lookahead == 'u') ADVANCE(66);
-      if (lookahead == 'x') ADVANCE(83);
-      if (('0' <= lookahead && lookahead <= '7')) ADVANCE(138);
-      if (lookahead != 0) ADVANCE(136);
+      ACCEPT_TOKEN(anon_sym_DASH_GT);
       END_STATE();
     case 63:
-      if (lookahead == 'u') ADVANCE(34);
+      ACCEPT_TOKEN(anon_sym_DOT);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(9);
       END_STATE();
     case 64:
-      if (lookahead == 'u') ADVANCE(67);
+      if (lookahead == '*') ADVANCE(65);
+      if (lookahead == '/') ADVANCE(72);
       END_STATE();
     case 65:
-      if (lookahead == 'w') ADVANCE(53);
+      if ((1 <= lookahead && lookahead <= ')') ||
+          ('+' <= lookahead && lookahead <= 1114111)) ADVANCE(66);
+      if (lookahead == '*') ADVANCE(67);
       END_STATE();
     case 66:
-      if (lookahead == '{') ADVANCE(79);
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(81);
+      if ((1 <= lookahead && lookahead <= ')') ||
+          ('+' <= lookahead && lookahead <= 1114111)) ADVANCE(66);
+      if (lookahead == '*') ADVANCE(67);
       END_STATE();
     case 67:
-      if (lookahead == '{') ADVANCE(82);
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(85);
+      if ((1 <= lookahead && lookahead <= ')') ||
+          ('+' <= lookahead && lookahead <= '.') ||
+          ('0' <= lookahead && lookahead <= 1114111)) ADVANCE(68);
+      if (lookahead == '*') ADVANCE(67);
+      if (lookahead == '/') ADVANCE(71);
       END_STATE();
     case 68:
-      if (lookahead == '}') ADVANCE(136);
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(68);
+      if ((1 <= lookahead && lookahead <= ')') ||
+          ('+' <= lookahead && lookahead <= 1114111)) ADVANCE(69);
+      if (lookahead == '*') ADVANCE(70);
       END_STATE();
     case 69:
-      if (lookahead == '}') ADVANCE(152);
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(69);
+      if ((1 <= lookahead && lookahead <= ')') ||
+          ('+' <= lookahead && lookahead <= 1114111)) ADVANCE(69);
+      if (lookahead == '*') ADVANCE(70);
       END_STATE();
     case 70:
-      if (lookahead == '+' ||
-          lookahead == '-') ADVANCE(76);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(146);
+      if ((1 <= lookahead && lookahead <= ')') ||
+          ('+' <= lookahead && lookahead <= '.') ||
+          ('0' <= lookahead && lookahead <= 1114111)) ADVANCE(68);
+      if (lookahead == '*') ADVANCE(70);
+      if (lookahead == '/') ADVANCE(71);
       END_STATE();
     case 71:
-      if (lookahead == '0' ||
-          lookahead == '1') ADVANCE(142);
+      ACCEPT_TOKEN(sym_comment);
       END_STATE();
     case 72:
-      if (('0' <= lookahead && lookahead <= '7')) ADVANCE(143);
+      ACCEPT_TOKEN(sym_comment);
+      if ((1 <= lookahead && lookahead <= '\t') ||
+          (11 <= lookahead && lookahead <= 1114111)) ADVANCE(73);
       END_STATE();
     case 73:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(141);
+      ACCEPT_TOKEN(sym_comment);
+      if ((1 <= lookahead && lookahead <= '\t') ||
+          (11 <= lookahead && lookahead <= 1114111)) ADVANCE(73);
       END_STATE();
     case 74:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(147);
+      ACCEPT_TOKEN(anon_sym_COLON);
       END_STATE();
     case 75:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(145);
+      ACCEPT_TOKEN(anon_sym_SEMI);
       END_STATE();
     case 76:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(146);
+      ACCEPT_TOKEN(anon_sym_EQ);
       END_STATE();
     case 77:
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(136);
+      ACCEPT_TOKEN(anon_sym_QMARK);
       END_STATE();
     case 78:
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(144);
+      ACCEPT_TOKEN(anon_sym_AT);
       END_STATE();
     case 79:
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(68);
+      if (lookahead == 'o') ADVANCE(80);
       END_STATE();
     case 80:
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(152);
+      ACCEPT_TOKEN(anon_sym_No);
       END_STATE();
     case 81:
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(83);
+      if (lookahead == 'e') ADVANCE(82);
       END_STATE();
     case 82:
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(69);
+      if (lookahead == 's') ADVANCE(83);
       END_STATE();
     case 83:
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(77);
+      ACCEPT_TOKEN(anon_sym_Yes);
       END_STATE();
     case 84:
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(80);
+      ACCEPT_TOKEN(anon_sym_LBRACK);
       END_STATE();
     case 85:
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(84);
+      if ((1 <= lookahead && lookahead <= '/') ||
+          ('8' <= lookahead && lookahead <= 't') ||
+          lookahead == 'v' ||
+          lookahead == 'w' ||
+          ('y' <= lookahead && lookahead <= 1114111)) ADVANCE(86);
+      if (('0' <= lookahead && lookahead <= '7')) ADVANCE(87);
+      if (lookahead == 'u') ADVANCE(90);
+      if (lookahead == 'x') ADVANCE(98);
       END_STATE();
     case 86:
-      if (eof) ADVANCE(88);
-      if (lookahead == '"') ADVANCE(120);
-      if (lookahead == '\'') ADVANCE(121);
-      if (lookahead == '(') ADVANCE(102);
-      if (lookahead == ')') ADVANCE(104);
-      if (lookahead == ',') ADVANCE(103);
-      if (lookahead == '-') ADVANCE(9);
-      if (lookahead == '.') ADVANCE(113);
-      if (lookahead == '/') ADVANCE(6);
-      if (lookahead == '0') ADVANCE(140);
-      if (lookahead == ':') ADVANCE(96);
-      if (lookahead == ';') ADVANCE(153);
-      if (lookahead == '?') ADVANCE(114);
-      if (lookahead == '@') ADVANCE(115);
-      if (lookahead == 'N') ADVANCE(42);
-      if (lookahead == 'Y') ADVANCE(20);
-      if (lookahead == '[') ADVANCE(110);
-      if (lookahead == ']') ADVANCE(111);
-      if (lookahead == 'a') ADVANCE(51);
-      if (lookahead == 'c') ADVANCE(13);
-      if (lookahead == 'e') ADVANCE(37);
-      if (lookahead == 'f') ADVANCE(32);
-      if (lookahead == 'i') ADVANCE(36);
-      if (lookahead == 'l') ADVANCE(18);
-      if (lookahead == 'o') ADVANCE(47);
-      if (lookahead == 's') ADVANCE(28);
-      if (lookahead == 't') ADVANCE(27);
-      if (lookahead == '{') ADVANCE(92);
-      if (lookahead == '}') ADVANCE(93);
-      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(141);
-      if (anon_sym_DQUOTE_character_set_1(lookahead)) SKIP(86)
+      ACCEPT_TOKEN(sym_escape_sequence);
       END_STATE();
     case 87:
-      if (eof) ADVANCE(88);
-      if (lookahead == '"') ADVANCE(120);
-      if (lookahead == '\'') ADVANCE(121);
-      if (lookahead == ')') ADVANCE(104);
-      if (lookahead == '.') ADVANCE(74);
-      if (lookahead == '/') ADVANCE(6);
-      if (lookahead == '0') ADVANCE(140);
-      if (lookahead == '@') ADVANCE(115);
-      if (lookahead == 'N') ADVANCE(42);
-      if (lookahead == 'Y') ADVANCE(20);
-      if (lookahead == '[') ADVANCE(110);
-      if (lookahead == 'c') ADVANCE(13);
-      if (lookahead == 'e') ADVANCE(37);
-      if (lookahead == 'f') ADVANCE(33);
-      if (lookahead == 'i') ADVANCE(36);
-      if (lookahead == 'l') ADVANCE(18);
-      if (lookahead == 's') ADVANCE(28);
-      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(141);
-      if (anon_sym_DQUOTE_character_set_1(lookahead)) SKIP(87)
+      ACCEPT_TOKEN(sym_escape_sequence);
+      if (('0' <= lookahead && lookahead <= '7')) ADVANCE(88);
       END_STATE();
     case 88:
-      ACCEPT_TOKEN(ts_builtin_sym_end);
+      ACCEPT_TOKEN(sym_escape_sequence);
+      if (('0' <= lookahead && lookahead <= '7')) ADVANCE(89);
       END_STATE();
     case 89:
-      ACCEPT_TOKEN(anon_sym_import);
+      ACCEPT_TOKEN(sym_escape_sequence);
       END_STATE();
     case 90:
-      ACCEPT_TOKEN(anon_sym_as);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(91);
+      if (lookahead == '{') ADVANCE(95);
       END_STATE();
     case 91:
-      ACCEPT_TOKEN(anon_sym_stream);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(92);
       END_STATE();
     case 92:
-      ACCEPT_TOKEN(anon_sym_LBRACE);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(93);
       END_STATE();
     case 93:
-      ACCEPT_TOKEN(anon_sym_RBRACE);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(94);
       END_STATE();
     case 94:
-      ACCEPT_TOKEN(anon_sym_struct);
+      ACCEPT_TOKEN(sym_escape_sequence);
       END_STATE();
     case 95:
-      ACCEPT_TOKEN(anon_sym_let);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(96);
       END_STATE();
     case 96:
-      ACCEPT_TOKEN(anon_sym_COLON);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(96);
+      if (lookahead == '}') ADVANCE(97);
       END_STATE();
     case 97:
-      ACCEPT_TOKEN(anon_sym_enum);
+      ACCEPT_TOKEN(sym_escape_sequence);
       END_STATE();
     case 98:
-      ACCEPT_TOKEN(anon_sym_flagset);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(99);
       END_STATE();
     case 99:
-      ACCEPT_TOKEN(sym_optional);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(100);
       END_STATE();
     case 100:
-      ACCEPT_TOKEN(anon_sym_flag);
+      ACCEPT_TOKEN(sym_escape_sequence);
       END_STATE();
     case 101:
-      ACCEPT_TOKEN(anon_sym_case);
+      ACCEPT_TOKEN(anon_sym_RBRACK);
       END_STATE();
     case 102:
-      ACCEPT_TOKEN(anon_sym_LPAREN);
+      if (lookahead == 's') ADVANCE(103);
       END_STATE();
     case 103:
-      ACCEPT_TOKEN(anon_sym_COMMA);
+      ACCEPT_TOKEN(anon_sym_as);
       END_STATE();
     case 104:
-      ACCEPT_TOKEN(anon_sym_RPAREN);
+      if (lookahead == 'a') ADVANCE(105);
+      if (lookahead == 'o') ADVANCE(108);
       END_STATE();
     case 105:
-      ACCEPT_TOKEN(anon_sym_func);
+      if (lookahead == 's') ADVANCE(106);
       END_STATE();
     case 106:
-      ACCEPT_TOKEN(anon_sym_throws);
+      if (lookahead == 'e') ADVANCE(107);
       END_STATE();
     case 107:
-      ACCEPT_TOKEN(anon_sym_DASH_GT);
+      ACCEPT_TOKEN(anon_sym_case);
       END_STATE();
     case 108:
-      ACCEPT_TOKEN(anon_sym_event);
+      if (lookahead == 'n') ADVANCE(109);
       END_STATE();
     case 109:
-      ACCEPT_TOKEN(anon_sym_signal);
+      if (lookahead == 's') ADVANCE(110);
       END_STATE();
     case 110:
-      ACCEPT_TOKEN(anon_sym_LBRACK);
+      if (lookahead == 't') ADVANCE(111);
       END_STATE();
     case 111:
-      ACCEPT_TOKEN(anon_sym_RBRACK);
+      ACCEPT_TOKEN(anon_sym_const);
       END_STATE();
     case 112:
-      ACCEPT_TOKEN(anon_sym_DOT);
+      if (lookahead == 'n') ADVANCE(113);
+      if (lookahead == 'v') ADVANCE(116);
       END_STATE();
     case 113:
-      ACCEPT_TOKEN(anon_sym_DOT);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(147);
+      if (lookahead == 'u') ADVANCE(114);
       END_STATE();
     case 114:
-      ACCEPT_TOKEN(anon_sym_QMARK);
+      if (lookahead == 'm') ADVANCE(115);
       END_STATE();
     case 115:
-      ACCEPT_TOKEN(anon_sym_AT);
+      ACCEPT_TOKEN(anon_sym_enum);
       END_STATE();
     case 116:
-      ACCEPT_TOKEN(anon_sym_Yes);
+      if (lookahead == 'e') ADVANCE(117);
       END_STATE();
     case 117:
-      ACCEPT_TOKEN(anon_sym_Yes);
-      if (lookahead == '\\') ADVANCE(64);
-      if (!sym_identifier_character_set_1(lookahead)) ADVANCE(152);
+      if (lookahead == 'n') ADVANCE(118);
       END_STATE();
     case 118:
-      ACCEPT_TOKEN(anon_sym_No);
+      if (lookahead == 't') ADVANCE(119);
       END_STATE();
     case 119:
-      ACCEPT_TOKEN(anon_sym_No);
-      if (lookahead == '\\') ADVANCE(64);
-      if (!sym_identifier_character_set_1(lookahead)) ADVANCE(152);
+      ACCEPT_TOKEN(anon_sym_event);
       END_STATE();
     case 120:
-      ACCEPT_TOKEN(anon_sym_DQUOTE);
+      if (lookahead == 'l') ADVANCE(121);
+      if (lookahead == 'u') ADVANCE(127);
       END_STATE();
     case 121:
-      ACCEPT_TOKEN(anon_sym_SQUOTE);
+      if (lookahead == 'a') ADVANCE(122);
       END_STATE();
     case 122:
-      ACCEPT_TOKEN(sym_comment);
+      if (lookahead == 'g') ADVANCE(123);
       END_STATE();
     case 123:
-      ACCEPT_TOKEN(sym_comment);
-      if (lookahead != 0 &&
-          lookahead != '\n') ADVANCE(123);
+      ACCEPT_TOKEN(anon_sym_flag);
+      if (lookahead == 's') ADVANCE(124);
       END_STATE();
     case 124:
-      ACCEPT_TOKEN(sym_unescaped_double_string_fragment);
-      if (lookahead == '\n') ADVANCE(129);
-      if (lookahead != 0 &&
-          lookahead != '"' &&
-          lookahead != '\\') ADVANCE(124);
+      if (lookahead == 'e') ADVANCE(125);
       END_STATE();
     case 125:
-      ACCEPT_TOKEN(sym_unescaped_double_string_fragment);
-      if (lookahead == '*') ADVANCE(127);
-      if (lookahead == '/') ADVANCE(124);
-      if (lookahead != 0 &&
-          lookahead != '"' &&
-          lookahead != '\\') ADVANCE(129);
+      if (lookahead == 't') ADVANCE(126);
       END_STATE();
     case 126:
-      ACCEPT_TOKEN(sym_unescaped_double_string_fragment);
-      if (lookahead == '*') ADVANCE(126);
-      if (lookahead == '/') ADVANCE(129);
-      if (lookahead != 0 &&
-          lookahead != '"' &&
-          lookahead != '\\') ADVANCE(127);
+      ACCEPT_TOKEN(anon_sym_flagset);
       END_STATE();
     case 127:
-      ACCEPT_TOKEN(sym_unescaped_double_string_fragment);
-      if (lookahead == '*') ADVANCE(126);
-      if (lookahead != 0 &&
-          lookahead != '"' &&
-          lookahead != '\\') ADVANCE(127);
+      if (lookahead == 'n') ADVANCE(128);
       END_STATE();
     case 128:
-      ACCEPT_TOKEN(sym_unescaped_double_string_fragment);
-      if (lookahead == '/') ADVANCE(125);
-      if (anon_sym_DQUOTE_character_set_1(lookahead)) ADVANCE(128);
-      if (lookahead != 0 &&
-          lookahead != '"' &&
-          lookahead != '\\') ADVANCE(129);
+      if (lookahead == 'c') ADVANCE(129);
       END_STATE();
     case 129:
-      ACCEPT_TOKEN(sym_unescaped_double_string_fragment);
-      if (lookahead != 0 &&
-          lookahead != '"' &&
-          lookahead != '\\') ADVANCE(129);
+      ACCEPT_TOKEN(anon_sym_func);
       END_STATE();
     case 130:
-      ACCEPT_TOKEN(sym_unescaped_single_string_fragment);
-      if (lookahead == '\n') ADVANCE(135);
-      if (lookahead != 0 &&
-          lookahead != '\'' &&
-          lookahead != '\\') ADVANCE(130);
+      if (lookahead == 'm') ADVANCE(131);
       END_STATE();
     case 131:
-      ACCEPT_TOKEN(sym_unescaped_single_string_fragment);
-      if (lookahead == '*') ADVANCE(133);
-      if (lookahead == '/') ADVANCE(130);
-      if (lookahead != 0 &&
-          lookahead != '\'' &&
-          lookahead != '\\') ADVANCE(135);
+      if (lookahead == 'p') ADVANCE(132);
       END_STATE();
     case 132:
-      ACCEPT_TOKEN(sym_unescaped_single_string_fragment);
-      if (lookahead == '*') ADVANCE(132);
-      if (lookahead == '/') ADVANCE(135);
-      if (lookahead != 0 &&
-          lookahead != '\'' &&
-          lookahead != '\\') ADVANCE(133);
+      if (lookahead == 'o') ADVANCE(133);
       END_STATE();
     case 133:
-      ACCEPT_TOKEN(sym_unescaped_single_string_fragment);
-      if (lookahead == '*') ADVANCE(132);
-      if (lookahead != 0 &&
-          lookahead != '\'' &&
-          lookahead != '\\') ADVANCE(133);
+      if (lookahead == 'r') ADVANCE(134);
       END_STATE();
     case 134:
-      ACCEPT_TOKEN(sym_unescaped_single_string_fragment);
-      if (lookahead == '/') ADVANCE(131);
-      if (anon_sym_DQUOTE_character_set_1(lookahead)) ADVANCE(134);
-      if (lookahead != 0 &&
-          lookahead != '\'' &&
-          lookahead != '\\') ADVANCE(135);
+      if (lookahead == 't') ADVANCE(135);
       END_STATE();
     case 135:
-      ACCEPT_TOKEN(sym_unescaped_single_string_fragment);
-      if (lookahead != 0 &&
-          lookahead != '\'' &&
-          lookahead != '\\') ADVANCE(135);
+      ACCEPT_TOKEN(anon_sym_import);
       END_STATE();
     case 136:
-      ACCEPT_TOKEN(sym_escape_sequence);
+      if (lookahead == 'e') ADVANCE(137);
       END_STATE();
     case 137:
-      ACCEPT_TOKEN(sym_escape_sequence);
-      if (('0' <= lookahead && lookahead <= '7')) ADVANCE(136);
+      if (lookahead == 't') ADVANCE(138);
       END_STATE();
     case 138:
-      ACCEPT_TOKEN(sym_escape_sequence);
-      if (('0' <= lookahead && lookahead <= '7')) ADVANCE(137);
+      ACCEPT_TOKEN(anon_sym_let);
       END_STATE();
     case 139:
-      ACCEPT_TOKEN(sym_number);
+      if (lookahead == 'e') ADVANCE(140);
       END_STATE();
     case 140:
-      ACCEPT_TOKEN(sym_number);
-      if (lookahead == '.') ADVANCE(148);
-      if (lookahead == '0') ADVANCE(145);
-      if (lookahead == 'B' ||
-          lookahead == 'b') ADVANCE(71);
-      if (lookahead == 'E' ||
-          lookahead == 'e') ADVANCE(70);
-      if (lookahead == 'O' ||
-          lookahead == 'o') ADVANCE(72);
-      if (lookahead == 'X' ||
-          lookahead == 'x') ADVANCE(78);
-      if (lookahead == '_') ADVANCE(75);
-      if (lookahead == 'n') ADVANCE(139);
-      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(141);
+      if (lookahead == 'w') ADVANCE(141);
       END_STATE();
     case 141:
-      ACCEPT_TOKEN(sym_number);
-      if (lookahead == '.') ADVANCE(148);
-      if (lookahead == 'E' ||
-          lookahead == 'e') ADVANCE(70);
-      if (lookahead == '_') ADVANCE(73);
-      if (lookahead == 'n') ADVANCE(139);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(141);
+      if (lookahead == 't') ADVANCE(142);
       END_STATE();
     case 142:
-      ACCEPT_TOKEN(sym_number);
-      if (lookahead == '_') ADVANCE(71);
-      if (lookahead == 'n') ADVANCE(139);
-      if (lookahead == '0' ||
-          lookahead == '1') ADVANCE(142);
+      if (lookahead == 'y') ADVANCE(143);
       END_STATE();
     case 143:
-      ACCEPT_TOKEN(sym_number);
-      if (lookahead == '_') ADVANCE(72);
-      if (lookahead == 'n') ADVANCE(139);
-      if (('0' <= lookahead && lookahead <= '7')) ADVANCE(143);
+      if (lookahead == 'p') ADVANCE(144);
       END_STATE();
     case 144:
-      ACCEPT_TOKEN(sym_number);
-      if (lookahead == '_') ADVANCE(78);
-      if (lookahead == 'n') ADVANCE(139);
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(144);
+      if (lookahead == 'e') ADVANCE(145);
       END_STATE();
     case 145:
-      ACCEPT_TOKEN(sym_number);
-      if (lookahead == '_') ADVANCE(75);
-      if (lookahead == 'n') ADVANCE(139);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(145);
+      ACCEPT_TOKEN(anon_sym_newtype);
       END_STATE();
     case 146:
-      ACCEPT_TOKEN(sym_number);
-      if (lookahead == '_') ADVANCE(76);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(146);
+      if (lookahead == 'p') ADVANCE(147);
       END_STATE();
     case 147:
-      ACCEPT_TOKEN(sym_number);
-      if (lookahead == 'E' ||
-          lookahead == 'e') ADVANCE(70);
-      if (lookahead == '_') ADVANCE(74);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(147);
+      if (lookahead == 't') ADVANCE(148);
       END_STATE();
     case 148:
-      ACCEPT_TOKEN(sym_number);
-      if (lookahead == 'E' ||
-          lookahead == 'e') ADVANCE(70);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(147);
+      if (lookahead == 'i') ADVANCE(149);
       END_STATE();
     case 149:
-      ACCEPT_TOKEN(sym_identifier);
-      if (lookahead == '\\') ADVANCE(64);
-      if (lookahead == 'e') ADVANCE(151);
-      if (!sym_identifier_character_set_1(lookahead)) ADVANCE(152);
+      if (lookahead == 'o') ADVANCE(150);
       END_STATE();
     case 150:
-      ACCEPT_TOKEN(sym_identifier);
-      if (lookahead == '\\') ADVANCE(64);
-      if (lookahead == 'o') ADVANCE(119);
-      if (!sym_identifier_character_set_1(lookahead)) ADVANCE(152);
+      if (lookahead == 'n') ADVANCE(151);
       END_STATE();
     case 151:
-      ACCEPT_TOKEN(sym_identifier);
-      if (lookahead == '\\') ADVANCE(64);
-      if (lookahead == 's') ADVANCE(117);
-      if (!sym_identifier_character_set_1(lookahead)) ADVANCE(152);
+      if (lookahead == 'a') ADVANCE(152);
       END_STATE();
     case 152:
-      ACCEPT_TOKEN(sym_identifier);
-      if (lookahead == '\\') ADVANCE(64);
-      if (!sym_identifier_character_set_1(lookahead)) ADVANCE(152);
+      if (lookahead == 'l') ADVANCE(153);
       END_STATE();
     case 153:
-      ACCEPT_TOKEN(anon_sym_SEMI);
+      ACCEPT_TOKEN(sym_optional);
       END_STATE();
-    default:
-      return false;
-  }
-}
-
-static const TSLexMode ts_lex_modes[STATE_COUNT] = {
-  [0] = {.lex_state = 0, .external_lex_state = 1},
-  [1] = {.lex_state = 87},
-  [2] = {.lex_state = 87},
-  [3] = {.lex_state = 87},
-  [4] = {.lex_state = 1},
-  [5] = {.lex_state = 87},
-  [6] = {.lex_state = 87},
-  [7] = {.lex_state = 87},
-  [8] = {.lex_state = 87},
-  [9] = {.lex_state = 87},
-  [10] = {.lex_state = 87},
-  [11] = {.lex_state = 87},
-  [12] = {.lex_state = 87},
-  [13] = {.lex_state = 87},
-  [14] = {.lex_state = 87},
-  [15] = {.lex_state = 4},
-  [16] = {.lex_state = 0},
-  [17] = {.lex_state = 0},
-  [18] = {.lex_state = 0},
-  [19] = {.lex_state = 4},
-  [20] = {.lex_state = 4},
-  [21] = {.lex_state = 0},
-  [22] = {.lex_state = 4},
-  [23] = {.lex_state = 0},
-  [24] = {.lex_state = 87},
-  [25] = {.lex_state = 87},
-  [26] = {.lex_state = 87},
-  [27] = {.lex_state = 87},
-  [28] = {.lex_state = 87},
-  [29] = {.lex_state = 87},
-  [30] = {.lex_state = 87},
-  [31] = {.lex_state = 87},
-  [32] = {.lex_state = 87},
-  [33] = {.lex_state = 87},
-  [34] = {.lex_state = 87},
-  [35] = {.lex_state = 87},
-  [36] = {.lex_state = 87},
-  [37] = {.lex_state = 87},
-  [38] = {.lex_state = 87},
-  [39] = {.lex_state = 87},
-  [40] = {.lex_state = 87},
-  [41] = {.lex_state = 87},
-  [42] = {.lex_state = 87},
-  [43] = {.lex_state = 87},
-  [44] = {.lex_state = 87},
-  [45] = {.lex_state = 87},
-  [46] = {.lex_state = 87},
-  [47] = {.lex_state = 87},
-  [48] = {.lex_state = 87},
-  [49] = {.lex_state = 87},
-  [50] = {.lex_state = 87},
-  [51] = {.lex_state = 87},
-  [52] = {.lex_state = 87},
-  [53] = {.lex_state = 87},
-  [54] = {.lex_state = 87},
-  [55] = {.lex_state = 87},
-  [56] = {.lex_state = 87},
-  [57] = {.lex_state = 87},
-  [58] = {.lex_state = 87},
-  [59] = {.lex_state = 87},
-  [60] = {.lex_state = 87},
-  [61] = {.lex_state = 87},
-  [62] = {.lex_state = 87},
-  [63] = {.lex_state = 87},
-  [64] = {.lex_state = 87},
-  [65] = {.lex_state = 87},
-  [66] = {.lex_state = 87},
-  [67] = {.lex_state = 87},
-  [68] = {.lex_state = 87},
-  [69] = {.lex_state = 87},
-  [70] = {.lex_state = 87},
-  [71] = {.lex_state = 0},
-  [72] = {.lex_state = 0},
-  [73] = {.lex_state = 0},
-  [74] = {.lex_state = 0},
-  [75] = {.lex_state = 0},
-  [76] = {.lex_state = 0},
-  [77] = {.lex_state = 0},
-  [78] = {.lex_state = 0},
-  [79] = {.lex_state = 0},
-  [80] = {.lex_state = 0},
-  [81] = {.lex_state = 0},
-  [82] = {.lex_state = 0},
-  [83] = {.lex_state = 0},
-  [84] = {.lex_state = 0},
-  [85] = {.lex_state = 0},
-  [86] = {.lex_state = 0},
-  [87] = {.lex_state = 0},
-  [88] = {.lex_state = 0},
-  [89] = {.lex_state = 0},
-  [90] = {.lex_state = 4, .external_lex_state = 2},
-  [91] = {.lex_state = 5},
-  [92] = {.lex_state = 5},
-  [93] = {.lex_state = 5},
-  [94] = {.lex_state = 5},
-  [95] = {.lex_state = 4, .external_lex_state = 2},
-  [96] = {.lex_state = 4, .external_lex_state = 2},
-  [97] = {.lex_state = 5},
-  [98] = {.lex_state = 5},
-  [99] = {.lex_state = 4, .external_lex_state = 2},
-  [100] = {.lex_state = 5},
-  [101] = {.lex_state = 5},
-  [102] = {.lex_state = 4, .external_lex_state = 2},
-  [103] = {.lex_state = 4, .external_lex_state = 2},
-  [104] = {.lex_state = 0, .external_lex_state = 2},
-  [105] = {.lex_state = 4, .external_lex_state = 2},
-  [106] = {.lex_state = 4, .external_lex_state = 2},
-  [107] = {.lex_state = 5},
-  [108] = {.lex_state = 0, .external_lex_state = 2},
-  [109] = {.lex_state = 4, .external_lex_state = 2},
-  [110] = {.lex_state = 0, .external_lex_state = 2},
-  [111] = {.lex_state = 0, .external_lex_state = 2},
-  [112] = {.lex_state = 4, .external_lex_state = 2},
-  [113] = {.lex_state = 4, .external_lex_state = 2},
-  [114] = {.lex_state = 4, .external_lex_state = 2},
-  [115] = {.lex_state = 4},
-  [116] = {.lex_state = 4, .external_lex_state = 2},
-  [117] = {.lex_state = 4, .external_lex_state = 2},
-  [118] = {.lex_state = 4, .external_lex_state = 2},
-  [119] = {.lex_state = 4, .external_lex_state = 2},
-  [120] = {.lex_state = 4, .external_lex_state = 2},
-  [121] = {.lex_state = 0},
-  [122] = {.lex_state = 4, .external_lex_state = 2},
-  [123] = {.lex_state = 4, .external_lex_state = 2},
-  [124] = {.lex_state = 4, .external_lex_state = 2},
-  [125] = {.lex_state = 4, .external_lex_state = 2},
-  [126] = {.lex_state = 0, .external_lex_state = 2},
-  [127] = {.lex_state = 4, .external_lex_state = 2},
-  [128] = {.lex_state = 4},
-  [129] = {.lex_state = 0, .external_lex_state = 2},
-  [130] = {.lex_state = 0},
-  [131] = {.lex_state = 4},
-  [132] = {.lex_state = 4},
-  [133] = {.lex_state = 5},
-  [134] = {.lex_state = 0},
-  [135] = {.lex_state = 0},
-  [136] = {.lex_state = 2},
-  [137] = {.lex_state = 3},
-  [138] = {.lex_state = 0},
-  [139] = {.lex_state = 0},
-  [140] = {.lex_state = 0},
-  [141] = {.lex_state = 0},
-  [142] = {.lex_state = 0},
-  [143] = {.lex_state = 4},
-  [144] = {.lex_state = 0},
-  [145] = {.lex_state = 0},
-  [146] = {.lex_state = 2},
-  [147] = {.lex_state = 3},
-  [148] = {.lex_state = 0},
-  [149] = {.lex_state = 0},
-  [150] = {.lex_state = 0},
-  [151] = {.lex_state = 0},
-  [152] = {.lex_state = 0},
-  [153] = {.lex_state = 2},
-  [154] = {.lex_state = 3},
-  [155] = {.lex_state = 0},
-  [156] = {.lex_state = 0},
-  [157] = {.lex_state = 4},
-  [158] = {.lex_state = 0},
-  [159] = {.lex_state = 0},
-  [160] = {.lex_state = 0},
-  [161] = {.lex_state = 0},
-  [162] = {.lex_state = 5},
-  [163] = {.lex_state = 0, .external_lex_state = 2},
-  [164] = {.lex_state = 0},
-  [165] = {.lex_state = 0},
-  [166] = {.lex_state = 5},
-  [167] = {.lex_state = 5},
-  [168] = {.lex_state = 5},
-  [169] = {.lex_state = 5},
-  [170] = {.lex_state = 0},
-  [171] = {.lex_state = 5},
-  [172] = {.lex_state = 5},
-  [173] = {.lex_state = 5},
-  [174] = {.lex_state = 0},
-  [175] = {.lex_state = 0, .external_lex_state = 2},
-  [176] = {.lex_state = 0},
-  [177] = {.lex_state = 0, .external_lex_state = 2},
-  [178] = {.lex_state = 0},
-  [179] = {.lex_state = 0},
-  [180] = {.lex_state = 0},
-  [181] = {.lex_state = 4},
-  [182] = {.lex_state = 5},
-  [183] = {.lex_state = 0},
-  [184] = {.lex_state = 0},
-  [185] = {.lex_state = 0},
-  [186] = {.lex_state = 0},
-  [187] = {.lex_state = 5},
-  [188] = {.lex_state = 0},
-  [189] = {.lex_state = 0},
-  [190] = {.lex_state = 0},
-  [191] = {.lex_state = 0},
-  [192] = {.lex_state = 0},
-  [193] = {.lex_state = 0},
-  [194] = {.lex_state = 5},
-  [195] = {.lex_state = 5},
-  [196] = {.lex_state = 0},
-  [197] = {.lex_state = 0},
-  [198] = {.lex_state = 0},
-  [199] = {.lex_state = 5},
-  [200] = {.lex_state = 5},
-  [201] = {.lex_state = 5},
-  [202] = {.lex_state = 5},
-  [203] = {.lex_state = 0},
-  [204] = {.lex_state = 0, .external_lex_state = 2},
-  [205] = {.lex_state = 0},
-  [206] = {.lex_state = 0, .external_lex_state = 2},
-  [207] = {.lex_state = 0},
-  [208] = {.lex_state = 0, .external_lex_state = 2},
-  [209] = {.lex_state = 0},
-  [210] = {.lex_state = 0, .external_lex_state = 2},
-  [211] = {.lex_state = 0},
-  [212] = {.lex_state = 0, .external_lex_state = 2},
-  [213] = {.lex_state = 0},
-  [214] = {.lex_state = 0},
-  [215] = {.lex_state = 0},
-  [216] = {.lex_state = 0},
-  [217] = {.lex_state = 5},
-  [218] = {.lex_state = 5},
-  [219] = {.lex_state = 0},
-  [220] = {.lex_state = 5},
-  [221] = {.lex_state = 0, .external_lex_state = 2},
-  [222] = {.lex_state = 0},
-  [223] = {.lex_state = 5},
-  [224] = {.lex_state = 0},
-  [225] = {.lex_state = 5},
-  [226] = {.lex_state = 0},
-  [227] = {.lex_state = 0},
-  [228] = {.lex_state = 0},
-  [229] = {.lex_state = 5},
-  [230] = {.lex_state = 0},
-  [231] = {.lex_state = 5},
-  [232] = {.lex_state = 0},
-  [233] = {.lex_state = 0},
-  [234] = {.lex_state = 5},
-  [235] = {.lex_state = 5},
-  [236] = {.lex_state = 0},
-  [237] = {.lex_state = 5},
-  [238] = {.lex_state = 0, .external_lex_state = 2},
-  [239] = {.lex_state = 4},
-  [240] = {.lex_state = 0, .external_lex_state = 2},
-  [241] = {.lex_state = 5},
-  [242] = {.lex_state = 0, .external_lex_state = 2},
-  [243] = {.lex_state = 0},
-  [244] = {.lex_state = 0},
-  [245] = {.lex_state = 0, .external_lex_state = 2},
-  [246] = {.lex_state = 0},
-  [247] = {.lex_state = 0},
-  [248] = {.lex_state = 0},
-  [249] = {.lex_state = 0},
-  [250] = {.lex_state = 0},
-  [251] = {.lex_state = 5},
-  [252] = {.lex_state = 5},
-  [253] = {.lex_state = 5},
-  [254] = {.lex_state = 0},
-  [255] = {.lex_state = 0},
-  [256] = {.lex_state = 0},
-  [257] = {.lex_state = 0},
-  [258] = {.lex_state = 0},
-  [259] = {.lex_state = 0},
-  [260] = {.lex_state = 0},
-  [261] = {.lex_state = 0},
-  [262] = {.lex_state = 5},
-  [263] = {.lex_state = 5},
-  [264] = {.lex_state = 5},
-  [265] = {.lex_state = 5},
-  [266] = {.lex_state = 0},
-  [267] = {.lex_state = 5},
-  [268] = {.lex_state = 5},
-  [269] = {.lex_state = 5},
-  [270] = {.lex_state = 5},
-  [271] = {.lex_state = 5},
-  [272] = {.lex_state = 5},
-  [273] = {.lex_state = 5},
-  [274] = {.lex_state = 0},
-  [275] = {.lex_state = 0},
-  [276] = {.lex_state = 0},
-  [277] = {.lex_state = 0},
-  [278] = {.lex_state = 0},
-  [279] = {.lex_state = 0},
-  [280] = {.lex_state = 0},
-  [281] = {.lex_state = 0},
-  [282] = {.lex_state = 0},
-  [283] = {.lex_state = 0},
-  [284] = {.lex_state = 0},
-  [285] = {.lex_state = 0},
-  [286] = {.lex_state = 0},
-  [287] = {.lex_state = 0},
-  [288] = {.lex_state = 0},
-  [289] = {.lex_state = 0},
-  [290] = {.lex_state = 0},
-  [291] = {.lex_state = 5},
-  [292] = {.lex_state = 0},
-  [293] = {.lex_state = 5},
-  [294] = {.lex_state = 5},
-  [295] = {.lex_state = 5},
-  [296] = {.lex_state = 0},
-  [297] = {.lex_state = 5},
-  [298] = {.lex_state = 0},
-  [299] = {.lex_state = 0},
-  [300] = {.lex_state = 5},
-  [301] = {.lex_state = 5},
-  [302] = {.lex_state = 5},
-  [303] = {.lex_state = 0},
-  [304] = {.lex_state = 5},
-  [305] = {.lex_state = 5},
-  [306] = {.lex_state = 5},
-  [307] = {.lex_state = 0},
-  [308] = {.lex_state = 5},
-  [309] = {.lex_state = 0},
-  [310] = {.lex_state = 5},
-  [311] = {.lex_state = 5},
-  [312] = {.lex_state = 0},
-  [313] = {.lex_state = 5},
-  [314] = {.lex_state = 5},
-};
-
-enum {
-  ts_external_token__automatic_semicolon = 0,
-  ts_external_token__template_chars = 1,
-  ts_external_token__ternary_qmark = 2,
-};
-
-static const TSSymbol ts_external_scanner_symbol_map[EXTERNAL_TOKEN_COUNT] = {
-  [ts_external_token__automatic_semicolon] = sym__automatic_semicolon,
-  [ts_external_token__template_chars] = sym__template_chars,
-  [ts_external_token__ternary_qmark] = sym__ternary_qmark,
-};
-
-static const bool ts_external_scanner_states[3][EXTERNAL_TOKEN_COUNT] = {
-  [1] = {
-    [ts_external_token__automatic_semicolon] = true,
-    [ts_external_token__template_chars] = true,
-    [ts_external_token__ternary_qmark] = true,
-  },
-  [2] = {
-    [ts_external_token__automatic_semicolon] = true,
-  },
-};
-
-static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
-  [0] = {
-    [ts_builtin_sym_end] = ACTIONS(1),
-    [anon_sym_import] = ACTIONS(1),
-    [anon_sym_as] = ACTIONS(1),
-    [anon_sym_stream] = ACTIONS(1),
-    [anon_sym_LBRACE] = ACTIONS(1),
-    [anon_sym_RBRACE] = ACTIONS(1),
-    [anon_sym_struct] = ACTIONS(1),
-    [anon_sym_let] = ACTIONS(1),
-    [anon_sym_COLON] = ACTIONS(1),
-    [anon_sym_enum] = ACTIONS(1),
-    [sym_optional] = ACTIONS(1),
-    [anon_sym_flag] = ACTIONS(1),
-    [anon_sym_case] = ACTIONS(1),
-    [anon_sym_LPAREN] = ACTIONS(1),
-    [anon_sym_COMMA] = ACTIONS(1),
-    [anon_sym_RPAREN] = ACTIONS(1),
-    [anon_sym_func] = ACTIONS(1),
-    [anon_sym_throws] = ACTIONS(1),
-    [anon_sym_DASH_GT] = ACTIONS(1),
-    [anon_sym_event] = ACTIONS(1),
-    [anon_sym_signal] = ACTIONS(1),
-    [anon_sym_LBRACK] = ACTIONS(1),
-    [anon_sym_RBRACK] = ACTIONS(1),
-    [anon_sym_DOT] = ACTIONS(1),
-    [anon_sym_QMARK] = ACTIONS(1),
-    [anon_sym_AT] = ACTIONS(1),
-    [anon_sym_Yes] = ACTIONS(1),
-    [anon_sym_No] = ACTIONS(1),
-    [anon_sym_DQUOTE] = ACTIONS(1),
-    [anon_sym_SQUOTE] = ACTIONS(1),
-    [sym_comment] = ACTIONS(3),
-    [sym_escape_sequence] = ACTIONS(1),
-    [sym_number] = ACTIONS(1),
-    [anon_sym_SEMI] = ACTIONS(1),
-    [sym__automatic_semicolon] = ACTIONS(1),
-    [sym__template_chars] = ACTIONS(1),
-    [sym__ternary_qmark] = ACTIONS(1),
-  },
-  [1] = {
-    [sym_file] = STATE(261),
-    [sym_statement] = STATE(3),
-    [sym_import] = STATE(36),
-    [sym_stream_declaration] = STATE(36),
-    [sym_struct_declaration] = STATE(36),
-    [sym_enum_declaration] = STATE(36),
-    [sym_flagset_declaration] = STATE(36),
-    [sym_func_declaration] = STATE(36),
-    [sym_annotation] = STATE(37),
-    [aux_sym_file_repeat1] = STATE(3),
-    [aux_sym_stream_declaration_repeat1] = STATE(37),
-    [ts_builtin_sym_end] = ACTIONS(5),
-    [anon_sym_import] = ACTIONS(7),
-    [anon_sym_stream] = ACTIONS(9),
-    [anon_sym_struct] = ACTIONS(11),
-    [anon_sym_enum] = ACTIONS(13),
-    [anon_sym_flagset] = ACTIONS(15),
-    [anon_sym_func] = ACTIONS(17),
-    [anon_sym_AT] = ACTIONS(19),
-    [sym_comment] = ACTIONS(3),
-  },
-};
-
-static const uint16_t ts_small_parse_table[] = {
-  [0] = 12,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(21), 1,
-      ts_builtin_sym_end,
-    ACTIONS(23), 1,
-      anon_sym_import,
-    ACTIONS(26), 1,
-      anon_sym_stream,
-    ACTIONS(29), 1,
-      anon_sym_struct,
-    ACTIONS(32), 1,
-      anon_sym_enum,
-    ACTIONS(35), 1,
-      anon_sym_flagset,
-    ACTIONS(38), 1,
-      anon_sym_func,
-    ACTIONS(41), 1,
-      anon_sym_AT,
-    STATE(2), 2,
-      sym_statement,
-      aux_sym_file_repeat1,
-    STATE(37), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-    STATE(36), 6,
-      sym_import,
-      sym_stream_declaration,
-      sym_struct_declaration,
-      sym_enum_declaration,
-      sym_flagset_declaration,
-      sym_func_declaration,
-  [44] = 12,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(7), 1,
-      anon_sym_import,
+    case 154:
+      if (lookahead == 'i') ADVANCE(155);
+      if (lookahead == 't') ADVANCE(160);
+      END_STATE();
+    case 155:
+      if (lookahead == 'g') ADVANCE(156);
+      END_STATE();
+    case 156:
+      if (lookahead == 'n') ADVANCE(157);
+      END_STATE();
+    case 157:
+      if (lookahead == 'a') ADVANCE(158);
+      END_STATE();
+    case 158:
+      if (lookahead == 'l') ADVANCE(159);
+      END_STATE();
+    case 159:
+      ACCEPT_TOKEN(anon_sym_signal);
+      END_STATE();
+    case 160:
+      if (lookahead == 'r') ADVANCE(161);
+      END_STATE();
+    case 161:
+      if (lookahead == 'e') ADVANCE(162);
+      if (lookahead == 'u') ADVANCE(165);
+      END_STATE();
+    case 162:
+      if (lookahead == 'a') ADVANCE(163);
+      END_STATE();
+    case 163:
+      if (lookahead == 'm') ADVANCE(164);
+      END_STATE();
+    case 164:
+      ACCEPT_TOKEN(anon_sym_stream);
+      END_STATE();
+    case 165:
+      if (lookahead == 'c') ADVANCE(166);
+      END_STATE();
+    case 166:
+      if (lookahead == 't') ADVANCE(167);
+      END_STATE();
+    case 167:
+      ACCEPT_TOKEN(anon_sym_struct);
+      END_STATE();
+    case 168:
+      if (lookahead == 'h') ADVANCE(169);
+      if (lookahead == 'y') ADVANCE(174);
+      END_STATE();
+    case 169:
+      if (lookahead == 'r') ADVANCE(170);
+      END_STATE();
+    case 170:
+      if (lookahead == 'o') ADVANCE(171);
+      END_STATE();
+    case 171:
+      if (lookahead == 'w') ADVANCE(172);
+      END_STATE();
+    case 172:
+      if (lookahead == 's') ADVANCE(173);
+      END_STATE();
+    case 173:
+      ACCEPT_TOKEN(anon_sym_throws);
+      END_STATE();
+    case 174:
+      if (lookahead == 'p') ADVANCE(175);
+      END_STATE();
+    case 175:
+      if (lookahead == 'e') ADVANCE(176);
+      END_STATE();
+    case 176:
+      if (lookahead == 'a') ADVANCE(177);
+      END_STATE();
+    case 177:
+      if (lookahead == 'l') ADVANCE(178);
+      END_STATE();
+    case 178:
+      if (lookahead == 'i') ADVANCE(179);
+      END_STATE();
+    case 179:
+      if (lookahead == 'a') ADVANCE(180);
+      END_STATE();
+    case 180:
+      if (lookahead == 's') ADVANCE(181);
+      END_STATE();
+    case 181:
+      ACCEPT_TOKEN(anon_sym_typealias);
+      END_STATE();
+    case 182:
+      ACCEPT_TOKEN(anon_sym_LBRACE);
+      END_STATE();
+    case 183:
+      ACCEPT_TOKEN(anon_sym_RBRACE);
+      END_STATE();
+    case 184:
+      if (eof) ADVANCE(1);
+      if (lookahead == '"') ADVANCE(2);
+      if (lookahead == '\'') ADVANCE(3);
+      if (lookahead == '(') ADVANCE(4);
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(6);
+      if (lookahead == '-') ADVANCE(7);
+      if (lookahead == '.') ADVANCE(63);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '0') ADVANCE(19);
+      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(61);
+      if (lookahead == ':') ADVANCE(74);
+      if (lookahead == ';') ADVANCE(75);
+      if (lookahead == '=') ADVANCE(76);
+      if (lookahead == '?') ADVANCE(77);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'N') ADVANCE(79);
+      if (lookahead == 'Y') ADVANCE(81);
+      if (lookahead == '[') ADVANCE(84);
+      if (lookahead == ']') ADVANCE(101);
+      if (lookahead == 'a') ADVANCE(102);
+      if (lookahead == 'c') ADVANCE(104);
+      if (lookahead == 'e') ADVANCE(112);
+      if (lookahead == 'f') ADVANCE(120);
+      if (lookahead == 'i') ADVANCE(130);
+      if (lookahead == 'l') ADVANCE(136);
+      if (lookahead == 'n') ADVANCE(139);
+      if (lookahead == 'o') ADVANCE(146);
+      if (lookahead == 's') ADVANCE(154);
+      if (lookahead == 't') ADVANCE(168);
+      if (lookahead == '{') ADVANCE(182);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(184)
+      END_STATE();
+    case 185:
+      if (eof) ADVANCE(1);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'c') ADVANCE(186);
+      if (lookahead == 'e') ADVANCE(187);
+      if (lookahead == 'f') ADVANCE(188);
+      if (lookahead == 'i') ADVANCE(130);
+      if (lookahead == 'n') ADVANCE(139);
+      if (lookahead == 's') ADVANCE(192);
+      if (lookahead == 't') ADVANCE(193);
+      if (sym_character_set_1(lookahead)) SKIP(194)
+      END_STATE();
+    case 186:
+      if (lookahead == 'o') ADVANCE(108);
+      END_STATE();
+    case 187:
+      if (lookahead == 'n') ADVANCE(113);
+      END_STATE();
+    case 188:
+      if (lookahead == 'l') ADVANCE(189);
+      if (lookahead == 'u') ADVANCE(127);
+      END_STATE();
+    case 189:
+      if (lookahead == 'a') ADVANCE(190);
+      END_STATE();
+    case 190:
+      if (lookahead == 'g') ADVANCE(191);
+      END_STATE();
+    case 191:
+      if (lookahead == 's') ADVANCE(124);
+      END_STATE();
+    case 192:
+      if (lookahead == 't') ADVANCE(160);
+      END_STATE();
+    case 193:
+      if (lookahead == 'y') ADVANCE(174);
+      END_STATE();
+    case 194:
+      if (eof) ADVANCE(1);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'c') ADVANCE(186);
+      if (lookahead == 'e') ADVANCE(187);
+      if (lookahead == 'f') ADVANCE(188);
+      if (lookahead == 'i') ADVANCE(130);
+      if (lookahead == 'n') ADVANCE(139);
+      if (lookahead == 's') ADVANCE(192);
+      if (lookahead == 't') ADVANCE(193);
+      if (sym_character_set_1(lookahead)) SKIP(194)
+      END_STATE();
+    case 195:
+      if (lookahead == '"') ADVANCE(2);
+      if (lookahead == '\'') ADVANCE(3);
+      if (lookahead == '/') ADVANCE(64);
+      if (sym_character_set_1(lookahead)) SKIP(196)
+      END_STATE();
+    case 196:
+      if (lookahead == '"') ADVANCE(2);
+      if (lookahead == '\'') ADVANCE(3);
+      if (lookahead == '/') ADVANCE(64);
+      if (sym_character_set_1(lookahead)) SKIP(196)
+      END_STATE();
+    case 197:
+      if (sym_character_set_2(lookahead)) ADVANCE(198);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '\\') ADVANCE(209);
+      if (sym_character_set_1(lookahead)) SKIP(218)
+      END_STATE();
+    case 198:
+      ACCEPT_TOKEN(sym_identifier);
+      if (sym_identifier_character_set_3(lookahead)) ADVANCE(199);
+      if (lookahead == '\\') ADVANCE(200);
+      END_STATE();
+    case 199:
+      ACCEPT_TOKEN(sym_identifier);
+      if (sym_identifier_character_set_3(lookahead)) ADVANCE(199);
+      if (lookahead == '\\') ADVANCE(200);
+      END_STATE();
+    case 200:
+      if (lookahead == 'u') ADVANCE(201);
+      END_STATE();
+    case 201:
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(202);
+      if (lookahead == '{') ADVANCE(206);
+      END_STATE();
+    case 202:
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(203);
+      END_STATE();
+    case 203:
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(204);
+      END_STATE();
+    case 204:
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(205);
+      END_STATE();
+    case 205:
+      ACCEPT_TOKEN(sym_identifier);
+      if (sym_identifier_character_set_3(lookahead)) ADVANCE(199);
+      if (lookahead == '\\') ADVANCE(200);
+      END_STATE();
+    case 206:
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(207);
+      END_STATE();
+    case 207:
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(207);
+      if (lookahead == '}') ADVANCE(208);
+      END_STATE();
+    case 208:
+      ACCEPT_TOKEN(sym_identifier);
+      if (sym_identifier_character_set_3(lookahead)) ADVANCE(199);
+      if (lookahead == '\\') ADVANCE(200);
+      END_STATE();
+    case 209:
+      if (lookahead == 'u') ADVANCE(210);
+      END_STATE();
+    case 210:
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(211);
+      if (lookahead == '{') ADVANCE(215);
+      END_STATE();
+    case 211:
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(212);
+      END_STATE();
+    case 212:
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(213);
+      END_STATE();
+    case 213:
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(214);
+      END_STATE();
+    case 214:
+      ACCEPT_TOKEN(sym_identifier);
+      if (sym_identifier_character_set_3(lookahead)) ADVANCE(199);
+      if (lookahead == '\\') ADVANCE(200);
+      END_STATE();
+    case 215:
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(216);
+      END_STATE();
+    case 216:
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(216);
+      if (lookahead == '}') ADVANCE(217);
+      END_STATE();
+    case 217:
+      ACCEPT_TOKEN(sym_identifier);
+      if (sym_identifier_character_set_3(lookahead)) ADVANCE(199);
+      if (lookahead == '\\') ADVANCE(200);
+      END_STATE();
+    case 218:
+      if (sym_character_set_2(lookahead)) ADVANCE(198);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '\\') ADVANCE(209);
+      if (sym_character_set_1(lookahead)) SKIP(218)
+      END_STATE();
+    case 219:
+      if (eof) ADVANCE(1);
+      if (lookahead == '/') ADVANCE(64);
+      if (sym_character_set_1(lookahead)) SKIP(220)
+      END_STATE();
+    case 220:
+      if (eof) ADVANCE(1);
+      if (lookahead == '/') ADVANCE(64);
+      if (sym_character_set_1(lookahead)) SKIP(220)
+      END_STATE();
+    case 221:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'c') ADVANCE(104);
+      if (lookahead == 'e') ADVANCE(112);
+      if (lookahead == 'f') ADVANCE(120);
+      if (lookahead == 'l') ADVANCE(136);
+      if (lookahead == 'n') ADVANCE(139);
+      if (lookahead == 's') ADVANCE(154);
+      if (lookahead == 't') ADVANCE(193);
+      if (sym_character_set_1(lookahead)) SKIP(222)
+      END_STATE();
+    case 222:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'c') ADVANCE(104);
+      if (lookahead == 'e') ADVANCE(112);
+      if (lookahead == 'f') ADVANCE(120);
+      if (lookahead == 'l') ADVANCE(136);
+      if (lookahead == 'n') ADVANCE(139);
+      if (lookahead == 's') ADVANCE(154);
+      if (lookahead == 't') ADVANCE(193);
+      if (sym_character_set_1(lookahead)) SKIP(222)
+      END_STATE();
+    case 223:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'c') ADVANCE(186);
+      if (lookahead == 'e') ADVANCE(187);
+      if (lookahead == 'f') ADVANCE(188);
+      if (lookahead == 'n') ADVANCE(139);
+      if (lookahead == 's') ADVANCE(192);
+      if (lookahead == 't') ADVANCE(193);
+      if (sym_character_set_1(lookahead)) SKIP(224)
+      END_STATE();
+    case 224:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'c') ADVANCE(186);
+      if (lookahead == 'e') ADVANCE(187);
+      if (lookahead == 'f') ADVANCE(188);
+      if (lookahead == 'n') ADVANCE(139);
+      if (lookahead == 's') ADVANCE(192);
+      if (lookahead == 't') ADVANCE(193);
+      if (sym_character_set_1(lookahead)) SKIP(224)
+      END_STATE();
+    case 225:
+      if ((1 <= lookahead && lookahead <= '!') ||
+          ('#' <= lookahead && lookahead <= '.') ||
+          ('0' <= lookahead && lookahead <= '[') ||
+          (']' <= lookahead && lookahead <= 1114111)) ADVANCE(226);
+      if (lookahead == '"') ADVANCE(2);
+      if (lookahead == '/') ADVANCE(227);
+      if (lookahead == '\\') ADVANCE(85);
+      END_STATE();
+    case 226:
+      ACCEPT_TOKEN(sym_unescaped_double_string_fragment);
+      if ((1 <= lookahead && lookahead <= '!') ||
+          ('#' <= lookahead && lookahead <= '[') ||
+          (']' <= lookahead && lookahead <= 1114111)) ADVANCE(226);
+      END_STATE();
+    case 227:
+      ACCEPT_TOKEN(sym_unescaped_double_string_fragment);
+      if ((1 <= lookahead && lookahead <= '!') ||
+          ('#' <= lookahead && lookahead <= '[') ||
+          (']' <= lookahead && lookahead <= 1114111)) ADVANCE(226);
+      END_STATE();
+    case 228:
+      if ((1 <= lookahead && lookahead <= '&') ||
+          ('(' <= lookahead && lookahead <= '.') ||
+          ('0' <= lookahead && lookahead <= '[') ||
+          (']' <= lookahead && lookahead <= 1114111)) ADVANCE(229);
+      if (lookahead == '\'') ADVANCE(3);
+      if (lookahead == '/') ADVANCE(230);
+      if (lookahead == '\\') ADVANCE(85);
+      END_STATE();
+    case 229:
+      ACCEPT_TOKEN(sym_unescaped_single_string_fragment);
+      if ((1 <= lookahead && lookahead <= '&') ||
+          ('(' <= lookahead && lookahead <= '[') ||
+          (']' <= lookahead && lookahead <= 1114111)) ADVANCE(229);
+      END_STATE();
+    case 230:
+      ACCEPT_TOKEN(sym_unescaped_single_string_fragment);
+      if ((1 <= lookahead && lookahead <= '&') ||
+          ('(' <= lookahead && lookahead <= '[') ||
+          (']' <= lookahead && lookahead <= 1114111)) ADVANCE(229);
+      END_STATE();
+    case 231:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == 'a') ADVANCE(102);
+      if (sym_character_set_1(lookahead)) SKIP(232)
+      END_STATE();
+    case 232:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == 'a') ADVANCE(102);
+      if (sym_character_set_1(lookahead)) SKIP(232)
+      END_STATE();
+    case 233:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '{') ADVANCE(182);
+      if (sym_character_set_1(lookahead)) SKIP(234)
+      END_STATE();
+    case 234:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '{') ADVANCE(182);
+      if (sym_character_set_1(lookahead)) SKIP(234)
+      END_STATE();
+    case 235:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ':') ADVANCE(74);
+      if (lookahead == '{') ADVANCE(182);
+      if (sym_character_set_1(lookahead)) SKIP(236)
+      END_STATE();
+    case 236:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ':') ADVANCE(74);
+      if (lookahead == '{') ADVANCE(182);
+      if (sym_character_set_1(lookahead)) SKIP(236)
+      END_STATE();
+    case 237:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '=') ADVANCE(76);
+      if (sym_character_set_1(lookahead)) SKIP(238)
+      END_STATE();
+    case 238:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '=') ADVANCE(76);
+      if (sym_character_set_1(lookahead)) SKIP(238)
+      END_STATE();
+    case 239:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ':') ADVANCE(74);
+      if (sym_character_set_1(lookahead)) SKIP(240)
+      END_STATE();
+    case 240:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ':') ADVANCE(74);
+      if (sym_character_set_1(lookahead)) SKIP(240)
+      END_STATE();
+    case 241:
+      if (lookahead == '(') ADVANCE(4);
+      if (lookahead == '/') ADVANCE(64);
+      if (sym_character_set_1(lookahead)) SKIP(242)
+      END_STATE();
+    case 242:
+      if (lookahead == '(') ADVANCE(4);
+      if (lookahead == '/') ADVANCE(64);
+      if (sym_character_set_1(lookahead)) SKIP(242)
+      END_STATE();
+    case 243:
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(6);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == ']') ADVANCE(101);
+      if (lookahead == 'a') ADVANCE(102);
+      if (lookahead == 'l') ADVANCE(136);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(244)
+      END_STATE();
+    case 244:
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(6);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == ']') ADVANCE(101);
+      if (lookahead == 'a') ADVANCE(102);
+      if (lookahead == 'l') ADVANCE(136);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(244)
+      END_STATE();
+    case 245:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'e') ADVANCE(246);
+      if (lookahead == 's') ADVANCE(247);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(248)
+      END_STATE();
+    case 246:
+      if (lookahead == 'v') ADVANCE(116);
+      END_STATE();
+    case 247:
+      if (lookahead == 'i') ADVANCE(155);
+      END_STATE();
+    case 248:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'e') ADVANCE(246);
+      if (lookahead == 's') ADVANCE(247);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(248)
+      END_STATE();
+    case 249:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'l') ADVANCE(136);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(250)
+      END_STATE();
+    case 250:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'l') ADVANCE(136);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(250)
+      END_STATE();
+    case 251:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'c') ADVANCE(252);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(253)
+      END_STATE();
+    case 252:
+      if (lookahead == 'a') ADVANCE(105);
+      END_STATE();
+    case 253:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'c') ADVANCE(252);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(253)
+      END_STATE();
+    case 254:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'f') ADVANCE(255);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(259)
+      END_STATE();
+    case 255:
+      if (lookahead == 'l') ADVANCE(256);
+      END_STATE();
+    case 256:
+      if (lookahead == 'a') ADVANCE(257);
+      END_STATE();
+    case 257:
+      if (lookahead == 'g') ADVANCE(258);
+      END_STATE();
+    case 258:
+      ACCEPT_TOKEN(anon_sym_flag);
+      END_STATE();
+    case 259:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'f') ADVANCE(255);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(259)
+      END_STATE();
+    case 260:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == 'o') ADVANCE(146);
+      if (sym_character_set_1(lookahead)) SKIP(261)
+      END_STATE();
+    case 261:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == 'o') ADVANCE(146);
+      if (sym_character_set_1(lookahead)) SKIP(261)
+      END_STATE();
+    case 262:
+      if (sym_character_set_2(lookahead)) ADVANCE(198);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '[') ADVANCE(84);
+      if (lookahead == '\\') ADVANCE(209);
+      if (sym_character_set_1(lookahead)) SKIP(263)
+      END_STATE();
+    case 263:
+      if (sym_character_set_2(lookahead)) ADVANCE(198);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '[') ADVANCE(84);
+      if (lookahead == '\\') ADVANCE(209);
+      if (sym_character_set_1(lookahead)) SKIP(263)
+      END_STATE();
+    case 264:
+      if (sym_character_set_2(lookahead)) ADVANCE(198);
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == '\\') ADVANCE(209);
+      if (sym_character_set_1(lookahead)) SKIP(265)
+      END_STATE();
+    case 265:
+      if (sym_character_set_2(lookahead)) ADVANCE(198);
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == '\\') ADVANCE(209);
+      if (sym_character_set_1(lookahead)) SKIP(265)
+      END_STATE();
+    case 266:
+      if (lookahead == '"') ADVANCE(2);
+      if (lookahead == '\'') ADVANCE(3);
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == '-') ADVANCE(267);
+      if (lookahead == '.') ADVANCE(8);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '0') ADVANCE(19);
+      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(61);
+      if (lookahead == 'N') ADVANCE(79);
+      if (lookahead == 'Y') ADVANCE(81);
+      if (lookahead == '[') ADVANCE(84);
+      if (sym_character_set_1(lookahead)) SKIP(268)
+      END_STATE();
+    case 267:
+      if (lookahead == '.') ADVANCE(8);
+      if (lookahead == '0') ADVANCE(19);
+      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(61);
+      END_STATE();
+    case 268:
+      if (lookahead == '"') ADVANCE(2);
+      if (lookahead == '\'') ADVANCE(3);
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == '-') ADVANCE(267);
+      if (lookahead == '.') ADVANCE(8);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '0') ADVANCE(19);
+      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(61);
+      if (lookahead == 'N') ADVANCE(79);
+      if (lookahead == 'Y') ADVANCE(81);
+      if (lookahead == '[') ADVANCE(84);
+      if (sym_character_set_1(lookahead)) SKIP(268)
+      END_STATE();
+    case 269:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ';') ADVANCE(75);
+      if (sym_character_set_1(lookahead)) SKIP(270)
+      END_STATE();
+    case 270:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ';') ADVANCE(75);
+      if (sym_character_set_1(lookahead)) SKIP(270)
+      END_STATE();
+    case 271:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'e') ADVANCE(246);
+      if (lookahead == 's') ADVANCE(247);
+      if (sym_character_set_1(lookahead)) SKIP(272)
+      END_STATE();
+    case 272:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'e') ADVANCE(246);
+      if (lookahead == 's') ADVANCE(247);
+      if (sym_character_set_1(lookahead)) SKIP(272)
+      END_STATE();
+    case 273:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'l') ADVANCE(136);
+      if (sym_character_set_1(lookahead)) SKIP(274)
+      END_STATE();
+    case 274:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'l') ADVANCE(136);
+      if (sym_character_set_1(lookahead)) SKIP(274)
+      END_STATE();
+    case 275:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'c') ADVANCE(252);
+      if (sym_character_set_1(lookahead)) SKIP(276)
+      END_STATE();
+    case 276:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'c') ADVANCE(252);
+      if (sym_character_set_1(lookahead)) SKIP(276)
+      END_STATE();
+    case 277:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'f') ADVANCE(255);
+      if (sym_character_set_1(lookahead)) SKIP(278)
+      END_STATE();
+    case 278:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'f') ADVANCE(255);
+      if (sym_character_set_1(lookahead)) SKIP(278)
+      END_STATE();
+    case 279:
+      if (lookahead == '-') ADVANCE(280);
+      if (lookahead == '.') ADVANCE(281);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ';') ADVANCE(75);
+      if (lookahead == '?') ADVANCE(77);
+      if (sym_character_set_1(lookahead)) SKIP(282)
+      END_STATE();
+    case 280:
+      if (lookahead == '>') ADVANCE(62);
+      END_STATE();
+    case 281:
+      ACCEPT_TOKEN(anon_sym_DOT);
+      END_STATE();
+    case 282:
+      if (lookahead == '-') ADVANCE(280);
+      if (lookahead == '.') ADVANCE(281);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ';') ADVANCE(75);
+      if (lookahead == '?') ADVANCE(77);
+      if (sym_character_set_1(lookahead)) SKIP(282)
+      END_STATE();
+    case 283:
+      if (lookahead == '.') ADVANCE(281);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ';') ADVANCE(75);
+      if (lookahead == '?') ADVANCE(77);
+      if (sym_character_set_1(lookahead)) SKIP(284)
+      END_STATE();
+    case 284:
+      if (lookahead == '.') ADVANCE(281);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ';') ADVANCE(75);
+      if (lookahead == '?') ADVANCE(77);
+      if (sym_character_set_1(lookahead)) SKIP(284)
+      END_STATE();
+    case 285:
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(6);
+      if (lookahead == '.') ADVANCE(281);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ':') ADVANCE(74);
+      if (lookahead == '=') ADVANCE(76);
+      if (lookahead == '?') ADVANCE(77);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == ']') ADVANCE(101);
+      if (lookahead == 'l') ADVANCE(136);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(286)
+      END_STATE();
+    case 286:
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(6);
+      if (lookahead == '.') ADVANCE(281);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ':') ADVANCE(74);
+      if (lookahead == '=') ADVANCE(76);
+      if (lookahead == '?') ADVANCE(77);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == ']') ADVANCE(101);
+      if (lookahead == 'l') ADVANCE(136);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(286)
+      END_STATE();
+    case 287:
+      if (lookahead == '.') ADVANCE(281);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '=') ADVANCE(76);
+      if (lookahead == '?') ADVANCE(77);
+      if (sym_character_set_1(lookahead)) SKIP(288)
+      END_STATE();
+    case 288:
+      if (lookahead == '.') ADVANCE(281);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '=') ADVANCE(76);
+      if (lookahead == '?') ADVANCE(77);
+      if (sym_character_set_1(lookahead)) SKIP(288)
+      END_STATE();
+    case 289:
+      if (lookahead == '-') ADVANCE(280);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ';') ADVANCE(75);
+      if (lookahead == 't') ADVANCE(290);
+      if (sym_character_set_1(lookahead)) SKIP(291)
+      END_STATE();
+    case 290:
+      if (lookahead == 'h') ADVANCE(169);
+      END_STATE();
+    case 291:
+      if (lookahead == '-') ADVANCE(280);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ';') ADVANCE(75);
+      if (lookahead == 't') ADVANCE(290);
+      if (sym_character_set_1(lookahead)) SKIP(291)
+      END_STATE();
+    case 292:
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(6);
+      if (lookahead == '/') ADVANCE(64);
+      if (sym_character_set_1(lookahead)) SKIP(293)
+      END_STATE();
+    case 293:
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(6);
+      if (lookahead == '/') ADVANCE(64);
+      if (sym_character_set_1(lookahead)) SKIP(293)
+      END_STATE();
+    case 294:
+      if (sym_character_set_2(lookahead)) ADVANCE(198);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == '\\') ADVANCE(209);
+      if (sym_character_set_1(lookahead)) SKIP(295)
+      END_STATE();
+    case 295:
+      if (sym_character_set_2(lookahead)) ADVANCE(198);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == '\\') ADVANCE(209);
+      if (sym_character_set_1(lookahead)) SKIP(295)
+      END_STATE();
+    case 296:
+      if (lookahead == '"') ADVANCE(2);
+      if (sym_character_set_4(lookahead)) ADVANCE(198);
+      if (lookahead == '\'') ADVANCE(3);
+      if (lookahead == '-') ADVANCE(267);
+      if (lookahead == '.') ADVANCE(8);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '0') ADVANCE(19);
+      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(61);
+      if (lookahead == ':') ADVANCE(74);
+      if (lookahead == 'N') ADVANCE(297);
+      if (lookahead == 'Y') ADVANCE(299);
+      if (lookahead == '[') ADVANCE(84);
+      if (lookahead == '\\') ADVANCE(209);
+      if (lookahead == ']') ADVANCE(101);
+      if (sym_character_set_1(lookahead)) SKIP(302)
+      END_STATE();
+    case 297:
+      ACCEPT_TOKEN(sym_identifier);
+      if (sym_identifier_character_set_5(lookahead)) ADVANCE(199);
+      if (lookahead == '\\') ADVANCE(200);
+      if (lookahead == 'o') ADVANCE(298);
+      END_STATE();
+    case 298:
+      ACCEPT_TOKEN(anon_sym_No);
+      if (sym_identifier_character_set_3(lookahead)) ADVANCE(199);
+      if (lookahead == '\\') ADVANCE(200);
+      END_STATE();
+    case 299:
+      ACCEPT_TOKEN(sym_identifier);
+      if (sym_identifier_character_set_6(lookahead)) ADVANCE(199);
+      if (lookahead == '\\') ADVANCE(200);
+      if (lookahead == 'e') ADVANCE(300);
+      END_STATE();
+    case 300:
+      ACCEPT_TOKEN(sym_identifier);
+      if (sym_identifier_character_set_7(lookahead)) ADVANCE(199);
+      if (lookahead == '\\') ADVANCE(200);
+      if (lookahead == 's') ADVANCE(301);
+      END_STATE();
+    case 301:
+      ACCEPT_TOKEN(anon_sym_Yes);
+      if (sym_identifier_character_set_3(lookahead)) ADVANCE(199);
+      if (lookahead == '\\') ADVANCE(200);
+      END_STATE();
+    case 302:
+      if (lookahead == '"') ADVANCE(2);
+      if (sym_character_set_4(lookahead)) ADVANCE(198);
+      if (lookahead == '\'') ADVANCE(3);
+      if (lookahead == '-') ADVANCE(267);
+      if (lookahead == '.') ADVANCE(8);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '0') ADVANCE(19);
+      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(61);
+      if (lookahead == ':') ADVANCE(74);
+      if (lookahead == 'N') ADVANCE(297);
+      if (lookahead == 'Y') ADVANCE(299);
+      if (lookahead == '[') ADVANCE(84);
+      if (lookahead == '\\') ADVANCE(209);
+      if (lookahead == ']') ADVANCE(101);
+      if (sym_character_set_1(lookahead)) SKIP(302)
+      END_STATE();
+    case 303:
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(6);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == ']') ADVANCE(101);
+      if (lookahead == 'l') ADVANCE(136);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(304)
+      END_STATE();
+    case 304:
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(6);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == ']') ADVANCE(101);
+      if (lookahead == 'l') ADVANCE(136);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(304)
+      END_STATE();
+    case 305:
+      if (eof) ADVANCE(1);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'c') ADVANCE(186);
+      if (lookahead == 'e') ADVANCE(112);
+      if (lookahead == 'f') ADVANCE(188);
+      if (lookahead == 'i') ADVANCE(130);
+      if (lookahead == 'n') ADVANCE(139);
+      if (lookahead == 's') ADVANCE(154);
+      if (lookahead == 't') ADVANCE(193);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(306)
+      END_STATE();
+    case 306:
+      if (eof) ADVANCE(1);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'c') ADVANCE(186);
+      if (lookahead == 'e') ADVANCE(112);
+      if (lookahead == 'f') ADVANCE(188);
+      if (lookahead == 'i') ADVANCE(130);
+      if (lookahead == 'n') ADVANCE(139);
+      if (lookahead == 's') ADVANCE(154);
+      if (lookahead == 't') ADVANCE(193);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(306)
+      END_STATE();
+    case 307:
+      if (lookahead == '(') ADVANCE(4);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'c') ADVANCE(252);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(308)
+      END_STATE();
+    case 308:
+      if (lookahead == '(') ADVANCE(4);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'c') ADVANCE(252);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(308)
+      END_STATE();
+    case 309:
+      if (lookahead == '.') ADVANCE(281);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ':') ADVANCE(74);
+      if (lookahead == '?') ADVANCE(77);
+      if (lookahead == ']') ADVANCE(101);
+      if (sym_character_set_1(lookahead)) SKIP(310)
+      END_STATE();
+    case 310:
+      if (lookahead == '.') ADVANCE(281);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ':') ADVANCE(74);
+      if (lookahead == '?') ADVANCE(77);
+      if (lookahead == ']') ADVANCE(101);
+      if (sym_character_set_1(lookahead)) SKIP(310)
+      END_STATE();
+    case 311:
+      if (lookahead == '"') ADVANCE(2);
+      if (lookahead == '\'') ADVANCE(3);
+      if (lookahead == '-') ADVANCE(267);
+      if (lookahead == '.') ADVANCE(8);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '0') ADVANCE(19);
+      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(61);
+      if (lookahead == 'N') ADVANCE(79);
+      if (lookahead == 'Y') ADVANCE(81);
+      if (lookahead == '[') ADVANCE(84);
+      if (sym_character_set_1(lookahead)) SKIP(312)
+      END_STATE();
+    case 312:
+      if (lookahead == '"') ADVANCE(2);
+      if (lookahead == '\'') ADVANCE(3);
+      if (lookahead == '-') ADVANCE(267);
+      if (lookahead == '.') ADVANCE(8);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '0') ADVANCE(19);
+      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(61);
+      if (lookahead == 'N') ADVANCE(79);
+      if (lookahead == 'Y') ADVANCE(81);
+      if (lookahead == '[') ADVANCE(84);
+      if (sym_character_set_1(lookahead)) SKIP(312)
+      END_STATE();
+    case 313:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ']') ADVANCE(101);
+      if (sym_character_set_1(lookahead)) SKIP(314)
+      END_STATE();
+    case 314:
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ']') ADVANCE(101);
+      if (sym_character_set_1(lookahead)) SKIP(314)
+      END_STATE();
+    case 315:
+      if (lookahead == ',') ADVANCE(6);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ']') ADVANCE(101);
+      if (sym_character_set_1(lookahead)) SKIP(316)
+      END_STATE();
+    case 316:
+      if (lookahead == ',') ADVANCE(6);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ']') ADVANCE(101);
+      if (sym_character_set_1(lookahead)) SKIP(316)
+      END_STATE();
+    case 317:
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(6);
+      if (lookahead == '.') ADVANCE(281);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '=') ADVANCE(76);
+      if (lookahead == '?') ADVANCE(77);
+      if (sym_character_set_1(lookahead)) SKIP(318)
+      END_STATE();
+    case 318:
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(6);
+      if (lookahead == '.') ADVANCE(281);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '=') ADVANCE(76);
+      if (lookahead == '?') ADVANCE(77);
+      if (sym_character_set_1(lookahead)) SKIP(318)
+      END_STATE();
+    case 319:
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(6);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ']') ADVANCE(101);
+      if (sym_character_set_1(lookahead)) SKIP(320)
+      END_STATE();
+    case 320:
+      if (lookahead == ')') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(6);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == ']') ADVANCE(101);
+      if (sym_character_set_1(lookahead)) SKIP(320)
+      END_STATE();
+    case 321:
+      if (lookahead == '.') ADVANCE(281);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '=') ADVANCE(76);
+      if (lookahead == '?') ADVANCE(77);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'l') ADVANCE(136);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(322)
+      END_STATE();
+    case 322:
+      if (lookahead == '.') ADVANCE(281);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '=') ADVANCE(76);
+      if (lookahead == '?') ADVANCE(77);
+      if (lookahead == '@') ADVANCE(78);
+      if (lookahead == 'l') ADVANCE(136);
+      if (lookahead == '}') ADVANCE(183);
+      if (sym_character_set_1(lookahead)) SKIP(322)
+      END_STATE();
+    case 323:
+      if (lookahead == '.') ADVANCE(281);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '?') ADVANCE(77);
+      if (lookahead == ']') ADVANCE(101);
+      if (sym_character_set_1(lookahead)) SKIP(324)
+      END_STATE();
+    case 324:
+      if (lookahead == '.') ADVANCE(281);
+      if (lookahead == '/') ADVANCE(64);
+      if (lookahead == '?') ADVANCE(77);
+      if (lookahead == ']') ADVANCE(101);
+      if (sym_character_set_1(lookahead)) SKIP(324)
+      END_STATE();
+    default:
+      return false;
+  }
+}
+
+static const TSLexMode ts_lex_modes[STATE_COUNT] = {
+  [0] = {.lex_state = 0, .external_lex_state = 1},
+  [1] = {.lex_state = 185},
+  [2] = {.lex_state = 195},
+  [3] = {.lex_state = 197},
+  [4] = {.lex_state = 197},
+  [5] = {.lex_state = 197},
+  [6] = {.lex_state = 197},
+  [7] = {.lex_state = 197},
+  [8] = {.lex_state = 197},
+  [9] = {.lex_state = 197},
+  [10] = {.lex_state = 197},
+  [11] = {.lex_state = 197},
+  [12] = {.lex_state = 219},
+  [13] = {.lex_state = 185},
+  [14] = {.lex_state = 185},
+  [15] = {.lex_state = 185},
+  [16] = {.lex_state = 185},
+  [17] = {.lex_state = 185},
+  [18] = {.lex_state = 185},
+  [19] = {.lex_state = 185},
+  [20] = {.lex_state = 185},
+  [21] = {.lex_state = 185},
+  [22] = {.lex_state = 221},
+  [23] = {.lex_state = 185},
+  [24] = {.lex_state = 223},
+  [25] = {.lex_state = 225},
+  [26] = {.lex_state = 228},
+  [27] = {.lex_state = 231},
+  [28] = {.lex_state = 233},
+  [29] = {.lex_state = 233},
+  [30] = {.lex_state = 233},
+  [31] = {.lex_state = 235},
+  [32] = {.lex_state = 237},
+  [33] = {.lex_state = 237},
+  [34] = {.lex_state = 239},
+  [35] = {.lex_state = 241},
+  [36] = {.lex_state = 241},
+  [37] = {.lex_state = 185},
+  [38] = {.lex_state = 197},
+  [39] = {.lex_state = 197},
+  [40] = {.lex_state = 197},
+  [41] = {.lex_state = 197},
+  [42] = {.lex_state = 197},
+  [43] = {.lex_state = 197},
+  [44] = {.lex_state = 197},
+  [45] = {.lex_state = 197},
+  [46] = {.lex_state = 221},
+  [47] = {.lex_state = 243},
+  [48] = {.lex_state = 225},
+  [49] = {.lex_state = 225},
+  [50] = {.lex_state = 225},
+  [51] = {.lex_state = 243},
+  [52] = {.lex_state = 228},
+  [53] = {.lex_state = 228},
+  [54] = {.lex_state = 228},
+  [55] = {.lex_state = 197},
+  [56] = {.lex_state = 245},
+  [57] = {.lex_state = 249},
+  [58] = {.lex_state = 251},
+  [59] = {.lex_state = 254},
+  [60] = {.lex_state = 260},
+  [61] = {.lex_state = 262},
+  [62] = {.lex_state = 262},
+  [63] = {.lex_state = 262},
+  [64] = {.lex_state = 264},
+  [65] = {.lex_state = 266},
+  [66] = {.lex_state = 233},
+  [67] = {.lex_state = 233},
+  [68] = {.lex_state = 233},
+  [69] = {.lex_state = 235},
+  [70] = {.lex_state = 237},
+  [71] = {.lex_state = 237},
+  [72] = {.lex_state = 239},
+  [73] = {.lex_state = 241},
+  [74] = {.lex_state = 243},
+  [75] = {.lex_state = 225},
+  [76] = {.lex_state = 225},
+  [77] = {.lex_state = 243},
+  [78] = {.lex_state = 228},
+  [79] = {.lex_state = 228},
+  [80] = {.lex_state = 269, .external_lex_state = 2},
+  [81] = {.lex_state = 185},
+  [82] = {.lex_state = 197},
+  [83] = {.lex_state = 197},
+  [84] = {.lex_state = 245},
+  [85] = {.lex_state = 245},
+  [86] = {.lex_state = 271},
+  [87] = {.lex_state = 245},
+  [88] = {.lex_state = 185},
+  [89] = {.lex_state = 197},
+  [90] = {.lex_state = 249},
+  [91] = {.lex_state = 273},
+  [92] = {.lex_state = 249},
+  [93] = {.lex_state = 185},
+  [94] = {.lex_state = 197},
+  [95] = {.lex_state = 251},
+  [96] = {.lex_state = 275},
+  [97] = {.lex_state = 251},
+  [98] = {.lex_state = 185},
+  [99] = {.lex_state = 197},
+  [100] = {.lex_state = 254},
+  [101] = {.lex_state = 277},
+  [102] = {.lex_state = 254},
+  [103] = {.lex_state = 233},
+  [104] = {.lex_state = 262},
+  [105] = {.lex_state = 279, .external_lex_state = 2},
+  [106] = {.lex_state = 283, .external_lex_state = 2},
+  [107] = {.lex_state = 283, .external_lex_state = 2},
+  [108] = {.lex_state = 262},
+  [109] = {.lex_state = 285},
+  [110] = {.lex_state = 287},
+  [111] = {.lex_state = 289, .external_lex_state = 2},
+  [112] = {.lex_state = 197},
+  [113] = {.lex_state = 239},
+  [114] = {.lex_state = 292},
+  [115] = {.lex_state = 294},
+  [116] = {.lex_state = 294},
+  [117] = {.lex_state = 221},
+  [118] = {.lex_state = 296},
+  [119] = {.lex_state = 303},
+  [120] = {.lex_state = 303},
+  [121] = {.lex_state = 303},
+  [122] = {.lex_state = 292},
+  [123] = {.lex_state = 303},
+  [124] = {.lex_state = 303},
+  [125] = {.lex_state = 303},
+  [126] = {.lex_state = 303},
+  [127] = {.lex_state = 245},
+  [128] = {.lex_state = 249},
+  [129] = {.lex_state = 251},
+  [130] = {.lex_state = 254},
+  [131] = {.lex_state = 260},
+  [132] = {.lex_state = 262},
+  [133] = {.lex_state = 262},
+  [134] = {.lex_state = 262},
+  [135] = {.lex_state = 264},
+  [136] = {.lex_state = 305},
+  [137] = {.lex_state = 305},
+  [138] = {.lex_state = 185},
+  [139] = {.lex_state = 241},
+  [140] = {.lex_state = 241},
+  [141] = {.lex_state = 197},
+  [142] = {.lex_state = 197},
+  [143] = {.lex_state = 185},
+  [144] = {.lex_state = 245},
+  [145] = {.lex_state = 245},
+  [146] = {.lex_state = 239},
+  [147] = {.lex_state = 197},
+  [148] = {.lex_state = 185},
+  [149] = {.lex_state = 249},
+  [150] = {.lex_state = 307},
+  [151] = {.lex_state = 197},
+  [152] = {.lex_state = 185},
+  [153] = {.lex_state = 251},
+  [154] = {.lex_state = 254},
+  [155] = {.lex_state = 197},
+  [156] = {.lex_state = 185},
+  [157] = {.lex_state = 254},
+  [158] = {.lex_state = 254},
+  [159] = {.lex_state = 309},
+  [160] = {.lex_state = 197},
+  [161] = {.lex_state = 279, .external_lex_state = 2},
+  [162] = {.lex_state = 185},
+  [163] = {.lex_state = 185},
+  [164] = {.lex_state = 309},
+  [165] = {.lex_state = 311},
+  [166] = {.lex_state = 197},
+  [167] = {.lex_state = 285},
+  [168] = {.lex_state = 262},
+  [169] = {.lex_state = 262},
+  [170] = {.lex_state = 185},
+  [171] = {.lex_state = 241},
+  [172] = {.lex_state = 262},
+  [173] = {.lex_state = 294},
+  [174] = {.lex_state = 289, .external_lex_state = 2},
+  [175] = {.lex_state = 292},
+  [176] = {.lex_state = 239},
+  [177] = {.lex_state = 294},
+  [178] = {.lex_state = 313},
+  [179] = {.lex_state = 303},
+  [180] = {.lex_state = 239},
+  [181] = {.lex_state = 315},
+  [182] = {.lex_state = 311},
+  [183] = {.lex_state = 221},
+  [184] = {.lex_state = 292},
+  [185] = {.lex_state = 185},
+  [186] = {.lex_state = 245},
+  [187] = {.lex_state = 185},
+  [188] = {.lex_state = 249},
+  [189] = {.lex_state = 185},
+  [190] = {.lex_state = 251},
+  [191] = {.lex_state = 185},
+  [192] = {.lex_state = 254},
+  [193] = {.lex_state = 233},
+  [194] = {.lex_state = 283, .external_lex_state = 2},
+  [195] = {.lex_state = 283, .external_lex_state = 2},
+  [196] = {.lex_state = 287},
+  [197] = {.lex_state = 289, .external_lex_state = 2},
+  [198] = {.lex_state = 292},
+  [199] = {.lex_state = 264},
+  [200] = {.lex_state = 264},
+  [201] = {.lex_state = 241},
+  [202] = {.lex_state = 241},
+  [203] = {.lex_state = 262},
+  [204] = {.lex_state = 239},
+  [205] = {.lex_state = 264},
+  [206] = {.lex_state = 307},
+  [207] = {.lex_state = 254},
+  [208] = {.lex_state = 185},
+  [209] = {.lex_state = 254},
+  [210] = {.lex_state = 262},
+  [211] = {.lex_state = 279, .external_lex_state = 2},
+  [212] = {.lex_state = 279, .external_lex_state = 2},
+  [213] = {.lex_state = 262},
+  [214] = {.lex_state = 285},
+  [215] = {.lex_state = 296},
+  [216] = {.lex_state = 269, .external_lex_state = 2},
+  [217] = {.lex_state = 269, .external_lex_state = 2},
+  [218] = {.lex_state = 225},
+  [219] = {.lex_state = 228},
+  [220] = {.lex_state = 269, .external_lex_state = 2},
+  [221] = {.lex_state = 269, .external_lex_state = 2},
+  [222] = {.lex_state = 269, .external_lex_state = 2},
+  [223] = {.lex_state = 269, .external_lex_state = 2},
+  [224] = {.lex_state = 269, .external_lex_state = 2},
+  [225] = {.lex_state = 269, .external_lex_state = 2},
+  [226] = {.lex_state = 285},
+  [227] = {.lex_state = 279, .external_lex_state = 2},
+  [228] = {.lex_state = 283, .external_lex_state = 2},
+  [229] = {.lex_state = 266},
+  [230] = {.lex_state = 317},
+  [231] = {.lex_state = 292},
+  [232] = {.lex_state = 262},
+  [233] = {.lex_state = 262},
+  [234] = {.lex_state = 185},
+  [235] = {.lex_state = 294},
+  [236] = {.lex_state = 289, .external_lex_state = 2},
+  [237] = {.lex_state = 262},
+  [238] = {.lex_state = 303},
+  [239] = {.lex_state = 311},
+  [240] = {.lex_state = 303},
+  [241] = {.lex_state = 315},
+  [242] = {.lex_state = 319},
+  [243] = {.lex_state = 311},
+  [244] = {.lex_state = 221},
+  [245] = {.lex_state = 185},
+  [246] = {.lex_state = 185},
+  [247] = {.lex_state = 185},
+  [248] = {.lex_state = 185},
+  [249] = {.lex_state = 254},
+  [250] = {.lex_state = 185},
+  [251] = {.lex_state = 185},
+  [252] = {.lex_state = 311},
+  [253] = {.lex_state = 262},
+  [254] = {.lex_state = 262},
+  [255] = {.lex_state = 185},
+  [256] = {.lex_state = 289, .external_lex_state = 2},
+  [257] = {.lex_state = 292},
+  [258] = {.lex_state = 269, .external_lex_state = 2},
+  [259] = {.lex_state = 292},
+  [260] = {.lex_state = 269, .external_lex_state = 2},
+  [261] = {.lex_state = 292},
+  [262] = {.lex_state = 264},
+  [263] = {.lex_state = 264},
+  [264] = {.lex_state = 321},
+  [265] = {.lex_state = 262},
+  [266] = {.lex_state = 251},
+  [267] = {.lex_state = 292},
+  [268] = {.lex_state = 264},
+  [269] = {.lex_state = 185},
+  [270] = {.lex_state = 323},
+  [271] = {.lex_state = 323},
+  [272] = {.lex_state = 313},
+  [273] = {.lex_state = 269, .external_lex_state = 2},
+  [274] = {.lex_state = 239},
+  [275] = {.lex_state = 315},
+  [276] = {.lex_state = 269, .external_lex_state = 2},
+  [277] = {.lex_state = 225},
+  [278] = {.lex_state = 269, .external_lex_state = 2},
+  [279] = {.lex_state = 228},
+  [280] = {.lex_state = 185},
+  [281] = {.lex_state = 262},
+  [282] = {.lex_state = 185},
+  [283] = {.lex_state = 185},
+  [284] = {.lex_state = 294},
+  [285] = {.lex_state = 292},
+  [286] = {.lex_state = 311},
+  [287] = {.lex_state = 279, .external_lex_state = 2},
+  [288] = {.lex_state = 283, .external_lex_state = 2},
+  [289] = {.lex_state = 292},
+  [290] = {.lex_state = 262},
+  [291] = {.lex_state = 262},
+  [292] = {.lex_state = 185},
+  [293] = {.lex_state = 317},
+  [294] = {.lex_state = 315},
+  [295] = {.lex_state = 303},
+  [296] = {.lex_state = 319},
+  [297] = {.lex_state = 185},
+  [298] = {.lex_state = 254},
+  [299] = {.lex_state = 269, .external_lex_state = 2},
+  [300] = {.lex_state = 279, .external_lex_state = 2},
+  [301] = {.lex_state = 283, .external_lex_state = 2},
+  [302] = {.lex_state = 262},
+  [303] = {.lex_state = 262},
+  [304] = {.lex_state = 185},
+  [305] = {.lex_state = 289, .external_lex_state = 2},
+  [306] = {.lex_state = 245},
+  [307] = {.lex_state = 269, .external_lex_state = 2},
+  [308] = {.lex_state = 292},
+  [309] = {.lex_state = 245},
+  [310] = {.lex_state = 269, .external_lex_state = 2},
+  [311] = {.lex_state = 292},
+  [312] = {.lex_state = 269, .external_lex_state = 2},
+  [313] = {.lex_state = 292},
+  [314] = {.lex_state = 269, .external_lex_state = 2},
+  [315] = {.lex_state = 292},
+  [316] = {.lex_state = 311},
+  [317] = {.lex_state = 321},
+  [318] = {.lex_state = 251},
+  [319] = {.lex_state = 292},
+  [320] = {.lex_state = 251},
+  [321] = {.lex_state = 292},
+  [322] = {.lex_state = 279, .external_lex_state = 2},
+  [323] = {.lex_state = 285},
+  [324] = {.lex_state = 269, .external_lex_state = 2},
+  [325] = {.lex_state = 311},
+  [326] = {.lex_state = 269, .external_lex_state = 2},
+  [327] = {.lex_state = 315},
+  [328] = {.lex_state = 269, .external_lex_state = 2},
+  [329] = {.lex_state = 269, .external_lex_state = 2},
+  [330] = {.lex_state = 283, .external_lex_state = 2},
+  [331] = {.lex_state = 294},
+  [332] = {.lex_state = 292},
+  [333] = {.lex_state = 292},
+  [334] = {.lex_state = 262},
+  [335] = {.lex_state = 185},
+  [336] = {.lex_state = 185},
+  [337] = {.lex_state = 279, .external_lex_state = 2},
+  [338] = {.lex_state = 283, .external_lex_state = 2},
+  [339] = {.lex_state = 311},
+  [340] = {.lex_state = 197},
+  [341] = {.lex_state = 303},
+  [342] = {.lex_state = 315},
+  [343] = {.lex_state = 185},
+  [344] = {.lex_state = 185},
+  [345] = {.lex_state = 262},
+  [346] = {.lex_state = 185},
+  [347] = {.lex_state = 185},
+  [348] = {.lex_state = 279, .external_lex_state = 2},
+  [349] = {.lex_state = 283, .external_lex_state = 2},
+  [350] = {.lex_state = 262},
+  [351] = {.lex_state = 262},
+  [352] = {.lex_state = 185},
+  [353] = {.lex_state = 245},
+  [354] = {.lex_state = 269, .external_lex_state = 2},
+  [355] = {.lex_state = 245},
+  [356] = {.lex_state = 269, .external_lex_state = 2},
+  [357] = {.lex_state = 245},
+  [358] = {.lex_state = 269, .external_lex_state = 2},
+  [359] = {.lex_state = 292},
+  [360] = {.lex_state = 245},
+  [361] = {.lex_state = 269, .external_lex_state = 2},
+  [362] = {.lex_state = 292},
+  [363] = {.lex_state = 249},
+  [364] = {.lex_state = 311},
+  [365] = {.lex_state = 251},
+  [366] = {.lex_state = 251},
+  [367] = {.lex_state = 292},
+  [368] = {.lex_state = 315},
+  [369] = {.lex_state = 269, .external_lex_state = 2},
+  [370] = {.lex_state = 185},
+  [371] = {.lex_state = 294},
+  [372] = {.lex_state = 283, .external_lex_state = 2},
+  [373] = {.lex_state = 262},
+  [374] = {.lex_state = 185},
+  [375] = {.lex_state = 185},
+  [376] = {.lex_state = 292},
+  [377] = {.lex_state = 239},
+  [378] = {.lex_state = 197},
+  [379] = {.lex_state = 303},
+  [380] = {.lex_state = 283, .external_lex_state = 2},
+  [381] = {.lex_state = 262},
+  [382] = {.lex_state = 185},
+  [383] = {.lex_state = 185},
+  [384] = {.lex_state = 279, .external_lex_state = 2},
+  [385] = {.lex_state = 283, .external_lex_state = 2},
+  [386] = {.lex_state = 245},
+  [387] = {.lex_state = 245},
+  [388] = {.lex_state = 245},
+  [389] = {.lex_state = 269, .external_lex_state = 2},
+  [390] = {.lex_state = 245},
+  [391] = {.lex_state = 269, .external_lex_state = 2},
+  [392] = {.lex_state = 249},
+  [393] = {.lex_state = 251},
+  [394] = {.lex_state = 269, .external_lex_state = 2},
+  [395] = {.lex_state = 315},
+  [396] = {.lex_state = 185},
+  [397] = {.lex_state = 283, .external_lex_state = 2},
+  [398] = {.lex_state = 311},
+  [399] = {.lex_state = 239},
+  [400] = {.lex_state = 185},
+  [401] = {.lex_state = 283, .external_lex_state = 2},
+  [402] = {.lex_state = 262},
+  [403] = {.lex_state = 185},
+  [404] = {.lex_state = 185},
+  [405] = {.lex_state = 245},
+  [406] = {.lex_state = 245},
+  [407] = {.lex_state = 269, .external_lex_state = 2},
+  [408] = {.lex_state = 185},
+  [409] = {.lex_state = 315},
+  [410] = {.lex_state = 311},
+  [411] = {.lex_state = 185},
+  [412] = {.lex_state = 283, .external_lex_state = 2},
+  [413] = {.lex_state = 315},
+  [414] = {.lex_state = 185},
+};
+
+enum {
+  ts_external_token__automatic_semicolon = 0,
+  ts_external_token__template_chars = 1,
+  ts_external_token__ternary_qmark = 2,
+};
+
+static const TSSymbol ts_external_scanner_symbol_map[EXTERNAL_TOKEN_COUNT] = {
+  [ts_external_token__automatic_semicolon] = sym__automatic_semicolon,
+  [ts_external_token__template_chars] = sym__template_chars,
+  [ts_external_token__ternary_qmark] = sym__ternary_qmark,
+};
+
+static const bool ts_external_scanner_states[3][EXTERNAL_TOKEN_COUNT] = {
+  [1] = {
+    [ts_external_token__automatic_semicolon] = true,
+    [ts_external_token__template_chars] = true,
+    [ts_external_token__ternary_qmark] = true,
+  },
+  [2] = {
+    [ts_external_token__automatic_semicolon] = true,
+  },
+};
+
+static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
+  [0] = {
+    [ts_builtin_sym_end] = ACTIONS(1),
+    [anon_sym_import] = ACTIONS(1),
+    [anon_sym_as] = ACTIONS(1),
+    [anon_sym_stream] = ACTIONS(1),
+    [anon_sym_LBRACE] = ACTIONS(1),
+    [anon_sym_RBRACE] = ACTIONS(1),
+    [anon_sym_struct] = ACTIONS(1),
+    [anon_sym_let] = ACTIONS(1),
+    [anon_sym_COLON] = ACTIONS(1),
+    [anon_sym_EQ] = ACTIONS(1),
+    [anon_sym_enum] = ACTIONS(1),
+    [anon_sym_flagset] = ACTIONS(1),
+    [sym_optional] = ACTIONS(1),
+    [anon_sym_flag] = ACTIONS(1),
+    [anon_sym_case] = ACTIONS(1),
+    [anon_sym_LPAREN] = ACTIONS(1),
+    [anon_sym_COMMA] = ACTIONS(1),
+    [anon_sym_RPAREN] = ACTIONS(1),
+    [anon_sym_typealias] = ACTIONS(1),
+    [anon_sym_newtype] = ACTIONS(1),
+    [anon_sym_const] = ACTIONS(1),
+    [anon_sym_func] = ACTIONS(1),
+    [anon_sym_throws] = ACTIONS(1),
+    [anon_sym_DASH_GT] = ACTIONS(1),
+    [anon_sym_event] = ACTIONS(1),
+    [anon_sym_signal] = ACTIONS(1),
+    [anon_sym_LBRACK] = ACTIONS(1),
+    [anon_sym_RBRACK] = ACTIONS(1),
+    [anon_sym_DOT] = ACTIONS(1),
+    [anon_sym_QMARK] = ACTIONS(1),
+    [anon_sym_AT] = ACTIONS(1),
+    [anon_sym_Yes] = ACTIONS(1),
+    [anon_sym_No] = ACTIONS(1),
+    [anon_sym_DQUOTE] = ACTIONS(1),
+    [anon_sym_SQUOTE] = ACTIONS(1),
+    [sym_comment] = ACTIONS(3),
+    [sym_escape_sequence] = ACTIONS(1),
+    [sym_number] = ACTIONS(1),
+    [anon_sym_SEMI] = ACTIONS(1),
+    [sym__automatic_semicolon] = ACTIONS(1),
+    [sym__template_chars] = ACTIONS(1),
+    [sym__ternary_qmark] = ACTIONS(1),
+  },
+  [1] = {
+    [sym_file] = STATE(12),
+    [sym_statement] = STATE(13),
+    [sym_import] = STATE(14),
+    [sym_stream_declaration] = STATE(15),
+    [sym_struct_declaration] = STATE(16),
+    [sym_enum_declaration] = STATE(17),
+    [sym_flagset_declaration] = STATE(18),
+    [sym_typealias_declaration] = STATE(19),
+    [sym_const_declaration] = STATE(20),
+    [sym_func_declaration] = STATE(21),
+    [sym_annotation] = STATE(22),
+    [aux_sym_file_repeat1] = STATE(23),
+    [aux_sym_stream_declaration_repeat1] = STATE(24),
+    [ts_builtin_sym_end] = ACTIONS(5),
+    [anon_sym_import] = ACTIONS(7),
+    [anon_sym_stream] = ACTIONS(9),
+    [anon_sym_struct] = ACTIONS(11),
+    [anon_sym_enum] = ACTIONS(13),
+    [anon_sym_flagset] = ACTIONS(15),
+    [anon_sym_typealias] = ACTIONS(17),
+    [anon_sym_newtype] = ACTIONS(19),
+    [anon_sym_const] = ACTIONS(21),
+    [anon_sym_func] = ACTIONS(23),
+    [anon_sym_AT] = ACTIONS(25),
+    [sym_comment] = ACTIONS(3),
+  },
+};
+
+static const uint16_t ts_small_parse_table[] = {
+  [0] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(27), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(29), 1,
+      anon_sym_SQUOTE,
+    STATE(27), 1,
+      sym_string,
+  [13] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(31), 1,
+      sym_identifier,
+  [20] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(33), 1,
+      sym_identifier,
+  [27] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(35), 1,
+      sym_identifier,
+  [34] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      sym_identifier,
+  [41] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(39), 1,
+      sym_identifier,
+  [48] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(41), 1,
+      sym_identifier,
+  [55] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(43), 1,
+      sym_identifier,
+  [62] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(45), 1,
+      sym_identifier,
+  [69] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(47), 1,
+      sym_identifier,
+  [76] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(49), 1,
+      ts_builtin_sym_end,
+  [83] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(51), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [100] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(53), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [117] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(53), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [134] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(53), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [151] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(53), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [168] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(53), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [185] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(53), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [202] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(53), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [219] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(53), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [236] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(55), 14,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_let,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_flag,
+      anon_sym_case,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_event,
+      anon_sym_signal,
+      anon_sym_AT,
+  [256] = 23,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(7), 1,
+      anon_sym_import,
     ACTIONS(9), 1,
       anon_sym_stream,
-    ACTIONS(11), 1,
+    ACTIONS(11), 1,
+      anon_sym_struct,
+    ACTIONS(13), 1,
+      anon_sym_enum,
+    ACTIONS(15), 1,
+      anon_sym_flagset,
+    ACTIONS(17), 1,
+      anon_sym_typealias,
+    ACTIONS(19), 1,
+      anon_sym_newtype,
+    ACTIONS(21), 1,
+      anon_sym_const,
+    ACTIONS(23), 1,
+      anon_sym_func,
+    ACTIONS(25), 1,
+      anon_sym_AT,
+    ACTIONS(57), 1,
+      ts_builtin_sym_end,
+    STATE(14), 1,
+      sym_import,
+    STATE(15), 1,
+      sym_stream_declaration,
+    STATE(16), 1,
+      sym_struct_declaration,
+    STATE(17), 1,
+      sym_enum_declaration,
+    STATE(18), 1,
+      sym_flagset_declaration,
+    STATE(19), 1,
+      sym_typealias_declaration,
+    STATE(20), 1,
+      sym_const_declaration,
+    STATE(21), 1,
+      sym_func_declaration,
+    STATE(22), 1,
+      sym_annotation,
+    STATE(24), 1,
+      aux_sym_stream_declaration_repeat1,
+    STATE(37), 1,
+      sym_statement,
+  [326] = 11,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(25), 1,
+      anon_sym_AT,
+    ACTIONS(59), 1,
+      anon_sym_stream,
+    ACTIONS(61), 1,
+      anon_sym_struct,
+    ACTIONS(63), 1,
+      anon_sym_enum,
+    ACTIONS(65), 1,
+      anon_sym_flagset,
+    ACTIONS(67), 1,
+      anon_sym_typealias,
+    ACTIONS(69), 1,
+      anon_sym_newtype,
+    ACTIONS(71), 1,
+      anon_sym_const,
+    ACTIONS(73), 1,
+      anon_sym_func,
+    STATE(46), 1,
+      sym_annotation,
+  [360] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(75), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(77), 1,
+      sym_unescaped_double_string_fragment,
+    ACTIONS(79), 1,
+      sym_escape_sequence,
+    STATE(50), 1,
+      aux_sym_string_repeat1,
+  [376] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(81), 1,
+      anon_sym_SQUOTE,
+    ACTIONS(83), 1,
+      sym_unescaped_single_string_fragment,
+    ACTIONS(85), 1,
+      sym_escape_sequence,
+    STATE(54), 1,
+      aux_sym_string_repeat2,
+  [392] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(87), 1,
+      anon_sym_as,
+  [399] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+  [406] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(91), 1,
+      anon_sym_LBRACE,
+  [413] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(93), 1,
+      anon_sym_LBRACE,
+  [420] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(95), 1,
+      anon_sym_LBRACE,
+    ACTIONS(97), 1,
+      anon_sym_COLON,
+  [430] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(99), 1,
+      anon_sym_EQ,
+  [437] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(101), 1,
+      anon_sym_EQ,
+  [444] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(103), 1,
+      anon_sym_COLON,
+  [451] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(105), 1,
+      anon_sym_LPAREN,
+  [458] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(107), 1,
+      anon_sym_LPAREN,
+  [465] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(109), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [482] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(111), 1,
+      sym_identifier,
+  [489] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(113), 1,
+      sym_identifier,
+  [496] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(115), 1,
+      sym_identifier,
+  [503] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(117), 1,
+      sym_identifier,
+  [510] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(119), 1,
+      sym_identifier,
+  [517] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(121), 1,
+      sym_identifier,
+  [524] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(123), 1,
+      sym_identifier,
+  [531] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(125), 1,
+      sym_identifier,
+  [538] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(127), 14,
+      anon_sym_stream,
       anon_sym_struct,
-    ACTIONS(13), 1,
+      anon_sym_let,
       anon_sym_enum,
-    ACTIONS(15), 1,
       anon_sym_flagset,
-    ACTIONS(17), 1,
+      anon_sym_flag,
+      anon_sym_case,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
       anon_sym_func,
-    ACTIONS(19), 1,
+      anon_sym_event,
+      anon_sym_signal,
       anon_sym_AT,
-    ACTIONS(44), 1,
-      ts_builtin_sym_end,
-    STATE(2), 2,
-      sym_statement,
-      aux_sym_file_repeat1,
-    STATE(37), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-    STATE(36), 6,
-      sym_import,
-      sym_stream_declaration,
-      sym_struct_declaration,
-      sym_enum_declaration,
-      sym_flagset_declaration,
-      sym_func_declaration,
-  [88] = 11,
+  [558] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(46), 1,
-      anon_sym_COLON,
-    ACTIONS(48), 1,
-      anon_sym_LBRACK,
-    ACTIONS(50), 1,
+    ACTIONS(129), 7,
+      anon_sym_as,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
       anon_sym_RBRACK,
-    ACTIONS(54), 1,
+      anon_sym_AT,
+  [571] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(131), 3,
       anon_sym_DQUOTE,
-    ACTIONS(56), 1,
-      anon_sym_SQUOTE,
-    ACTIONS(58), 1,
-      sym_number,
-    ACTIONS(60), 1,
-      sym_identifier,
-    STATE(222), 1,
-      sym_literal,
-    ACTIONS(52), 2,
-      anon_sym_Yes,
-      anon_sym_No,
-    STATE(192), 4,
-      sym_bool,
-      sym_list,
-      sym_dictionary,
-      sym_string,
-  [126] = 9,
+      sym_unescaped_double_string_fragment,
+      sym_escape_sequence,
+  [580] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(48), 1,
-      anon_sym_LBRACK,
-    ACTIONS(54), 1,
+    ACTIONS(131), 3,
       anon_sym_DQUOTE,
-    ACTIONS(56), 1,
-      anon_sym_SQUOTE,
-    ACTIONS(58), 1,
-      sym_number,
-    ACTIONS(62), 1,
-      anon_sym_RPAREN,
-    STATE(180), 1,
-      sym_literal,
-    ACTIONS(64), 2,
-      anon_sym_Yes,
-      anon_sym_No,
-    STATE(192), 4,
-      sym_bool,
-      sym_list,
-      sym_dictionary,
-      sym_string,
-  [158] = 9,
+      sym_unescaped_double_string_fragment,
+      sym_escape_sequence,
+  [589] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(48), 1,
-      anon_sym_LBRACK,
-    ACTIONS(54), 1,
+    ACTIONS(133), 1,
       anon_sym_DQUOTE,
-    ACTIONS(56), 1,
-      anon_sym_SQUOTE,
-    ACTIONS(58), 1,
-      sym_number,
-    ACTIONS(66), 1,
+    ACTIONS(135), 1,
+      sym_unescaped_double_string_fragment,
+    ACTIONS(137), 1,
+      sym_escape_sequence,
+  [602] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(129), 7,
+      anon_sym_as,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COMMA,
       anon_sym_RPAREN,
-    STATE(188), 1,
-      sym_literal,
-    ACTIONS(64), 2,
-      anon_sym_Yes,
-      anon_sym_No,
-    STATE(192), 4,
-      sym_bool,
-      sym_list,
-      sym_dictionary,
-      sym_string,
-  [190] = 9,
+      anon_sym_RBRACK,
+      anon_sym_AT,
+  [615] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(48), 1,
-      anon_sym_LBRACK,
-    ACTIONS(54), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(56), 1,
+    ACTIONS(139), 3,
       anon_sym_SQUOTE,
-    ACTIONS(58), 1,
-      sym_number,
-    ACTIONS(68), 1,
-      anon_sym_RPAREN,
-    STATE(191), 1,
-      sym_literal,
-    ACTIONS(64), 2,
-      anon_sym_Yes,
-      anon_sym_No,
-    STATE(192), 4,
-      sym_bool,
-      sym_list,
-      sym_dictionary,
-      sym_string,
-  [222] = 4,
+      sym_unescaped_single_string_fragment,
+      sym_escape_sequence,
+  [624] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(139), 3,
+      anon_sym_SQUOTE,
+      sym_unescaped_single_string_fragment,
+      sym_escape_sequence,
+  [633] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(141), 1,
+      anon_sym_SQUOTE,
+    ACTIONS(143), 1,
+      sym_unescaped_single_string_fragment,
+    ACTIONS(145), 1,
+      sym_escape_sequence,
+  [646] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(147), 1,
+      sym_identifier,
+  [653] = 10,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(72), 1,
+    ACTIONS(25), 1,
       anon_sym_AT,
-    STATE(8), 2,
+    ACTIONS(149), 1,
+      anon_sym_RBRACE,
+    ACTIONS(151), 1,
+      anon_sym_event,
+    ACTIONS(153), 1,
+      anon_sym_signal,
+    STATE(22), 1,
       sym_annotation,
+    STATE(84), 1,
+      sym_event_declaration,
+    STATE(85), 1,
+      sym_signal_declaration,
+    STATE(86), 1,
       aux_sym_stream_declaration_repeat1,
-    ACTIONS(70), 9,
-      anon_sym_stream,
-      anon_sym_struct,
+    STATE(87), 1,
+      aux_sym_stream_declaration_repeat2,
+  [684] = 8,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(25), 1,
+      anon_sym_AT,
+    ACTIONS(155), 1,
+      anon_sym_RBRACE,
+    ACTIONS(157), 1,
       anon_sym_let,
-      anon_sym_enum,
-      anon_sym_flagset,
+    STATE(22), 1,
+      sym_annotation,
+    STATE(90), 1,
+      sym_field_declaration,
+    STATE(91), 1,
+      aux_sym_stream_declaration_repeat1,
+    STATE(92), 1,
+      aux_sym_struct_declaration_repeat1,
+  [709] = 8,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(25), 1,
+      anon_sym_AT,
+    ACTIONS(159), 1,
+      anon_sym_RBRACE,
+    ACTIONS(161), 1,
       anon_sym_case,
-      anon_sym_func,
-      anon_sym_event,
-      anon_sym_signal,
-  [244] = 8,
+    STATE(22), 1,
+      sym_annotation,
+    STATE(95), 1,
+      sym_case_declaration,
+    STATE(96), 1,
+      aux_sym_stream_declaration_repeat1,
+    STATE(97), 1,
+      aux_sym_enum_declaration_repeat1,
+  [734] = 8,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(25), 1,
+      anon_sym_AT,
+    ACTIONS(163), 1,
+      anon_sym_RBRACE,
+    ACTIONS(165), 1,
+      anon_sym_flag,
+    STATE(22), 1,
+      sym_annotation,
+    STATE(100), 1,
+      sym_flag_declaration,
+    STATE(101), 1,
+      aux_sym_stream_declaration_repeat1,
+    STATE(102), 1,
+      aux_sym_flagset_declaration_repeat1,
+  [759] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(167), 1,
+      sym_optional,
+  [766] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(169), 1,
+      anon_sym_LBRACK,
+    ACTIONS(171), 1,
+      sym_identifier,
+    STATE(106), 1,
+      sym_type,
+  [779] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(169), 1,
+      anon_sym_LBRACK,
+    ACTIONS(171), 1,
+      sym_identifier,
+    STATE(107), 1,
+      sym_type,
+  [792] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(48), 1,
+    ACTIONS(173), 1,
       anon_sym_LBRACK,
-    ACTIONS(54), 1,
+    ACTIONS(175), 1,
+      sym_identifier,
+    STATE(110), 1,
+      sym_type,
+  [805] = 7,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(177), 1,
+      anon_sym_RPAREN,
+    ACTIONS(179), 1,
+      anon_sym_AT,
+    ACTIONS(181), 1,
+      sym_identifier,
+    STATE(114), 1,
+      sym_arg,
+    STATE(115), 1,
+      sym_annotation,
+    STATE(116), 1,
+      aux_sym_stream_declaration_repeat1,
+  [827] = 13,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(27), 1,
       anon_sym_DQUOTE,
-    ACTIONS(56), 1,
+    ACTIONS(29), 1,
       anon_sym_SQUOTE,
-    ACTIONS(58), 1,
-      sym_number,
-    STATE(196), 1,
-      sym_literal,
-    ACTIONS(64), 2,
+    ACTIONS(183), 1,
+      anon_sym_RPAREN,
+    ACTIONS(185), 1,
+      anon_sym_LBRACK,
+    ACTIONS(187), 1,
       anon_sym_Yes,
+    ACTIONS(189), 1,
       anon_sym_No,
-    STATE(192), 4,
+    ACTIONS(191), 1,
+      sym_number,
+    STATE(122), 1,
+      sym_literal,
+    STATE(123), 1,
       sym_bool,
+    STATE(124), 1,
       sym_list,
+    STATE(125), 1,
       sym_dictionary,
+    STATE(126), 1,
       sym_string,
-  [273] = 8,
+  [867] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(48), 1,
-      anon_sym_LBRACK,
-    ACTIONS(54), 1,
+    ACTIONS(193), 1,
+      anon_sym_LBRACE,
+  [874] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(195), 1,
+      anon_sym_LBRACE,
+  [881] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(197), 1,
+      anon_sym_LBRACE,
+  [888] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(199), 1,
+      anon_sym_LBRACE,
+    ACTIONS(201), 1,
+      anon_sym_COLON,
+  [898] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(203), 1,
+      anon_sym_EQ,
+  [905] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(205), 1,
+      anon_sym_EQ,
+  [912] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(207), 1,
+      anon_sym_COLON,
+  [919] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(209), 1,
+      anon_sym_LPAREN,
+  [926] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(211), 7,
+      anon_sym_as,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+      anon_sym_AT,
+  [939] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(213), 3,
+      anon_sym_DQUOTE,
+      sym_unescaped_double_string_fragment,
+      sym_escape_sequence,
+  [948] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(213), 3,
       anon_sym_DQUOTE,
-    ACTIONS(56), 1,
+      sym_unescaped_double_string_fragment,
+      sym_escape_sequence,
+  [957] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(211), 7,
+      anon_sym_as,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+      anon_sym_AT,
+  [970] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(215), 3,
       anon_sym_SQUOTE,
-    ACTIONS(58), 1,
-      sym_number,
-    STATE(257), 1,
-      sym_literal,
-    ACTIONS(64), 2,
-      anon_sym_Yes,
-      anon_sym_No,
-    STATE(192), 4,
-      sym_bool,
-      sym_list,
-      sym_dictionary,
-      sym_string,
-  [302] = 8,
+      sym_unescaped_single_string_fragment,
+      sym_escape_sequence,
+  [979] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(48), 1,
-      anon_sym_LBRACK,
-    ACTIONS(54), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(56), 1,
+    ACTIONS(215), 3,
       anon_sym_SQUOTE,
-    ACTIONS(58), 1,
-      sym_number,
-    STATE(224), 1,
-      sym_literal,
-    ACTIONS(64), 2,
-      anon_sym_Yes,
-      anon_sym_No,
-    STATE(192), 4,
-      sym_bool,
-      sym_list,
-      sym_dictionary,
-      sym_string,
-  [331] = 2,
+      sym_unescaped_single_string_fragment,
+      sym_escape_sequence,
+  [988] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(75), 10,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_let,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_case,
-      anon_sym_func,
-      anon_sym_event,
-      anon_sym_signal,
-      anon_sym_AT,
-  [347] = 2,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    STATE(138), 1,
+      sym__semicolon,
+  [1001] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(77), 10,
+    ACTIONS(221), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
       anon_sym_stream,
       anon_sym_struct,
-      anon_sym_let,
       anon_sym_enum,
       anon_sym_flagset,
-      anon_sym_case,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
       anon_sym_func,
-      anon_sym_event,
-      anon_sym_signal,
       anon_sym_AT,
-  [363] = 2,
+  [1018] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(79), 10,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_let,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_case,
-      anon_sym_func,
-      anon_sym_event,
-      anon_sym_signal,
-      anon_sym_AT,
-  [379] = 2,
+    ACTIONS(223), 1,
+      sym_identifier,
+  [1025] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(225), 1,
+      sym_identifier,
+  [1032] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(81), 9,
+    ACTIONS(227), 4,
       anon_sym_RBRACE,
-      anon_sym_let,
-      anon_sym_COLON,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_RBRACK,
-      anon_sym_DOT,
-      anon_sym_QMARK,
+      anon_sym_event,
+      anon_sym_signal,
       anon_sym_AT,
-  [394] = 7,
+  [1042] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(83), 1,
+    ACTIONS(227), 4,
       anon_sym_RBRACE,
-    ACTIONS(85), 1,
       anon_sym_event,
-    ACTIONS(88), 1,
       anon_sym_signal,
-    ACTIONS(91), 1,
       anon_sym_AT,
-    STATE(121), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-    STATE(16), 3,
-      sym_event_declaration,
-      sym_signal_declaration,
-      aux_sym_stream_declaration_repeat2,
-  [419] = 7,
+  [1052] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(19), 1,
+    ACTIONS(25), 1,
       anon_sym_AT,
-    ACTIONS(94), 1,
-      anon_sym_RBRACE,
-    ACTIONS(96), 1,
+    ACTIONS(229), 1,
       anon_sym_event,
-    ACTIONS(98), 1,
+    ACTIONS(231), 1,
       anon_sym_signal,
-    STATE(121), 2,
+    STATE(46), 1,
       sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-    STATE(18), 3,
-      sym_event_declaration,
-      sym_signal_declaration,
-      aux_sym_stream_declaration_repeat2,
-  [444] = 7,
+  [1068] = 9,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(19), 1,
+    ACTIONS(25), 1,
       anon_sym_AT,
-    ACTIONS(96), 1,
+    ACTIONS(151), 1,
       anon_sym_event,
-    ACTIONS(98), 1,
+    ACTIONS(153), 1,
       anon_sym_signal,
-    ACTIONS(100), 1,
+    ACTIONS(233), 1,
       anon_sym_RBRACE,
-    STATE(121), 2,
+    STATE(22), 1,
       sym_annotation,
+    STATE(86), 1,
       aux_sym_stream_declaration_repeat1,
-    STATE(16), 3,
+    STATE(144), 1,
       sym_event_declaration,
+    STATE(145), 1,
       sym_signal_declaration,
-      aux_sym_stream_declaration_repeat2,
-  [469] = 2,
+  [1096] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(102), 9,
-      anon_sym_RBRACE,
-      anon_sym_let,
-      anon_sym_COLON,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_RBRACK,
-      anon_sym_DOT,
-      anon_sym_QMARK,
+    ACTIONS(235), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
       anon_sym_AT,
-  [484] = 2,
+  [1113] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(237), 1,
+      sym_identifier,
+  [1120] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(104), 9,
+    ACTIONS(239), 3,
       anon_sym_RBRACE,
       anon_sym_let,
-      anon_sym_COLON,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_RBRACK,
-      anon_sym_DOT,
-      anon_sym_QMARK,
       anon_sym_AT,
-  [499] = 7,
+  [1129] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(19), 1,
+    ACTIONS(25), 1,
       anon_sym_AT,
-    ACTIONS(96), 1,
-      anon_sym_event,
-    ACTIONS(98), 1,
-      anon_sym_signal,
-    ACTIONS(106), 1,
-      anon_sym_RBRACE,
-    STATE(121), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-    STATE(23), 3,
-      sym_event_declaration,
-      sym_signal_declaration,
-      aux_sym_stream_declaration_repeat2,
-  [524] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(108), 9,
-      anon_sym_RBRACE,
+    ACTIONS(241), 1,
       anon_sym_let,
-      anon_sym_COLON,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_RBRACK,
-      anon_sym_DOT,
-      anon_sym_QMARK,
-      anon_sym_AT,
-  [539] = 7,
+    STATE(46), 1,
+      sym_annotation,
+  [1142] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(19), 1,
+    ACTIONS(25), 1,
       anon_sym_AT,
-    ACTIONS(96), 1,
-      anon_sym_event,
-    ACTIONS(98), 1,
-      anon_sym_signal,
-    ACTIONS(110), 1,
+    ACTIONS(157), 1,
+      anon_sym_let,
+    ACTIONS(243), 1,
       anon_sym_RBRACE,
-    STATE(121), 2,
+    STATE(22), 1,
       sym_annotation,
+    STATE(91), 1,
       aux_sym_stream_declaration_repeat1,
-    STATE(16), 3,
-      sym_event_declaration,
-      sym_signal_declaration,
-      aux_sym_stream_declaration_repeat2,
-  [564] = 2,
+    STATE(149), 1,
+      sym_field_declaration,
+  [1164] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(112), 8,
+    ACTIONS(245), 11,
       ts_builtin_sym_end,
       anon_sym_import,
       anon_sym_stream,
       anon_sym_struct,
       anon_sym_enum,
       anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
       anon_sym_func,
       anon_sym_AT,
-  [578] = 2,
+  [1181] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(114), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(247), 1,
+      sym_identifier,
+  [1188] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(249), 3,
+      anon_sym_RBRACE,
+      anon_sym_case,
       anon_sym_AT,
-  [592] = 2,
+  [1197] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(116), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(25), 1,
       anon_sym_AT,
-  [606] = 2,
+    ACTIONS(251), 1,
+      anon_sym_case,
+    STATE(46), 1,
+      sym_annotation,
+  [1210] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(118), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(25), 1,
       anon_sym_AT,
-  [620] = 2,
+    ACTIONS(161), 1,
+      anon_sym_case,
+    ACTIONS(253), 1,
+      anon_sym_RBRACE,
+    STATE(22), 1,
+      sym_annotation,
+    STATE(96), 1,
+      aux_sym_stream_declaration_repeat1,
+    STATE(153), 1,
+      sym_case_declaration,
+  [1232] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(120), 8,
+    ACTIONS(255), 11,
       ts_builtin_sym_end,
       anon_sym_import,
       anon_sym_stream,
       anon_sym_struct,
       anon_sym_enum,
       anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
       anon_sym_func,
       anon_sym_AT,
-  [634] = 2,
+  [1249] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(122), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(257), 1,
+      sym_identifier,
+  [1256] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(259), 3,
+      anon_sym_RBRACE,
+      anon_sym_flag,
+      anon_sym_AT,
+  [1265] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(25), 1,
+      anon_sym_AT,
+    ACTIONS(261), 1,
+      anon_sym_flag,
+    STATE(46), 1,
+      sym_annotation,
+  [1278] = 7,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(25), 1,
+      anon_sym_AT,
+    ACTIONS(165), 1,
+      anon_sym_flag,
+    ACTIONS(263), 1,
+      anon_sym_RBRACE,
+    STATE(22), 1,
+      sym_annotation,
+    STATE(101), 1,
+      aux_sym_stream_declaration_repeat1,
+    STATE(157), 1,
+      sym_flag_declaration,
+  [1300] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(265), 1,
+      anon_sym_LBRACE,
+  [1307] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(173), 1,
+      anon_sym_LBRACK,
+    ACTIONS(175), 1,
+      sym_identifier,
+    STATE(159), 1,
+      sym_type,
+  [1320] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(267), 5,
+      anon_sym_DASH_GT,
+      anon_sym_DOT,
+      anon_sym_QMARK,
+      anon_sym_SEMI,
+      sym__automatic_semicolon,
+  [1331] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    STATE(162), 1,
+      sym__semicolon,
+  [1350] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    STATE(163), 1,
+      sym__semicolon,
+  [1369] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(173), 1,
+      anon_sym_LBRACK,
+    ACTIONS(175), 1,
+      sym_identifier,
+    STATE(164), 1,
+      sym_type,
+  [1382] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(267), 10,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COLON,
+      anon_sym_EQ,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+      anon_sym_DOT,
+      anon_sym_QMARK,
       anon_sym_AT,
-  [648] = 2,
+  [1398] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(273), 1,
+      anon_sym_EQ,
+    ACTIONS(275), 1,
+      anon_sym_DOT,
+    ACTIONS(277), 1,
+      anon_sym_QMARK,
+  [1411] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(279), 1,
+      anon_sym_throws,
+    ACTIONS(281), 1,
+      anon_sym_DASH_GT,
+    STATE(170), 1,
+      sym__semicolon,
+  [1430] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(283), 1,
+      sym_identifier,
+  [1437] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(285), 1,
+      anon_sym_COLON,
+  [1444] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(287), 1,
+      anon_sym_COMMA,
+    ACTIONS(289), 1,
+      anon_sym_RPAREN,
+    STATE(175), 1,
+      aux_sym_case_declaration_repeat1,
+  [1457] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(124), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(55), 2,
       anon_sym_AT,
-  [662] = 2,
+      sym_identifier,
+  [1465] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(126), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(179), 1,
       anon_sym_AT,
-  [676] = 2,
+    ACTIONS(291), 1,
+      sym_identifier,
+    STATE(177), 1,
+      sym_annotation,
+  [1478] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(128), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
+    ACTIONS(293), 14,
       anon_sym_stream,
       anon_sym_struct,
+      anon_sym_let,
       anon_sym_enum,
       anon_sym_flagset,
+      anon_sym_flag,
+      anon_sym_case,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
       anon_sym_func,
+      anon_sym_event,
+      anon_sym_signal,
       anon_sym_AT,
-  [690] = 2,
+  [1498] = 15,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(130), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
-      anon_sym_AT,
-  [704] = 2,
+    ACTIONS(27), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(29), 1,
+      anon_sym_SQUOTE,
+    ACTIONS(185), 1,
+      anon_sym_LBRACK,
+    ACTIONS(187), 1,
+      anon_sym_Yes,
+    ACTIONS(189), 1,
+      anon_sym_No,
+    ACTIONS(191), 1,
+      sym_number,
+    ACTIONS(295), 1,
+      anon_sym_COLON,
+    ACTIONS(297), 1,
+      anon_sym_RBRACK,
+    ACTIONS(299), 1,
+      sym_identifier,
+    STATE(123), 1,
+      sym_bool,
+    STATE(124), 1,
+      sym_list,
+    STATE(125), 1,
+      sym_dictionary,
+    STATE(126), 1,
+      sym_string,
+    STATE(181), 1,
+      sym_literal,
+  [1544] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(132), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(301), 6,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
       anon_sym_AT,
-  [718] = 2,
+  [1556] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(134), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(301), 6,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
       anon_sym_AT,
-  [732] = 2,
+  [1568] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(136), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(303), 6,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
       anon_sym_AT,
-  [746] = 8,
+  [1580] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(19), 1,
-      anon_sym_AT,
-    ACTIONS(138), 1,
-      anon_sym_stream,
-    ACTIONS(140), 1,
-      anon_sym_struct,
-    ACTIONS(142), 1,
-      anon_sym_enum,
-    ACTIONS(144), 1,
-      anon_sym_flagset,
-    ACTIONS(146), 1,
-      anon_sym_func,
-    STATE(8), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [772] = 2,
+    ACTIONS(305), 1,
+      anon_sym_COMMA,
+    ACTIONS(307), 1,
+      anon_sym_RPAREN,
+    STATE(184), 1,
+      aux_sym_annotation_repeat1,
+  [1593] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(148), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(303), 6,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
       anon_sym_AT,
-  [786] = 2,
+  [1605] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(150), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(303), 6,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
       anon_sym_AT,
-  [800] = 2,
+  [1617] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(152), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(303), 6,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
       anon_sym_AT,
-  [814] = 2,
+  [1629] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(154), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(303), 6,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
       anon_sym_AT,
-  [828] = 2,
+  [1641] = 10,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(156), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(25), 1,
       anon_sym_AT,
-  [842] = 2,
+    ACTIONS(151), 1,
+      anon_sym_event,
+    ACTIONS(153), 1,
+      anon_sym_signal,
+    ACTIONS(309), 1,
+      anon_sym_RBRACE,
+    STATE(22), 1,
+      sym_annotation,
+    STATE(84), 1,
+      sym_event_declaration,
+    STATE(85), 1,
+      sym_signal_declaration,
+    STATE(86), 1,
+      aux_sym_stream_declaration_repeat1,
+    STATE(186), 1,
+      aux_sym_stream_declaration_repeat2,
+  [1672] = 8,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(158), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(25), 1,
       anon_sym_AT,
-  [856] = 2,
+    ACTIONS(157), 1,
+      anon_sym_let,
+    ACTIONS(311), 1,
+      anon_sym_RBRACE,
+    STATE(22), 1,
+      sym_annotation,
+    STATE(90), 1,
+      sym_field_declaration,
+    STATE(91), 1,
+      aux_sym_stream_declaration_repeat1,
+    STATE(188), 1,
+      aux_sym_struct_declaration_repeat1,
+  [1697] = 8,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(160), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(25), 1,
       anon_sym_AT,
-  [870] = 2,
+    ACTIONS(161), 1,
+      anon_sym_case,
+    ACTIONS(313), 1,
+      anon_sym_RBRACE,
+    STATE(22), 1,
+      sym_annotation,
+    STATE(95), 1,
+      sym_case_declaration,
+    STATE(96), 1,
+      aux_sym_stream_declaration_repeat1,
+    STATE(190), 1,
+      aux_sym_enum_declaration_repeat1,
+  [1722] = 8,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(162), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(25), 1,
       anon_sym_AT,
-  [884] = 2,
+    ACTIONS(165), 1,
+      anon_sym_flag,
+    ACTIONS(315), 1,
+      anon_sym_RBRACE,
+    STATE(22), 1,
+      sym_annotation,
+    STATE(100), 1,
+      sym_flag_declaration,
+    STATE(101), 1,
+      aux_sym_stream_declaration_repeat1,
+    STATE(192), 1,
+      aux_sym_flagset_declaration_repeat1,
+  [1747] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(164), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
-      anon_sym_AT,
-  [898] = 2,
+    ACTIONS(317), 1,
+      sym_optional,
+  [1754] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(166), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
-      anon_sym_AT,
-  [912] = 2,
+    ACTIONS(169), 1,
+      anon_sym_LBRACK,
+    ACTIONS(171), 1,
+      sym_identifier,
+    STATE(194), 1,
+      sym_type,
+  [1767] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(168), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(169), 1,
+      anon_sym_LBRACK,
+    ACTIONS(171), 1,
+      sym_identifier,
+    STATE(195), 1,
+      sym_type,
+  [1780] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(173), 1,
+      anon_sym_LBRACK,
+    ACTIONS(175), 1,
+      sym_identifier,
+    STATE(196), 1,
+      sym_type,
+  [1793] = 7,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(179), 1,
       anon_sym_AT,
-  [926] = 2,
+    ACTIONS(181), 1,
+      sym_identifier,
+    ACTIONS(319), 1,
+      anon_sym_RPAREN,
+    STATE(115), 1,
+      sym_annotation,
+    STATE(116), 1,
+      aux_sym_stream_declaration_repeat1,
+    STATE(198), 1,
+      sym_arg,
+  [1815] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(170), 8,
+    ACTIONS(321), 14,
       ts_builtin_sym_end,
       anon_sym_import,
       anon_sym_stream,
+      anon_sym_RBRACE,
       anon_sym_struct,
       anon_sym_enum,
       anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
       anon_sym_func,
+      anon_sym_event,
+      anon_sym_signal,
       anon_sym_AT,
-  [940] = 2,
+  [1835] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(172), 8,
+    ACTIONS(321), 14,
       ts_builtin_sym_end,
       anon_sym_import,
       anon_sym_stream,
+      anon_sym_RBRACE,
       anon_sym_struct,
       anon_sym_enum,
       anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
       anon_sym_func,
+      anon_sym_event,
+      anon_sym_signal,
       anon_sym_AT,
-  [954] = 2,
+  [1855] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(174), 8,
+    ACTIONS(323), 11,
       ts_builtin_sym_end,
       anon_sym_import,
       anon_sym_stream,
       anon_sym_struct,
       anon_sym_enum,
       anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
       anon_sym_func,
       anon_sym_AT,
-  [968] = 2,
+  [1872] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(176), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
-      anon_sym_AT,
-  [982] = 2,
+    ACTIONS(325), 1,
+      anon_sym_LPAREN,
+  [1879] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(178), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
-      anon_sym_AT,
-  [996] = 2,
+    ACTIONS(327), 1,
+      anon_sym_LPAREN,
+  [1886] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(180), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
-      anon_sym_AT,
-  [1010] = 2,
+    ACTIONS(329), 1,
+      sym_identifier,
+  [1893] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(331), 1,
+      sym_identifier,
+  [1900] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(182), 8,
+    ACTIONS(333), 11,
       ts_builtin_sym_end,
       anon_sym_import,
       anon_sym_stream,
       anon_sym_struct,
       anon_sym_enum,
       anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
       anon_sym_func,
       anon_sym_AT,
-  [1024] = 2,
+  [1917] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(184), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(335), 4,
+      anon_sym_RBRACE,
+      anon_sym_event,
+      anon_sym_signal,
       anon_sym_AT,
-  [1038] = 2,
+  [1927] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(186), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(335), 4,
+      anon_sym_RBRACE,
+      anon_sym_event,
+      anon_sym_signal,
       anon_sym_AT,
-  [1052] = 2,
+  [1937] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(188), 8,
+    ACTIONS(337), 1,
+      anon_sym_COLON,
+  [1944] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(339), 1,
+      sym_identifier,
+  [1951] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(341), 11,
       ts_builtin_sym_end,
       anon_sym_import,
       anon_sym_stream,
       anon_sym_struct,
       anon_sym_enum,
       anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
       anon_sym_func,
       anon_sym_AT,
-  [1066] = 2,
+  [1968] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(190), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(343), 3,
+      anon_sym_RBRACE,
+      anon_sym_let,
       anon_sym_AT,
-  [1080] = 2,
+  [1977] = 3,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(192), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(345), 3,
+      anon_sym_RBRACE,
+      anon_sym_case,
       anon_sym_AT,
-  [1094] = 2,
+    ACTIONS(347), 1,
+      anon_sym_LPAREN,
+  [1989] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(349), 1,
+      sym_identifier,
+  [1996] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(194), 8,
+    ACTIONS(351), 11,
       ts_builtin_sym_end,
       anon_sym_import,
       anon_sym_stream,
       anon_sym_struct,
       anon_sym_enum,
       anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
       anon_sym_func,
       anon_sym_AT,
-  [1108] = 2,
+  [2013] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(196), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(353), 3,
+      anon_sym_RBRACE,
+      anon_sym_case,
       anon_sym_AT,
-  [1122] = 2,
+  [2022] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(198), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(355), 3,
+      anon_sym_RBRACE,
+      anon_sym_flag,
       anon_sym_AT,
-  [1136] = 2,
+  [2031] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(357), 1,
+      sym_identifier,
+  [2038] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(200), 8,
+    ACTIONS(359), 11,
       ts_builtin_sym_end,
       anon_sym_import,
       anon_sym_stream,
       anon_sym_struct,
       anon_sym_enum,
       anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
       anon_sym_func,
       anon_sym_AT,
-  [1150] = 2,
+  [2055] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(202), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(361), 3,
+      anon_sym_RBRACE,
+      anon_sym_flag,
       anon_sym_AT,
-  [1164] = 2,
+  [2064] = 8,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(204), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(25), 1,
       anon_sym_AT,
-  [1178] = 2,
+    ACTIONS(165), 1,
+      anon_sym_flag,
+    ACTIONS(363), 1,
+      anon_sym_RBRACE,
+    STATE(22), 1,
+      sym_annotation,
+    STATE(100), 1,
+      sym_flag_declaration,
+    STATE(101), 1,
+      aux_sym_stream_declaration_repeat1,
+    STATE(209), 1,
+      aux_sym_flagset_declaration_repeat1,
+  [2089] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(275), 1,
+      anon_sym_DOT,
+    ACTIONS(277), 1,
+      anon_sym_QMARK,
+    ACTIONS(365), 1,
+      anon_sym_COLON,
+    ACTIONS(367), 1,
+      anon_sym_RBRACK,
+  [2105] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(369), 1,
+      sym_identifier,
+  [2112] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(371), 5,
+      anon_sym_DASH_GT,
+      anon_sym_DOT,
+      anon_sym_QMARK,
+      anon_sym_SEMI,
+      sym__automatic_semicolon,
+  [2123] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(206), 8,
+    ACTIONS(373), 11,
       ts_builtin_sym_end,
       anon_sym_import,
       anon_sym_stream,
       anon_sym_struct,
       anon_sym_enum,
       anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
       anon_sym_func,
       anon_sym_AT,
-  [1192] = 2,
+  [2140] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(208), 8,
+    ACTIONS(373), 11,
       ts_builtin_sym_end,
       anon_sym_import,
       anon_sym_stream,
       anon_sym_struct,
       anon_sym_enum,
       anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
       anon_sym_func,
       anon_sym_AT,
-  [1206] = 2,
+  [2157] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(210), 8,
-      ts_builtin_sym_end,
-      anon_sym_import,
-      anon_sym_stream,
-      anon_sym_struct,
-      anon_sym_enum,
-      anon_sym_flagset,
-      anon_sym_func,
+    ACTIONS(275), 1,
+      anon_sym_DOT,
+    ACTIONS(277), 1,
+      anon_sym_QMARK,
+    ACTIONS(375), 1,
+      anon_sym_COLON,
+    ACTIONS(377), 1,
+      anon_sym_RBRACK,
+  [2173] = 12,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(379), 1,
+      anon_sym_LBRACK,
+    ACTIONS(381), 1,
+      anon_sym_Yes,
+    ACTIONS(383), 1,
+      anon_sym_No,
+    ACTIONS(385), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(387), 1,
+      anon_sym_SQUOTE,
+    ACTIONS(389), 1,
+      sym_number,
+    STATE(221), 1,
+      sym_literal,
+    STATE(222), 1,
+      sym_bool,
+    STATE(223), 1,
+      sym_list,
+    STATE(224), 1,
+      sym_dictionary,
+    STATE(225), 1,
+      sym_string,
+  [2210] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(391), 1,
+      sym_identifier,
+  [2217] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(371), 10,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COLON,
+      anon_sym_EQ,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+      anon_sym_DOT,
+      anon_sym_QMARK,
       anon_sym_AT,
-  [1220] = 2,
+  [2233] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(169), 1,
+      anon_sym_LBRACK,
+    ACTIONS(171), 1,
+      sym_identifier,
+    STATE(227), 1,
+      sym_type,
+  [2246] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(212), 8,
+    ACTIONS(169), 1,
+      anon_sym_LBRACK,
+    ACTIONS(171), 1,
+      sym_identifier,
+    STATE(228), 1,
+      sym_type,
+  [2259] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(393), 11,
       ts_builtin_sym_end,
       anon_sym_import,
       anon_sym_stream,
       anon_sym_struct,
       anon_sym_enum,
       anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
       anon_sym_func,
       anon_sym_AT,
-  [1234] = 6,
+  [2276] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(214), 1,
-      anon_sym_RBRACE,
-    ACTIONS(216), 1,
-      anon_sym_flag,
-    ACTIONS(218), 1,
-      anon_sym_AT,
-    STATE(74), 2,
-      sym_flag_declaration,
-      aux_sym_flagset_declaration_repeat1,
-    STATE(159), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1255] = 6,
+    ACTIONS(395), 1,
+      anon_sym_LPAREN,
+  [2283] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(216), 1,
-      anon_sym_flag,
-    ACTIONS(218), 1,
+    ACTIONS(173), 1,
+      anon_sym_LBRACK,
+    ACTIONS(175), 1,
+      sym_identifier,
+    STATE(230), 1,
+      sym_type,
+  [2296] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(179), 1,
       anon_sym_AT,
-    ACTIONS(220), 1,
-      anon_sym_RBRACE,
-    STATE(85), 2,
-      sym_flag_declaration,
-      aux_sym_flagset_declaration_repeat1,
-    STATE(159), 2,
+    ACTIONS(181), 1,
+      sym_identifier,
+    STATE(115), 1,
       sym_annotation,
+    STATE(116), 1,
       aux_sym_stream_declaration_repeat1,
-  [1276] = 6,
+    STATE(231), 1,
+      sym_arg,
+  [2315] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(216), 1,
-      anon_sym_flag,
-    ACTIONS(218), 1,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(397), 1,
+      anon_sym_throws,
+    ACTIONS(399), 1,
+      anon_sym_DASH_GT,
+    STATE(234), 1,
+      sym__semicolon,
+  [2334] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(401), 1,
+      anon_sym_COMMA,
+    ACTIONS(403), 1,
+      anon_sym_RPAREN,
+  [2344] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(405), 1,
+      anon_sym_COLON,
+  [2351] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(127), 2,
       anon_sym_AT,
-    ACTIONS(222), 1,
+      sym_identifier,
+  [2359] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(407), 1,
+      anon_sym_RBRACK,
+  [2366] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(409), 6,
       anon_sym_RBRACE,
-    STATE(85), 2,
-      sym_flag_declaration,
-      aux_sym_flagset_declaration_repeat1,
-    STATE(159), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1297] = 6,
+      anon_sym_let,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+      anon_sym_AT,
+  [2378] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(411), 1,
+      anon_sym_COLON,
+  [2385] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(305), 1,
+      anon_sym_COMMA,
+    ACTIONS(413), 1,
+      anon_sym_RBRACK,
+    STATE(241), 1,
+      aux_sym_annotation_repeat1,
+  [2398] = 12,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(216), 1,
-      anon_sym_flag,
-    ACTIONS(218), 1,
-      anon_sym_AT,
-    ACTIONS(224), 1,
-      anon_sym_RBRACE,
-    STATE(85), 2,
-      sym_flag_declaration,
-      aux_sym_flagset_declaration_repeat1,
-    STATE(159), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1318] = 6,
+    ACTIONS(27), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(29), 1,
+      anon_sym_SQUOTE,
+    ACTIONS(185), 1,
+      anon_sym_LBRACK,
+    ACTIONS(187), 1,
+      anon_sym_Yes,
+    ACTIONS(189), 1,
+      anon_sym_No,
+    ACTIONS(191), 1,
+      sym_number,
+    STATE(123), 1,
+      sym_bool,
+    STATE(124), 1,
+      sym_list,
+    STATE(125), 1,
+      sym_dictionary,
+    STATE(126), 1,
+      sym_string,
+    STATE(242), 1,
+      sym_literal,
+  [2435] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(216), 1,
+    ACTIONS(415), 14,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_let,
+      anon_sym_enum,
+      anon_sym_flagset,
       anon_sym_flag,
-    ACTIONS(218), 1,
+      anon_sym_case,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_event,
+      anon_sym_signal,
       anon_sym_AT,
-    ACTIONS(226), 1,
-      anon_sym_RBRACE,
-    STATE(85), 2,
-      sym_flag_declaration,
-      aux_sym_flagset_declaration_repeat1,
-    STATE(159), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1339] = 6,
+  [2455] = 3,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(19), 1,
-      anon_sym_AT,
-    ACTIONS(228), 1,
-      anon_sym_RBRACE,
-    ACTIONS(230), 1,
-      anon_sym_case,
-    STATE(89), 2,
-      sym_case_declaration,
-      aux_sym_enum_declaration_repeat1,
-    STATE(160), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1360] = 6,
+    ACTIONS(417), 1,
+      anon_sym_COMMA,
+    ACTIONS(419), 1,
+      anon_sym_RPAREN,
+  [2465] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(19), 1,
+    ACTIONS(421), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
       anon_sym_AT,
-    ACTIONS(232), 1,
-      anon_sym_RBRACE,
-    ACTIONS(234), 1,
-      anon_sym_let,
-    STATE(80), 2,
-      sym_field_declaration,
-      aux_sym_struct_declaration_repeat1,
-    STATE(155), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1381] = 6,
+  [2482] = 9,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(19), 1,
+    ACTIONS(25), 1,
       anon_sym_AT,
-    ACTIONS(230), 1,
-      anon_sym_case,
-    ACTIONS(236), 1,
+    ACTIONS(151), 1,
+      anon_sym_event,
+    ACTIONS(153), 1,
+      anon_sym_signal,
+    ACTIONS(423), 1,
       anon_sym_RBRACE,
-    STATE(76), 2,
-      sym_case_declaration,
-      aux_sym_enum_declaration_repeat1,
-    STATE(160), 2,
+    STATE(22), 1,
       sym_annotation,
+    STATE(86), 1,
       aux_sym_stream_declaration_repeat1,
-  [1402] = 6,
+    STATE(144), 1,
+      sym_event_declaration,
+    STATE(145), 1,
+      sym_signal_declaration,
+  [2510] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(216), 1,
-      anon_sym_flag,
-    ACTIONS(218), 1,
+    ACTIONS(425), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
       anon_sym_AT,
-    ACTIONS(238), 1,
-      anon_sym_RBRACE,
-    STATE(73), 2,
-      sym_flag_declaration,
-      aux_sym_flagset_declaration_repeat1,
-    STATE(159), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1423] = 6,
+  [2527] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(19), 1,
+    ACTIONS(25), 1,
       anon_sym_AT,
-    ACTIONS(234), 1,
+    ACTIONS(157), 1,
       anon_sym_let,
-    ACTIONS(240), 1,
+    ACTIONS(427), 1,
       anon_sym_RBRACE,
-    STATE(88), 2,
-      sym_field_declaration,
-      aux_sym_struct_declaration_repeat1,
-    STATE(155), 2,
+    STATE(22), 1,
       sym_annotation,
+    STATE(91), 1,
       aux_sym_stream_declaration_repeat1,
-  [1444] = 6,
+    STATE(149), 1,
+      sym_field_declaration,
+  [2549] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(19), 1,
+    ACTIONS(429), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
       anon_sym_AT,
-    ACTIONS(234), 1,
-      anon_sym_let,
-    ACTIONS(242), 1,
-      anon_sym_RBRACE,
-    STATE(86), 2,
-      sym_field_declaration,
-      aux_sym_struct_declaration_repeat1,
-    STATE(155), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1465] = 6,
+  [2566] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(19), 1,
+    ACTIONS(25), 1,
       anon_sym_AT,
-    ACTIONS(230), 1,
+    ACTIONS(161), 1,
       anon_sym_case,
-    ACTIONS(244), 1,
+    ACTIONS(431), 1,
       anon_sym_RBRACE,
-    STATE(87), 2,
-      sym_case_declaration,
-      aux_sym_enum_declaration_repeat1,
-    STATE(160), 2,
+    STATE(22), 1,
       sym_annotation,
+    STATE(96), 1,
       aux_sym_stream_declaration_repeat1,
-  [1486] = 6,
+    STATE(153), 1,
+      sym_case_declaration,
+  [2588] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(216), 1,
-      anon_sym_flag,
-    ACTIONS(218), 1,
+    ACTIONS(433), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
       anon_sym_AT,
-    ACTIONS(246), 1,
-      anon_sym_RBRACE,
-    STATE(75), 2,
-      sym_flag_declaration,
-      aux_sym_flagset_declaration_repeat1,
-    STATE(159), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1507] = 6,
+  [2605] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(216), 1,
-      anon_sym_flag,
-    ACTIONS(218), 1,
+    ACTIONS(25), 1,
       anon_sym_AT,
-    ACTIONS(248), 1,
+    ACTIONS(165), 1,
+      anon_sym_flag,
+    ACTIONS(435), 1,
       anon_sym_RBRACE,
-    STATE(72), 2,
-      sym_flag_declaration,
-      aux_sym_flagset_declaration_repeat1,
-    STATE(159), 2,
+    STATE(22), 1,
       sym_annotation,
+    STATE(101), 1,
       aux_sym_stream_declaration_repeat1,
-  [1528] = 6,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(250), 1,
-      anon_sym_RBRACE,
-    ACTIONS(252), 1,
-      anon_sym_flag,
-    ACTIONS(255), 1,
-      anon_sym_AT,
-    STATE(85), 2,
+    STATE(157), 1,
       sym_flag_declaration,
-      aux_sym_flagset_declaration_repeat1,
-    STATE(159), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1549] = 6,
+  [2627] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(19), 1,
-      anon_sym_AT,
-    ACTIONS(234), 1,
-      anon_sym_let,
-    ACTIONS(258), 1,
-      anon_sym_RBRACE,
-    STATE(88), 2,
-      sym_field_declaration,
-      aux_sym_struct_declaration_repeat1,
-    STATE(155), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1570] = 6,
+    ACTIONS(437), 1,
+      anon_sym_LBRACE,
+  [2634] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(19), 1,
-      anon_sym_AT,
-    ACTIONS(230), 1,
-      anon_sym_case,
-    ACTIONS(260), 1,
-      anon_sym_RBRACE,
-    STATE(89), 2,
-      sym_case_declaration,
-      aux_sym_enum_declaration_repeat1,
-    STATE(160), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1591] = 6,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    STATE(250), 1,
+      sym__semicolon,
+  [2653] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(262), 1,
-      anon_sym_RBRACE,
-    ACTIONS(264), 1,
-      anon_sym_let,
-    ACTIONS(267), 1,
-      anon_sym_AT,
-    STATE(88), 2,
-      sym_field_declaration,
-      aux_sym_struct_declaration_repeat1,
-    STATE(155), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1612] = 6,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    STATE(251), 1,
+      sym__semicolon,
+  [2672] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(270), 1,
-      anon_sym_RBRACE,
-    ACTIONS(272), 1,
-      anon_sym_case,
     ACTIONS(275), 1,
-      anon_sym_AT,
-    STATE(89), 2,
-      sym_case_declaration,
-      aux_sym_enum_declaration_repeat1,
-    STATE(160), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1633] = 6,
+      anon_sym_DOT,
+    ACTIONS(277), 1,
+      anon_sym_QMARK,
+    ACTIONS(439), 1,
+      anon_sym_EQ,
+  [2685] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(278), 1,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(441), 1,
+      anon_sym_throws,
+    ACTIONS(443), 1,
       anon_sym_DASH_GT,
-    ACTIONS(280), 1,
-      anon_sym_DOT,
-    ACTIONS(282), 1,
-      anon_sym_QMARK,
-    STATE(65), 1,
+    STATE(255), 1,
       sym__semicolon,
-    ACTIONS(284), 2,
-      sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [1653] = 6,
+  [2704] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(286), 1,
+    ACTIONS(287), 1,
+      anon_sym_COMMA,
+    ACTIONS(445), 1,
       anon_sym_RPAREN,
-    ACTIONS(288), 1,
-      anon_sym_AT,
-    ACTIONS(290), 1,
-      sym_identifier,
-    STATE(198), 1,
-      sym_arg,
-    STATE(162), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1673] = 6,
+    STATE(257), 1,
+      aux_sym_case_declaration_repeat1,
+  [2717] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(288), 1,
+    ACTIONS(179), 1,
       anon_sym_AT,
-    ACTIONS(290), 1,
+    ACTIONS(181), 1,
       sym_identifier,
-    ACTIONS(292), 1,
+    ACTIONS(447), 1,
       anon_sym_RPAREN,
-    STATE(178), 1,
-      sym_arg,
-    STATE(162), 2,
+    STATE(115), 1,
       sym_annotation,
+    STATE(116), 1,
       aux_sym_stream_declaration_repeat1,
-  [1693] = 6,
+    STATE(259), 1,
+      sym_arg,
+  [2739] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(288), 1,
+    ACTIONS(179), 1,
       anon_sym_AT,
-    ACTIONS(290), 1,
+    ACTIONS(181), 1,
       sym_identifier,
-    ACTIONS(294), 1,
+    ACTIONS(449), 1,
       anon_sym_RPAREN,
-    STATE(209), 1,
-      sym_arg,
-    STATE(162), 2,
+    STATE(115), 1,
       sym_annotation,
+    STATE(116), 1,
       aux_sym_stream_declaration_repeat1,
-  [1713] = 6,
+    STATE(261), 1,
+      sym_arg,
+  [2761] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(288), 1,
-      anon_sym_AT,
-    ACTIONS(290), 1,
-      sym_identifier,
-    ACTIONS(296), 1,
-      anon_sym_RPAREN,
-    STATE(176), 1,
-      sym_arg,
-    STATE(162), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1733] = 6,
+    ACTIONS(451), 1,
+      anon_sym_LPAREN,
+  [2768] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(280), 1,
-      anon_sym_DOT,
-    ACTIONS(282), 1,
-      anon_sym_QMARK,
-    ACTIONS(298), 1,
-      anon_sym_DASH_GT,
-    STATE(51), 1,
-      sym__semicolon,
-    ACTIONS(300), 2,
-      sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [1753] = 6,
+    ACTIONS(453), 1,
+      anon_sym_LPAREN,
+  [2775] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(280), 1,
-      anon_sym_DOT,
-    ACTIONS(282), 1,
-      anon_sym_QMARK,
-    ACTIONS(302), 1,
-      anon_sym_DASH_GT,
-    STATE(59), 1,
-      sym__semicolon,
-    ACTIONS(304), 2,
-      sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [1773] = 6,
+    ACTIONS(173), 1,
+      anon_sym_LBRACK,
+    ACTIONS(175), 1,
+      sym_identifier,
+    STATE(264), 1,
+      sym_type,
+  [2788] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(288), 1,
+    ACTIONS(455), 1,
+      anon_sym_COLON,
+  [2795] = 7,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(179), 1,
       anon_sym_AT,
-    ACTIONS(290), 1,
+    ACTIONS(181), 1,
       sym_identifier,
-    ACTIONS(306), 1,
+    ACTIONS(457), 1,
       anon_sym_RPAREN,
-    STATE(184), 1,
-      sym_arg,
-    STATE(162), 2,
+    STATE(115), 1,
       sym_annotation,
+    STATE(116), 1,
       aux_sym_stream_declaration_repeat1,
-  [1793] = 6,
+    STATE(267), 1,
+      sym_arg,
+  [2817] = 3,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(288), 1,
+    ACTIONS(459), 3,
+      anon_sym_RBRACE,
+      anon_sym_case,
       anon_sym_AT,
-    ACTIONS(290), 1,
-      sym_identifier,
-    ACTIONS(308), 1,
-      anon_sym_RPAREN,
-    STATE(190), 1,
-      sym_arg,
-    STATE(162), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1813] = 6,
+    ACTIONS(461), 1,
+      anon_sym_LPAREN,
+  [2829] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(280), 1,
-      anon_sym_DOT,
-    ACTIONS(282), 1,
-      anon_sym_QMARK,
-    ACTIONS(310), 1,
-      anon_sym_DASH_GT,
-    STATE(45), 1,
-      sym__semicolon,
-    ACTIONS(312), 2,
-      sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [1833] = 6,
+    ACTIONS(463), 3,
+      anon_sym_RBRACE,
+      anon_sym_flag,
+      anon_sym_AT,
+  [2838] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(465), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [2855] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(288), 1,
+    ACTIONS(25), 1,
       anon_sym_AT,
-    ACTIONS(290), 1,
-      sym_identifier,
-    ACTIONS(314), 1,
-      anon_sym_RPAREN,
-    STATE(211), 1,
-      sym_arg,
-    STATE(162), 2,
+    ACTIONS(165), 1,
+      anon_sym_flag,
+    ACTIONS(467), 1,
+      anon_sym_RBRACE,
+    STATE(22), 1,
       sym_annotation,
+    STATE(101), 1,
       aux_sym_stream_declaration_repeat1,
-  [1853] = 6,
+    STATE(157), 1,
+      sym_flag_declaration,
+  [2877] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(288), 1,
-      anon_sym_AT,
-    ACTIONS(290), 1,
+    ACTIONS(173), 1,
+      anon_sym_LBRACK,
+    ACTIONS(175), 1,
       sym_identifier,
-    ACTIONS(316), 1,
-      anon_sym_RPAREN,
-    STATE(216), 1,
-      sym_arg,
-    STATE(162), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1873] = 6,
+    STATE(270), 1,
+      sym_type,
+  [2890] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(280), 1,
+    ACTIONS(469), 5,
+      anon_sym_DASH_GT,
       anon_sym_DOT,
-    ACTIONS(282), 1,
       anon_sym_QMARK,
-    ACTIONS(318), 1,
-      anon_sym_DASH_GT,
-    STATE(34), 1,
-      sym__semicolon,
-    ACTIONS(320), 2,
-      sym__automatic_semicolon,
       anon_sym_SEMI,
-  [1893] = 6,
+      sym__automatic_semicolon,
+  [2901] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(280), 1,
+    ACTIONS(469), 5,
+      anon_sym_DASH_GT,
       anon_sym_DOT,
-    ACTIONS(282), 1,
       anon_sym_QMARK,
-    ACTIONS(322), 1,
-      anon_sym_DASH_GT,
-    STATE(31), 1,
-      sym__semicolon,
-    ACTIONS(324), 2,
-      sym__automatic_semicolon,
       anon_sym_SEMI,
-  [1913] = 5,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(326), 1,
-      anon_sym_throws,
-    ACTIONS(328), 1,
-      anon_sym_DASH_GT,
-    STATE(47), 1,
-      sym__semicolon,
-    ACTIONS(330), 2,
       sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [1930] = 5,
+  [2912] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(280), 1,
-      anon_sym_DOT,
-    ACTIONS(282), 1,
-      anon_sym_QMARK,
-    STATE(26), 1,
-      sym__semicolon,
-    ACTIONS(332), 2,
-      sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [1947] = 5,
+    ACTIONS(173), 1,
+      anon_sym_LBRACK,
+    ACTIONS(175), 1,
+      sym_identifier,
+    STATE(271), 1,
+      sym_type,
+  [2925] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(280), 1,
+    ACTIONS(469), 10,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COLON,
+      anon_sym_EQ,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
       anon_sym_DOT,
-    ACTIONS(282), 1,
       anon_sym_QMARK,
-    STATE(33), 1,
-      sym__semicolon,
-    ACTIONS(334), 2,
-      sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [1964] = 5,
+      anon_sym_AT,
+  [2941] = 15,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(288), 1,
-      anon_sym_AT,
-    ACTIONS(290), 1,
+    ACTIONS(27), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(29), 1,
+      anon_sym_SQUOTE,
+    ACTIONS(185), 1,
+      anon_sym_LBRACK,
+    ACTIONS(187), 1,
+      anon_sym_Yes,
+    ACTIONS(189), 1,
+      anon_sym_No,
+    ACTIONS(191), 1,
+      sym_number,
+    ACTIONS(471), 1,
+      anon_sym_COLON,
+    ACTIONS(473), 1,
+      anon_sym_RBRACK,
+    ACTIONS(475), 1,
       sym_identifier,
-    STATE(250), 1,
-      sym_arg,
-    STATE(162), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [1981] = 5,
+    STATE(123), 1,
+      sym_bool,
+    STATE(124), 1,
+      sym_list,
+    STATE(125), 1,
+      sym_dictionary,
+    STATE(126), 1,
+      sym_string,
+    STATE(275), 1,
+      sym_literal,
+  [2987] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(336), 1,
-      anon_sym_throws,
-    ACTIONS(338), 1,
-      anon_sym_DASH_GT,
-    STATE(38), 1,
-      sym__semicolon,
-    ACTIONS(340), 2,
-      sym__automatic_semicolon,
+    ACTIONS(301), 2,
       anon_sym_SEMI,
-  [1998] = 5,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(280), 1,
-      anon_sym_DOT,
-    ACTIONS(282), 1,
-      anon_sym_QMARK,
-    STATE(30), 1,
-      sym__semicolon,
-    ACTIONS(342), 2,
       sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [2015] = 5,
+  [2995] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(344), 1,
-      anon_sym_throws,
-    ACTIONS(346), 1,
-      anon_sym_DASH_GT,
-    STATE(69), 1,
-      sym__semicolon,
-    ACTIONS(348), 2,
-      sym__automatic_semicolon,
+    ACTIONS(301), 2,
       anon_sym_SEMI,
-  [2032] = 5,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(350), 1,
-      anon_sym_throws,
-    ACTIONS(352), 1,
-      anon_sym_DASH_GT,
-    STATE(41), 1,
-      sym__semicolon,
-    ACTIONS(354), 2,
       sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [2049] = 5,
+  [3003] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(280), 1,
-      anon_sym_DOT,
-    ACTIONS(282), 1,
-      anon_sym_QMARK,
-    STATE(66), 1,
-      sym__semicolon,
-    ACTIONS(356), 2,
-      sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [2066] = 5,
+    ACTIONS(77), 1,
+      sym_unescaped_double_string_fragment,
+    ACTIONS(79), 1,
+      sym_escape_sequence,
+    ACTIONS(477), 1,
+      anon_sym_DQUOTE,
+    STATE(277), 1,
+      aux_sym_string_repeat1,
+  [3019] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(280), 1,
-      anon_sym_DOT,
-    ACTIONS(282), 1,
-      anon_sym_QMARK,
-    STATE(49), 1,
-      sym__semicolon,
-    ACTIONS(358), 2,
-      sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [2083] = 5,
+    ACTIONS(83), 1,
+      sym_unescaped_single_string_fragment,
+    ACTIONS(85), 1,
+      sym_escape_sequence,
+    ACTIONS(479), 1,
+      anon_sym_SQUOTE,
+    STATE(279), 1,
+      aux_sym_string_repeat2,
+  [3035] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(280), 1,
-      anon_sym_DOT,
-    ACTIONS(282), 1,
-      anon_sym_QMARK,
-    STATE(44), 1,
-      sym__semicolon,
-    ACTIONS(360), 2,
-      sym__automatic_semicolon,
+    ACTIONS(303), 2,
       anon_sym_SEMI,
-  [2100] = 4,
+      sym__automatic_semicolon,
+  [3043] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(364), 1,
-      anon_sym_DOT,
-    ACTIONS(366), 1,
-      anon_sym_QMARK,
-    ACTIONS(362), 3,
-      anon_sym_RBRACE,
-      anon_sym_let,
-      anon_sym_AT,
-  [2115] = 2,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    STATE(280), 1,
+      sym__semicolon,
+  [3056] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(102), 5,
-      sym__automatic_semicolon,
-      anon_sym_DASH_GT,
-      anon_sym_DOT,
-      anon_sym_QMARK,
+    ACTIONS(303), 2,
       anon_sym_SEMI,
-  [2126] = 5,
+      sym__automatic_semicolon,
+  [3064] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(280), 1,
-      anon_sym_DOT,
-    ACTIONS(282), 1,
-      anon_sym_QMARK,
-    STATE(42), 1,
-      sym__semicolon,
-    ACTIONS(368), 2,
-      sym__automatic_semicolon,
+    ACTIONS(303), 2,
       anon_sym_SEMI,
-  [2143] = 5,
+      sym__automatic_semicolon,
+  [3072] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(280), 1,
-      anon_sym_DOT,
-    ACTIONS(282), 1,
-      anon_sym_QMARK,
-    STATE(70), 1,
-      sym__semicolon,
-    ACTIONS(370), 2,
-      sym__automatic_semicolon,
+    ACTIONS(303), 2,
       anon_sym_SEMI,
-  [2160] = 5,
+      sym__automatic_semicolon,
+  [3080] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(280), 1,
-      anon_sym_DOT,
-    ACTIONS(282), 1,
-      anon_sym_QMARK,
-    STATE(67), 1,
-      sym__semicolon,
-    ACTIONS(372), 2,
-      sym__automatic_semicolon,
+    ACTIONS(303), 2,
       anon_sym_SEMI,
-  [2177] = 5,
+      sym__automatic_semicolon,
+  [3088] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(280), 1,
+    ACTIONS(469), 10,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COLON,
+      anon_sym_EQ,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
       anon_sym_DOT,
-    ACTIONS(282), 1,
       anon_sym_QMARK,
-    STATE(39), 1,
-      sym__semicolon,
-    ACTIONS(374), 2,
-      sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [2194] = 5,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(19), 1,
       anon_sym_AT,
-    ACTIONS(376), 1,
-      anon_sym_event,
-    ACTIONS(378), 1,
-      anon_sym_signal,
-    STATE(8), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [2211] = 2,
+  [3104] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(104), 5,
-      sym__automatic_semicolon,
-      anon_sym_DASH_GT,
-      anon_sym_DOT,
-      anon_sym_QMARK,
+    ACTIONS(217), 1,
       anon_sym_SEMI,
-  [2222] = 5,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(280), 1,
-      anon_sym_DOT,
-    ACTIONS(282), 1,
-      anon_sym_QMARK,
-    STATE(43), 1,
-      sym__semicolon,
-    ACTIONS(380), 2,
+    ACTIONS(219), 1,
       sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [2239] = 5,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(280), 1,
+    ACTIONS(269), 1,
       anon_sym_DOT,
-    ACTIONS(282), 1,
+    ACTIONS(271), 1,
       anon_sym_QMARK,
-    STATE(40), 1,
+    ACTIONS(481), 1,
+      anon_sym_DASH_GT,
+    STATE(282), 1,
       sym__semicolon,
-    ACTIONS(382), 2,
-      sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [2256] = 2,
+  [3126] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(108), 5,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
       sym__automatic_semicolon,
-      anon_sym_DASH_GT,
+    ACTIONS(269), 1,
       anon_sym_DOT,
+    ACTIONS(271), 1,
       anon_sym_QMARK,
-      anon_sym_SEMI,
-  [2267] = 5,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(384), 1,
-      anon_sym_throws,
-    ACTIONS(386), 1,
-      anon_sym_DASH_GT,
-    STATE(56), 1,
+    STATE(283), 1,
       sym__semicolon,
-    ACTIONS(388), 2,
-      sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [2284] = 2,
+  [3145] = 13,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(81), 5,
-      sym__automatic_semicolon,
-      anon_sym_DASH_GT,
-      anon_sym_DOT,
-      anon_sym_QMARK,
-      anon_sym_SEMI,
-  [2295] = 4,
+    ACTIONS(27), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(29), 1,
+      anon_sym_SQUOTE,
+    ACTIONS(185), 1,
+      anon_sym_LBRACK,
+    ACTIONS(187), 1,
+      anon_sym_Yes,
+    ACTIONS(189), 1,
+      anon_sym_No,
+    ACTIONS(191), 1,
+      sym_number,
+    ACTIONS(483), 1,
+      anon_sym_RPAREN,
+    STATE(123), 1,
+      sym_bool,
+    STATE(124), 1,
+      sym_list,
+    STATE(125), 1,
+      sym_dictionary,
+    STATE(126), 1,
+      sym_string,
+    STATE(285), 1,
+      sym_literal,
+  [3185] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(364), 1,
+    ACTIONS(275), 1,
       anon_sym_DOT,
-    ACTIONS(366), 1,
+    ACTIONS(277), 1,
       anon_sym_QMARK,
-    ACTIONS(390), 3,
-      anon_sym_RBRACE,
-      anon_sym_let,
-      anon_sym_AT,
-  [2310] = 5,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(392), 1,
-      anon_sym_throws,
-    ACTIONS(394), 1,
-      anon_sym_DASH_GT,
-    STATE(60), 1,
-      sym__semicolon,
-    ACTIONS(396), 2,
-      sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [2327] = 4,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(398), 1,
+    ACTIONS(485), 1,
+      anon_sym_EQ,
+    ACTIONS(487), 2,
       anon_sym_COMMA,
-    STATE(130), 1,
-      aux_sym_annotation_repeat1,
-    ACTIONS(401), 2,
       anon_sym_RPAREN,
-      anon_sym_RBRACK,
-  [2341] = 4,
+  [3202] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(364), 1,
-      anon_sym_DOT,
-    ACTIONS(366), 1,
-      anon_sym_QMARK,
-    ACTIONS(403), 2,
+    ACTIONS(489), 2,
       anon_sym_COMMA,
       anon_sym_RPAREN,
-  [2355] = 5,
+  [3210] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(364), 1,
-      anon_sym_DOT,
-    ACTIONS(366), 1,
-      anon_sym_QMARK,
-    ACTIONS(405), 1,
-      anon_sym_COLON,
-    ACTIONS(407), 1,
-      anon_sym_RBRACK,
-  [2371] = 4,
+    ACTIONS(169), 1,
+      anon_sym_LBRACK,
+    ACTIONS(171), 1,
+      sym_identifier,
+    STATE(287), 1,
+      sym_type,
+  [3223] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(70), 1,
+    ACTIONS(169), 1,
+      anon_sym_LBRACK,
+    ACTIONS(171), 1,
       sym_identifier,
-    ACTIONS(409), 1,
+    STATE(288), 1,
+      sym_type,
+  [3236] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(491), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
       anon_sym_AT,
-    STATE(133), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [2385] = 4,
+  [3253] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(70), 1,
-      anon_sym_flag,
-    ACTIONS(412), 1,
+    ACTIONS(179), 1,
       anon_sym_AT,
-    STATE(134), 2,
+    ACTIONS(181), 1,
+      sym_identifier,
+    STATE(115), 1,
       sym_annotation,
+    STATE(116), 1,
       aux_sym_stream_declaration_repeat1,
-  [2399] = 3,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(417), 1,
-      anon_sym_LPAREN,
-    ACTIONS(415), 3,
-      anon_sym_RBRACE,
-      anon_sym_case,
-      anon_sym_AT,
-  [2411] = 4,
-    ACTIONS(419), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(421), 1,
-      sym_comment,
-    STATE(146), 1,
-      aux_sym_string_repeat1,
-    ACTIONS(423), 2,
-      sym_unescaped_double_string_fragment,
-      sym_escape_sequence,
-  [2425] = 4,
-    ACTIONS(419), 1,
-      anon_sym_SQUOTE,
-    ACTIONS(421), 1,
-      sym_comment,
-    STATE(147), 1,
-      aux_sym_string_repeat2,
-    ACTIONS(425), 2,
-      sym_unescaped_single_string_fragment,
-      sym_escape_sequence,
-  [2439] = 2,
+    STATE(289), 1,
+      sym_arg,
+  [3272] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(427), 4,
-      anon_sym_RBRACE,
-      anon_sym_event,
-      anon_sym_signal,
-      anon_sym_AT,
-  [2449] = 2,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(493), 1,
+      anon_sym_throws,
+    ACTIONS(495), 1,
+      anon_sym_DASH_GT,
+    STATE(292), 1,
+      sym__semicolon,
+  [3291] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(429), 4,
-      anon_sym_RBRACE,
-      anon_sym_event,
-      anon_sym_signal,
-      anon_sym_AT,
-  [2459] = 2,
+    ACTIONS(173), 1,
+      anon_sym_LBRACK,
+    ACTIONS(175), 1,
+      sym_identifier,
+    STATE(293), 1,
+      sym_type,
+  [3304] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(431), 4,
+    ACTIONS(497), 6,
       anon_sym_RBRACE,
-      anon_sym_event,
-      anon_sym_signal,
+      anon_sym_let,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
       anon_sym_AT,
-  [2469] = 2,
+  [3316] = 12,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(433), 4,
-      anon_sym_RBRACE,
-      anon_sym_event,
-      anon_sym_signal,
-      anon_sym_AT,
-  [2479] = 2,
+    ACTIONS(27), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(29), 1,
+      anon_sym_SQUOTE,
+    ACTIONS(185), 1,
+      anon_sym_LBRACK,
+    ACTIONS(187), 1,
+      anon_sym_Yes,
+    ACTIONS(189), 1,
+      anon_sym_No,
+    ACTIONS(191), 1,
+      sym_number,
+    STATE(123), 1,
+      sym_bool,
+    STATE(124), 1,
+      sym_list,
+    STATE(125), 1,
+      sym_dictionary,
+    STATE(126), 1,
+      sym_string,
+    STATE(294), 1,
+      sym_literal,
+  [3353] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(435), 4,
+    ACTIONS(499), 6,
       anon_sym_RBRACE,
-      anon_sym_event,
-      anon_sym_signal,
-      anon_sym_AT,
-  [2489] = 4,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(364), 1,
-      anon_sym_DOT,
-    ACTIONS(366), 1,
-      anon_sym_QMARK,
-    ACTIONS(437), 2,
+      anon_sym_let,
       anon_sym_COMMA,
       anon_sym_RPAREN,
-  [2503] = 2,
+      anon_sym_RBRACK,
+      anon_sym_AT,
+  [3365] = 3,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(439), 4,
-      anon_sym_RBRACE,
-      anon_sym_event,
-      anon_sym_signal,
-      anon_sym_AT,
-  [2513] = 2,
+    ACTIONS(417), 1,
+      anon_sym_COMMA,
+    ACTIONS(501), 1,
+      anon_sym_RBRACK,
+  [3375] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(441), 4,
-      anon_sym_as,
+    ACTIONS(503), 3,
       anon_sym_COMMA,
       anon_sym_RPAREN,
       anon_sym_RBRACK,
-  [2523] = 4,
-    ACTIONS(421), 1,
+  [3384] = 12,
+    ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(443), 1,
+    ACTIONS(27), 1,
       anon_sym_DQUOTE,
-    STATE(153), 1,
-      aux_sym_string_repeat1,
-    ACTIONS(445), 2,
-      sym_unescaped_double_string_fragment,
-      sym_escape_sequence,
-  [2537] = 4,
-    ACTIONS(421), 1,
-      sym_comment,
-    ACTIONS(443), 1,
+    ACTIONS(29), 1,
       anon_sym_SQUOTE,
-    STATE(154), 1,
-      aux_sym_string_repeat2,
-    ACTIONS(447), 2,
-      sym_unescaped_single_string_fragment,
-      sym_escape_sequence,
-  [2551] = 2,
+    ACTIONS(185), 1,
+      anon_sym_LBRACK,
+    ACTIONS(187), 1,
+      anon_sym_Yes,
+    ACTIONS(189), 1,
+      anon_sym_No,
+    ACTIONS(191), 1,
+      sym_number,
+    STATE(123), 1,
+      sym_bool,
+    STATE(124), 1,
+      sym_list,
+    STATE(125), 1,
+      sym_dictionary,
+    STATE(126), 1,
+      sym_string,
+    STATE(296), 1,
+      sym_literal,
+  [3421] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(449), 4,
-      anon_sym_RBRACE,
+    ACTIONS(505), 14,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_let,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_flag,
+      anon_sym_case,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
       anon_sym_event,
       anon_sym_signal,
       anon_sym_AT,
-  [2561] = 2,
+  [3441] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(451), 4,
-      anon_sym_RBRACE,
-      anon_sym_event,
-      anon_sym_signal,
+    ACTIONS(507), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
       anon_sym_AT,
-  [2571] = 2,
+  [3458] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(453), 4,
-      anon_sym_RBRACE,
-      anon_sym_event,
-      anon_sym_signal,
+    ACTIONS(509), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
       anon_sym_AT,
-  [2581] = 2,
+  [3475] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(455), 4,
-      anon_sym_RBRACE,
-      anon_sym_event,
-      anon_sym_signal,
+    ACTIONS(511), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
       anon_sym_AT,
-  [2591] = 2,
+  [3492] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(457), 4,
-      anon_sym_as,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_RBRACK,
-  [2601] = 4,
-    ACTIONS(421), 1,
-      sym_comment,
-    ACTIONS(459), 1,
-      anon_sym_DQUOTE,
-    STATE(153), 1,
-      aux_sym_string_repeat1,
-    ACTIONS(461), 2,
-      sym_unescaped_double_string_fragment,
-      sym_escape_sequence,
-  [2615] = 4,
-    ACTIONS(421), 1,
-      sym_comment,
-    ACTIONS(464), 1,
-      anon_sym_SQUOTE,
-    STATE(154), 1,
-      aux_sym_string_repeat2,
-    ACTIONS(466), 2,
-      sym_unescaped_single_string_fragment,
-      sym_escape_sequence,
-  [2629] = 4,
+    ACTIONS(513), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [3509] = 8,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(19), 1,
+    ACTIONS(25), 1,
       anon_sym_AT,
-    ACTIONS(469), 1,
-      anon_sym_let,
-    STATE(8), 2,
+    ACTIONS(165), 1,
+      anon_sym_flag,
+    ACTIONS(515), 1,
+      anon_sym_RBRACE,
+    STATE(22), 1,
       sym_annotation,
+    STATE(100), 1,
+      sym_flag_declaration,
+    STATE(101), 1,
       aux_sym_stream_declaration_repeat1,
-  [2643] = 2,
+    STATE(298), 1,
+      aux_sym_flagset_declaration_repeat1,
+  [3534] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(471), 4,
-      anon_sym_RBRACE,
-      anon_sym_event,
-      anon_sym_signal,
+    ACTIONS(517), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
       anon_sym_AT,
-  [2653] = 5,
+  [3551] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(364), 1,
-      anon_sym_DOT,
-    ACTIONS(366), 1,
-      anon_sym_QMARK,
-    ACTIONS(473), 1,
-      anon_sym_COLON,
-    ACTIONS(475), 1,
-      anon_sym_RBRACK,
-  [2669] = 3,
+    ACTIONS(517), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [3568] = 12,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(479), 1,
-      anon_sym_LPAREN,
-    ACTIONS(477), 3,
-      anon_sym_RBRACE,
-      anon_sym_case,
-      anon_sym_AT,
-  [2681] = 4,
+    ACTIONS(379), 1,
+      anon_sym_LBRACK,
+    ACTIONS(381), 1,
+      anon_sym_Yes,
+    ACTIONS(383), 1,
+      anon_sym_No,
+    ACTIONS(385), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(387), 1,
+      anon_sym_SQUOTE,
+    ACTIONS(389), 1,
+      sym_number,
+    STATE(222), 1,
+      sym_bool,
+    STATE(223), 1,
+      sym_list,
+    STATE(224), 1,
+      sym_dictionary,
+    STATE(225), 1,
+      sym_string,
+    STATE(299), 1,
+      sym_literal,
+  [3605] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(218), 1,
-      anon_sym_AT,
-    ACTIONS(481), 1,
-      anon_sym_flag,
-    STATE(134), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [2695] = 4,
+    ACTIONS(169), 1,
+      anon_sym_LBRACK,
+    ACTIONS(171), 1,
+      sym_identifier,
+    STATE(300), 1,
+      sym_type,
+  [3618] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(19), 1,
-      anon_sym_AT,
-    ACTIONS(483), 1,
-      anon_sym_case,
-    STATE(8), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [2709] = 2,
+    ACTIONS(169), 1,
+      anon_sym_LBRACK,
+    ACTIONS(171), 1,
+      sym_identifier,
+    STATE(301), 1,
+      sym_type,
+  [3631] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(485), 4,
-      anon_sym_RBRACE,
-      anon_sym_event,
-      anon_sym_signal,
+    ACTIONS(519), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
       anon_sym_AT,
-  [2719] = 4,
+  [3648] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(288), 1,
-      anon_sym_AT,
-    ACTIONS(487), 1,
-      sym_identifier,
-    STATE(133), 2,
-      sym_annotation,
-      aux_sym_stream_declaration_repeat1,
-  [2733] = 3,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(521), 1,
+      anon_sym_throws,
+    ACTIONS(523), 1,
+      anon_sym_DASH_GT,
+    STATE(304), 1,
+      sym__semicolon,
+  [3667] = 3,
     ACTIONS(3), 1,
       sym_comment,
-    STATE(64), 1,
-      sym__semicolon,
-    ACTIONS(489), 2,
-      sym__automatic_semicolon,
+    ACTIONS(401), 1,
+      anon_sym_COMMA,
+    ACTIONS(525), 1,
+      anon_sym_RPAREN,
+  [3677] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(217), 1,
       anon_sym_SEMI,
-  [2744] = 2,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    STATE(306), 1,
+      sym__semicolon,
+  [3690] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(491), 3,
+    ACTIONS(287), 1,
       anon_sym_COMMA,
+    ACTIONS(527), 1,
       anon_sym_RPAREN,
-      anon_sym_RBRACK,
-  [2753] = 4,
+    STATE(308), 1,
+      aux_sym_case_declaration_repeat1,
+  [3703] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(493), 1,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    STATE(309), 1,
+      sym__semicolon,
+  [3716] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(287), 1,
       anon_sym_COMMA,
-    ACTIONS(495), 1,
+    ACTIONS(529), 1,
       anon_sym_RPAREN,
-    STATE(244), 1,
+    STATE(311), 1,
       aux_sym_case_declaration_repeat1,
-  [2766] = 4,
+  [3729] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(497), 1,
-      anon_sym_LBRACK,
-    ACTIONS(499), 1,
+    ACTIONS(179), 1,
+      anon_sym_AT,
+    ACTIONS(181), 1,
       sym_identifier,
-    STATE(143), 1,
-      sym_type,
-  [2779] = 4,
+    ACTIONS(531), 1,
+      anon_sym_RPAREN,
+    STATE(115), 1,
+      sym_annotation,
+    STATE(116), 1,
+      aux_sym_stream_declaration_repeat1,
+    STATE(313), 1,
+      sym_arg,
+  [3751] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
-      anon_sym_LBRACK,
-    ACTIONS(503), 1,
+    ACTIONS(179), 1,
+      anon_sym_AT,
+    ACTIONS(181), 1,
       sym_identifier,
-    STATE(112), 1,
-      sym_type,
-  [2792] = 4,
+    ACTIONS(533), 1,
+      anon_sym_RPAREN,
+    STATE(115), 1,
+      sym_annotation,
+    STATE(116), 1,
+      aux_sym_stream_declaration_repeat1,
+    STATE(315), 1,
+      sym_arg,
+  [3773] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
-      anon_sym_LBRACK,
-    ACTIONS(503), 1,
-      sym_identifier,
-    STATE(90), 1,
-      sym_type,
-  [2805] = 4,
+    ACTIONS(275), 1,
+      anon_sym_DOT,
+    ACTIONS(277), 1,
+      anon_sym_QMARK,
+    ACTIONS(535), 3,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_AT,
+    ACTIONS(537), 1,
+      anon_sym_EQ,
+  [3791] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(497), 1,
+    ACTIONS(173), 1,
       anon_sym_LBRACK,
-    ACTIONS(499), 1,
+    ACTIONS(175), 1,
       sym_identifier,
-    STATE(181), 1,
+    STATE(317), 1,
       sym_type,
-  [2818] = 2,
+  [3804] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(505), 3,
+    ACTIONS(539), 3,
       anon_sym_RBRACE,
-      anon_sym_flag,
+      anon_sym_case,
       anon_sym_AT,
-  [2827] = 4,
+  [3813] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
-      anon_sym_LBRACK,
-    ACTIONS(503), 1,
-      sym_identifier,
-    STATE(99), 1,
-      sym_type,
-  [2840] = 4,
+    ACTIONS(287), 1,
+      anon_sym_COMMA,
+    ACTIONS(541), 1,
+      anon_sym_RPAREN,
+    STATE(319), 1,
+      aux_sym_case_declaration_repeat1,
+  [3826] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
-      anon_sym_LBRACK,
-    ACTIONS(503), 1,
+    ACTIONS(179), 1,
+      anon_sym_AT,
+    ACTIONS(181), 1,
       sym_identifier,
-    STATE(114), 1,
-      sym_type,
-  [2853] = 4,
+    ACTIONS(543), 1,
+      anon_sym_RPAREN,
+    STATE(115), 1,
+      sym_annotation,
+    STATE(116), 1,
+      aux_sym_stream_declaration_repeat1,
+    STATE(321), 1,
+      sym_arg,
+  [3848] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(497), 1,
-      anon_sym_LBRACK,
-    ACTIONS(499), 1,
-      sym_identifier,
-    STATE(132), 1,
-      sym_type,
-  [2866] = 4,
+    ACTIONS(545), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [3865] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(493), 1,
-      anon_sym_COMMA,
-    ACTIONS(507), 1,
-      anon_sym_RPAREN,
-    STATE(244), 1,
-      aux_sym_case_declaration_repeat1,
-  [2879] = 3,
+    ACTIONS(275), 1,
+      anon_sym_DOT,
+    ACTIONS(277), 1,
+      anon_sym_QMARK,
+    ACTIONS(547), 1,
+      anon_sym_RBRACK,
+  [3878] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    STATE(161), 1,
-      sym__semicolon,
-    ACTIONS(509), 2,
-      sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [2890] = 4,
+    ACTIONS(275), 1,
+      anon_sym_DOT,
+    ACTIONS(277), 1,
+      anon_sym_QMARK,
+    ACTIONS(549), 1,
+      anon_sym_RBRACK,
+  [3891] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(493), 1,
-      anon_sym_COMMA,
-    ACTIONS(511), 1,
-      anon_sym_RPAREN,
-    STATE(205), 1,
-      aux_sym_case_declaration_repeat1,
-  [2903] = 3,
+    ACTIONS(551), 1,
+      anon_sym_RBRACK,
+  [3898] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    STATE(156), 1,
-      sym__semicolon,
-    ACTIONS(513), 2,
-      sym__automatic_semicolon,
+    ACTIONS(409), 2,
       anon_sym_SEMI,
-  [2914] = 4,
+      sym__automatic_semicolon,
+  [3906] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(493), 1,
-      anon_sym_COMMA,
-    ACTIONS(515), 1,
-      anon_sym_RPAREN,
-    STATE(207), 1,
-      aux_sym_case_declaration_repeat1,
-  [2927] = 4,
+    ACTIONS(553), 1,
+      anon_sym_COLON,
+  [3913] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(517), 1,
+    ACTIONS(305), 1,
       anon_sym_COMMA,
-    ACTIONS(519), 1,
-      anon_sym_RPAREN,
-    STATE(130), 1,
+    ACTIONS(555), 1,
+      anon_sym_RBRACK,
+    STATE(327), 1,
       aux_sym_annotation_repeat1,
-  [2940] = 4,
+  [3926] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(517), 1,
-      anon_sym_COMMA,
-    ACTIONS(521), 1,
-      anon_sym_RPAREN,
-    STATE(179), 1,
-      aux_sym_annotation_repeat1,
-  [2953] = 4,
+    ACTIONS(129), 2,
+      anon_sym_SEMI,
+      sym__automatic_semicolon,
+  [3934] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(364), 1,
-      anon_sym_DOT,
-    ACTIONS(366), 1,
-      anon_sym_QMARK,
-    ACTIONS(523), 1,
-      anon_sym_RBRACK,
-  [2966] = 4,
+    ACTIONS(135), 1,
+      sym_unescaped_double_string_fragment,
+    ACTIONS(137), 1,
+      sym_escape_sequence,
+    ACTIONS(557), 1,
+      anon_sym_DQUOTE,
+  [3947] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(129), 2,
+      anon_sym_SEMI,
+      sym__automatic_semicolon,
+  [3955] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(143), 1,
+      sym_unescaped_single_string_fragment,
+    ACTIONS(145), 1,
+      sym_escape_sequence,
+    ACTIONS(559), 1,
+      anon_sym_SQUOTE,
+  [3968] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(561), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [3985] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(497), 1,
+    ACTIONS(169), 1,
       anon_sym_LBRACK,
-    ACTIONS(499), 1,
+    ACTIONS(171), 1,
       sym_identifier,
-    STATE(128), 1,
+    STATE(330), 1,
       sym_type,
-  [2979] = 2,
+  [3998] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(525), 3,
-      anon_sym_RBRACE,
-      anon_sym_case,
+    ACTIONS(563), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
       anon_sym_AT,
-  [2988] = 4,
+  [4015] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(493), 1,
-      anon_sym_COMMA,
-    ACTIONS(527), 1,
-      anon_sym_RPAREN,
-    STATE(214), 1,
-      aux_sym_case_declaration_repeat1,
-  [3001] = 4,
+    ACTIONS(565), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [4032] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(293), 2,
+      anon_sym_AT,
+      sym_identifier,
+  [4040] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(517), 1,
+    ACTIONS(305), 1,
       anon_sym_COMMA,
-    ACTIONS(529), 1,
+    ACTIONS(567), 1,
       anon_sym_RPAREN,
-    STATE(130), 1,
+    STATE(332), 1,
       aux_sym_annotation_repeat1,
-  [3014] = 4,
+  [4053] = 12,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(54), 1,
+    ACTIONS(27), 1,
       anon_sym_DQUOTE,
-    ACTIONS(56), 1,
+    ACTIONS(29), 1,
       anon_sym_SQUOTE,
-    STATE(283), 1,
+    ACTIONS(185), 1,
+      anon_sym_LBRACK,
+    ACTIONS(187), 1,
+      anon_sym_Yes,
+    ACTIONS(189), 1,
+      anon_sym_No,
+    ACTIONS(191), 1,
+      sym_number,
+    STATE(123), 1,
+      sym_bool,
+    STATE(124), 1,
+      sym_list,
+    STATE(125), 1,
+      sym_dictionary,
+    STATE(126), 1,
       sym_string,
-  [3027] = 4,
+    STATE(333), 1,
+      sym_literal,
+  [4090] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    ACTIONS(569), 1,
+      anon_sym_DASH_GT,
+    STATE(335), 1,
+      sym__semicolon,
+  [4112] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    STATE(336), 1,
+      sym__semicolon,
+  [4131] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(571), 2,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+  [4139] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(169), 1,
       anon_sym_LBRACK,
-    ACTIONS(503), 1,
+    ACTIONS(171), 1,
       sym_identifier,
-    STATE(120), 1,
+    STATE(337), 1,
       sym_type,
-  [3040] = 4,
+  [4152] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(517), 1,
-      anon_sym_COMMA,
-    ACTIONS(531), 1,
-      anon_sym_RPAREN,
-    STATE(185), 1,
-      aux_sym_annotation_repeat1,
-  [3053] = 2,
+    ACTIONS(169), 1,
+      anon_sym_LBRACK,
+    ACTIONS(171), 1,
+      sym_identifier,
+    STATE(338), 1,
+      sym_type,
+  [4165] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(533), 3,
-      anon_sym_RBRACE,
-      anon_sym_flag,
+    ACTIONS(573), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
       anon_sym_AT,
-  [3062] = 4,
+  [4182] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(493), 1,
+    ACTIONS(275), 1,
+      anon_sym_DOT,
+    ACTIONS(277), 1,
+      anon_sym_QMARK,
+    ACTIONS(575), 1,
+      anon_sym_EQ,
+    ACTIONS(577), 2,
       anon_sym_COMMA,
-    ACTIONS(535), 1,
       anon_sym_RPAREN,
-    STATE(174), 1,
-      aux_sym_case_declaration_repeat1,
-  [3075] = 4,
+  [4199] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(517), 1,
+    ACTIONS(579), 1,
       anon_sym_COMMA,
-    ACTIONS(537), 1,
-      anon_sym_RPAREN,
-    STATE(219), 1,
-      aux_sym_annotation_repeat1,
-  [3088] = 2,
+    ACTIONS(581), 1,
+      anon_sym_RBRACK,
+    STATE(342), 1,
+      aux_sym_dictionary_repeat1,
+  [4212] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(539), 3,
+    ACTIONS(583), 6,
+      anon_sym_RBRACE,
+      anon_sym_let,
       anon_sym_COMMA,
       anon_sym_RPAREN,
       anon_sym_RBRACK,
-  [3097] = 2,
+      anon_sym_AT,
+  [4224] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(541), 3,
+    ACTIONS(585), 3,
       anon_sym_COMMA,
       anon_sym_RPAREN,
       anon_sym_RBRACK,
-  [3106] = 4,
+  [4233] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
-      anon_sym_LBRACK,
-    ACTIONS(503), 1,
-      sym_identifier,
-    STATE(102), 1,
-      sym_type,
-  [3119] = 4,
+    ACTIONS(587), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [4250] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
-      anon_sym_LBRACK,
-    ACTIONS(503), 1,
-      sym_identifier,
-    STATE(106), 1,
-      sym_type,
-  [3132] = 4,
+    ACTIONS(25), 1,
+      anon_sym_AT,
+    ACTIONS(165), 1,
+      anon_sym_flag,
+    ACTIONS(589), 1,
+      anon_sym_RBRACE,
+    STATE(22), 1,
+      sym_annotation,
+    STATE(101), 1,
+      aux_sym_stream_declaration_repeat1,
+    STATE(157), 1,
+      sym_flag_declaration,
+  [4272] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(543), 1,
-      anon_sym_COMMA,
-    ACTIONS(545), 1,
-      anon_sym_RBRACK,
-    STATE(227), 1,
-      aux_sym_dictionary_repeat1,
-  [3145] = 2,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    STATE(344), 1,
+      sym__semicolon,
+  [4285] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(547), 3,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_RBRACK,
-  [3154] = 4,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    ACTIONS(591), 1,
+      anon_sym_DASH_GT,
+    STATE(346), 1,
+      sym__semicolon,
+  [4307] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(493), 1,
-      anon_sym_COMMA,
-    ACTIONS(549), 1,
-      anon_sym_RPAREN,
-    STATE(165), 1,
-      aux_sym_case_declaration_repeat1,
-  [3167] = 4,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    STATE(347), 1,
+      sym__semicolon,
+  [4326] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(497), 1,
+    ACTIONS(169), 1,
       anon_sym_LBRACK,
-    ACTIONS(499), 1,
+    ACTIONS(171), 1,
       sym_identifier,
-    STATE(157), 1,
+    STATE(348), 1,
       sym_type,
-  [3180] = 4,
+  [4339] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
+    ACTIONS(169), 1,
       anon_sym_LBRACK,
-    ACTIONS(503), 1,
+    ACTIONS(171), 1,
       sym_identifier,
-    STATE(118), 1,
+    STATE(349), 1,
       sym_type,
-  [3193] = 4,
+  [4352] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
-      anon_sym_LBRACK,
-    ACTIONS(503), 1,
-      sym_identifier,
-    STATE(103), 1,
-      sym_type,
-  [3206] = 4,
+    ACTIONS(593), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [4369] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
-      anon_sym_LBRACK,
-    ACTIONS(503), 1,
-      sym_identifier,
-    STATE(109), 1,
-      sym_type,
-  [3219] = 2,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(595), 1,
+      anon_sym_throws,
+    ACTIONS(597), 1,
+      anon_sym_DASH_GT,
+    STATE(352), 1,
+      sym__semicolon,
+  [4388] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(551), 3,
+    ACTIONS(599), 4,
       anon_sym_RBRACE,
-      anon_sym_case,
+      anon_sym_event,
+      anon_sym_signal,
       anon_sym_AT,
-  [3228] = 3,
+  [4398] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    STATE(151), 1,
-      sym__semicolon,
-    ACTIONS(553), 2,
-      sym__automatic_semicolon,
+    ACTIONS(217), 1,
       anon_sym_SEMI,
-  [3239] = 4,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    STATE(353), 1,
+      sym__semicolon,
+  [4411] = 3,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(493), 1,
+    ACTIONS(401), 1,
       anon_sym_COMMA,
-    ACTIONS(555), 1,
+    ACTIONS(601), 1,
       anon_sym_RPAREN,
-    STATE(244), 1,
-      aux_sym_case_declaration_repeat1,
-  [3252] = 3,
+  [4421] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    STATE(150), 1,
-      sym__semicolon,
-    ACTIONS(557), 2,
-      sym__automatic_semicolon,
+    ACTIONS(603), 4,
+      anon_sym_RBRACE,
+      anon_sym_event,
+      anon_sym_signal,
+      anon_sym_AT,
+  [4431] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(217), 1,
       anon_sym_SEMI,
-  [3263] = 4,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    STATE(355), 1,
+      sym__semicolon,
+  [4444] = 3,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(493), 1,
+    ACTIONS(401), 1,
       anon_sym_COMMA,
-    ACTIONS(559), 1,
+    ACTIONS(605), 1,
       anon_sym_RPAREN,
-    STATE(244), 1,
-      aux_sym_case_declaration_repeat1,
-  [3276] = 3,
+  [4454] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    STATE(149), 1,
-      sym__semicolon,
-    ACTIONS(561), 2,
-      sym__automatic_semicolon,
+    ACTIONS(217), 1,
       anon_sym_SEMI,
-  [3287] = 4,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    STATE(357), 1,
+      sym__semicolon,
+  [4467] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(493), 1,
+    ACTIONS(287), 1,
       anon_sym_COMMA,
-    ACTIONS(563), 1,
+    ACTIONS(607), 1,
       anon_sym_RPAREN,
-    STATE(243), 1,
+    STATE(359), 1,
       aux_sym_case_declaration_repeat1,
-  [3300] = 3,
+  [4480] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    STATE(148), 1,
-      sym__semicolon,
-    ACTIONS(565), 2,
-      sym__automatic_semicolon,
+    ACTIONS(217), 1,
       anon_sym_SEMI,
-  [3311] = 4,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    STATE(360), 1,
+      sym__semicolon,
+  [4493] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(493), 1,
+    ACTIONS(287), 1,
       anon_sym_COMMA,
-    ACTIONS(567), 1,
+    ACTIONS(609), 1,
       anon_sym_RPAREN,
-    STATE(246), 1,
+    STATE(362), 1,
       aux_sym_case_declaration_repeat1,
-  [3324] = 3,
+  [4506] = 12,
     ACTIONS(3), 1,
       sym_comment,
-    STATE(138), 1,
-      sym__semicolon,
-    ACTIONS(569), 2,
-      sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [3335] = 2,
+    ACTIONS(27), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(29), 1,
+      anon_sym_SQUOTE,
+    ACTIONS(185), 1,
+      anon_sym_LBRACK,
+    ACTIONS(187), 1,
+      anon_sym_Yes,
+    ACTIONS(189), 1,
+      anon_sym_No,
+    ACTIONS(191), 1,
+      sym_number,
+    STATE(123), 1,
+      sym_bool,
+    STATE(124), 1,
+      sym_list,
+    STATE(125), 1,
+      sym_dictionary,
+    STATE(126), 1,
+      sym_string,
+    STATE(363), 1,
+      sym_literal,
+  [4543] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(275), 1,
+      anon_sym_DOT,
+    ACTIONS(277), 1,
+      anon_sym_QMARK,
+    ACTIONS(611), 3,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_AT,
+    ACTIONS(613), 1,
+      anon_sym_EQ,
+  [4561] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(571), 3,
+    ACTIONS(615), 3,
       anon_sym_RBRACE,
       anon_sym_case,
       anon_sym_AT,
-  [3344] = 4,
+  [4570] = 3,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(493), 1,
+    ACTIONS(401), 1,
       anon_sym_COMMA,
-    ACTIONS(573), 1,
+    ACTIONS(617), 1,
       anon_sym_RPAREN,
-    STATE(244), 1,
-      aux_sym_case_declaration_repeat1,
-  [3357] = 2,
+  [4580] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(575), 3,
+    ACTIONS(619), 3,
       anon_sym_RBRACE,
       anon_sym_case,
       anon_sym_AT,
-  [3366] = 4,
+  [4589] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(493), 1,
+    ACTIONS(287), 1,
       anon_sym_COMMA,
-    ACTIONS(577), 1,
+    ACTIONS(621), 1,
       anon_sym_RPAREN,
-    STATE(249), 1,
+    STATE(367), 1,
       aux_sym_case_declaration_repeat1,
-  [3379] = 4,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(497), 1,
-      anon_sym_LBRACK,
-    ACTIONS(499), 1,
-      sym_identifier,
-    STATE(239), 1,
-      sym_type,
-  [3392] = 4,
+  [4602] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(497), 1,
-      anon_sym_LBRACK,
-    ACTIONS(499), 1,
-      sym_identifier,
-    STATE(115), 1,
-      sym_type,
-  [3405] = 4,
+    ACTIONS(623), 5,
+      anon_sym_DASH_GT,
+      anon_sym_DOT,
+      anon_sym_QMARK,
+      anon_sym_SEMI,
+      sym__automatic_semicolon,
+  [4613] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(517), 1,
+    ACTIONS(623), 10,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COLON,
+      anon_sym_EQ,
       anon_sym_COMMA,
-    ACTIONS(579), 1,
       anon_sym_RPAREN,
-    STATE(130), 1,
-      aux_sym_annotation_repeat1,
-  [3418] = 4,
+      anon_sym_RBRACK,
+      anon_sym_DOT,
+      anon_sym_QMARK,
+      anon_sym_AT,
+  [4629] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
+    ACTIONS(497), 2,
+      anon_sym_SEMI,
+      sym__automatic_semicolon,
+  [4637] = 12,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(27), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(29), 1,
+      anon_sym_SQUOTE,
+    ACTIONS(185), 1,
       anon_sym_LBRACK,
-    ACTIONS(503), 1,
-      sym_identifier,
-    STATE(105), 1,
-      sym_type,
-  [3431] = 3,
+    ACTIONS(187), 1,
+      anon_sym_Yes,
+    ACTIONS(189), 1,
+      anon_sym_No,
+    ACTIONS(191), 1,
+      sym_number,
+    STATE(123), 1,
+      sym_bool,
+    STATE(124), 1,
+      sym_list,
+    STATE(125), 1,
+      sym_dictionary,
+    STATE(126), 1,
+      sym_string,
+    STATE(368), 1,
+      sym_literal,
+  [4674] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    STATE(139), 1,
-      sym__semicolon,
-    ACTIONS(581), 2,
-      sym__automatic_semicolon,
+    ACTIONS(499), 2,
       anon_sym_SEMI,
-  [3442] = 4,
+      sym__automatic_semicolon,
+  [4682] = 3,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(517), 1,
+    ACTIONS(417), 1,
       anon_sym_COMMA,
-    ACTIONS(583), 1,
+    ACTIONS(625), 1,
       anon_sym_RBRACK,
-    STATE(228), 1,
-      aux_sym_annotation_repeat1,
-  [3455] = 4,
+  [4692] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
-      anon_sym_LBRACK,
-    ACTIONS(503), 1,
+    ACTIONS(211), 2,
+      anon_sym_SEMI,
+      sym__automatic_semicolon,
+  [4700] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(211), 2,
+      anon_sym_SEMI,
+      sym__automatic_semicolon,
+  [4708] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    STATE(370), 1,
+      sym__semicolon,
+  [4727] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(415), 2,
+      anon_sym_AT,
       sym_identifier,
-    STATE(95), 1,
-      sym_type,
-  [3468] = 2,
+  [4735] = 3,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(401), 3,
+    ACTIONS(417), 1,
       anon_sym_COMMA,
+    ACTIONS(627), 1,
       anon_sym_RPAREN,
-      anon_sym_RBRACK,
-  [3477] = 4,
+  [4745] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
+    ACTIONS(629), 2,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+  [4753] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(169), 1,
       anon_sym_LBRACK,
-    ACTIONS(503), 1,
+    ACTIONS(171), 1,
       sym_identifier,
-    STATE(113), 1,
+    STATE(372), 1,
       sym_type,
-  [3490] = 2,
+  [4766] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(585), 3,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_RBRACK,
-  [3499] = 4,
+    ACTIONS(631), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [4783] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(543), 1,
-      anon_sym_COMMA,
-    ACTIONS(587), 1,
-      anon_sym_RBRACK,
-    STATE(232), 1,
-      aux_sym_dictionary_repeat1,
-  [3512] = 4,
+    ACTIONS(633), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [4800] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(517), 1,
-      anon_sym_COMMA,
-    ACTIONS(589), 1,
-      anon_sym_RBRACK,
-    STATE(130), 1,
-      aux_sym_annotation_repeat1,
-  [3525] = 4,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    ACTIONS(635), 1,
+      anon_sym_DASH_GT,
+    STATE(374), 1,
+      sym__semicolon,
+  [4822] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    STATE(375), 1,
+      sym__semicolon,
+  [4841] = 12,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(27), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(29), 1,
+      anon_sym_SQUOTE,
+    ACTIONS(185), 1,
+      anon_sym_LBRACK,
+    ACTIONS(187), 1,
+      anon_sym_Yes,
+    ACTIONS(189), 1,
+      anon_sym_No,
+    ACTIONS(191), 1,
+      sym_number,
+    STATE(123), 1,
+      sym_bool,
+    STATE(124), 1,
+      sym_list,
+    STATE(125), 1,
+      sym_dictionary,
+    STATE(126), 1,
+      sym_string,
+    STATE(376), 1,
+      sym_literal,
+  [4878] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
-      anon_sym_LBRACK,
-    ACTIONS(503), 1,
+    ACTIONS(637), 1,
       sym_identifier,
-    STATE(119), 1,
-      sym_type,
-  [3538] = 2,
+  [4885] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(591), 3,
+    ACTIONS(639), 6,
+      anon_sym_RBRACE,
+      anon_sym_let,
       anon_sym_COMMA,
       anon_sym_RPAREN,
       anon_sym_RBRACK,
-  [3547] = 4,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(501), 1,
-      anon_sym_LBRACK,
-    ACTIONS(503), 1,
-      sym_identifier,
-    STATE(124), 1,
-      sym_type,
-  [3560] = 4,
+      anon_sym_AT,
+  [4897] = 3,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(593), 1,
+    ACTIONS(641), 1,
       anon_sym_COMMA,
-    ACTIONS(596), 1,
+    ACTIONS(643), 1,
       anon_sym_RBRACK,
-    STATE(232), 1,
-      aux_sym_dictionary_repeat1,
-  [3573] = 2,
+  [4907] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(598), 3,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_RBRACK,
-  [3582] = 4,
+    ACTIONS(645), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [4924] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
-      anon_sym_LBRACK,
-    ACTIONS(503), 1,
-      sym_identifier,
-    STATE(96), 1,
-      sym_type,
-  [3595] = 4,
+    ACTIONS(647), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [4941] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
+    ACTIONS(169), 1,
       anon_sym_LBRACK,
-    ACTIONS(503), 1,
+    ACTIONS(171), 1,
       sym_identifier,
-    STATE(123), 1,
+    STATE(380), 1,
       sym_type,
-  [3608] = 2,
+  [4954] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(600), 3,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_RBRACK,
-  [3617] = 4,
+    ACTIONS(649), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [4971] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(501), 1,
-      anon_sym_LBRACK,
-    ACTIONS(503), 1,
-      sym_identifier,
-    STATE(117), 1,
-      sym_type,
-  [3630] = 3,
+    ACTIONS(651), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [4988] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    STATE(144), 1,
-      sym__semicolon,
-    ACTIONS(602), 2,
-      sym__automatic_semicolon,
+    ACTIONS(217), 1,
       anon_sym_SEMI,
-  [3641] = 4,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(364), 1,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
       anon_sym_DOT,
-    ACTIONS(366), 1,
+    ACTIONS(271), 1,
       anon_sym_QMARK,
-    ACTIONS(604), 1,
-      anon_sym_RBRACK,
-  [3654] = 3,
+    ACTIONS(653), 1,
+      anon_sym_DASH_GT,
+    STATE(382), 1,
+      sym__semicolon,
+  [5010] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    STATE(142), 1,
-      sym__semicolon,
-    ACTIONS(606), 2,
-      sym__automatic_semicolon,
+    ACTIONS(217), 1,
       anon_sym_SEMI,
-  [3665] = 4,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    STATE(383), 1,
+      sym__semicolon,
+  [5029] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(497), 1,
+    ACTIONS(169), 1,
       anon_sym_LBRACK,
-    ACTIONS(499), 1,
+    ACTIONS(171), 1,
       sym_identifier,
-    STATE(131), 1,
+    STATE(384), 1,
       sym_type,
-  [3678] = 3,
+  [5042] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    STATE(141), 1,
-      sym__semicolon,
-    ACTIONS(608), 2,
-      sym__automatic_semicolon,
-      anon_sym_SEMI,
-  [3689] = 4,
+    ACTIONS(169), 1,
+      anon_sym_LBRACK,
+    ACTIONS(171), 1,
+      sym_identifier,
+    STATE(385), 1,
+      sym_type,
+  [5055] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(493), 1,
-      anon_sym_COMMA,
-    ACTIONS(610), 1,
-      anon_sym_RPAREN,
-    STATE(244), 1,
-      aux_sym_case_declaration_repeat1,
-  [3702] = 4,
+    ACTIONS(655), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [5072] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(612), 1,
-      anon_sym_COMMA,
-    ACTIONS(615), 1,
-      anon_sym_RPAREN,
-    STATE(244), 1,
-      aux_sym_case_declaration_repeat1,
-  [3715] = 3,
+    ACTIONS(657), 4,
+      anon_sym_RBRACE,
+      anon_sym_event,
+      anon_sym_signal,
+      anon_sym_AT,
+  [5082] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    STATE(140), 1,
-      sym__semicolon,
-    ACTIONS(617), 2,
-      sym__automatic_semicolon,
+    ACTIONS(217), 1,
       anon_sym_SEMI,
-  [3726] = 4,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(493), 1,
-      anon_sym_COMMA,
-    ACTIONS(619), 1,
-      anon_sym_RPAREN,
-    STATE(244), 1,
-      aux_sym_case_declaration_repeat1,
-  [3739] = 2,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    STATE(386), 1,
+      sym__semicolon,
+  [5095] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(621), 3,
+    ACTIONS(659), 4,
       anon_sym_RBRACE,
-      anon_sym_case,
+      anon_sym_event,
+      anon_sym_signal,
       anon_sym_AT,
-  [3748] = 2,
+  [5105] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    STATE(387), 1,
+      sym__semicolon,
+  [5118] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(623), 3,
+    ACTIONS(661), 4,
       anon_sym_RBRACE,
-      anon_sym_case,
+      anon_sym_event,
+      anon_sym_signal,
       anon_sym_AT,
-  [3757] = 4,
+  [5128] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(493), 1,
-      anon_sym_COMMA,
-    ACTIONS(625), 1,
-      anon_sym_RPAREN,
-    STATE(244), 1,
-      aux_sym_case_declaration_repeat1,
-  [3770] = 2,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    STATE(388), 1,
+      sym__semicolon,
+  [5141] = 3,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(615), 2,
+    ACTIONS(401), 1,
       anon_sym_COMMA,
+    ACTIONS(663), 1,
       anon_sym_RPAREN,
-  [3778] = 2,
+  [5151] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(77), 2,
+    ACTIONS(665), 4,
+      anon_sym_RBRACE,
+      anon_sym_event,
+      anon_sym_signal,
       anon_sym_AT,
-      sym_identifier,
-  [3786] = 2,
+  [5161] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(79), 2,
-      anon_sym_AT,
-      sym_identifier,
-  [3794] = 2,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    STATE(390), 1,
+      sym__semicolon,
+  [5174] = 3,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(75), 2,
-      anon_sym_AT,
-      sym_identifier,
-  [3802] = 2,
+    ACTIONS(401), 1,
+      anon_sym_COMMA,
+    ACTIONS(667), 1,
+      anon_sym_RPAREN,
+  [5184] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(77), 2,
-      anon_sym_flag,
+    ACTIONS(669), 3,
+      anon_sym_RBRACE,
+      anon_sym_let,
       anon_sym_AT,
-  [3810] = 2,
+  [5193] = 12,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(79), 2,
-      anon_sym_flag,
-      anon_sym_AT,
-  [3818] = 2,
+    ACTIONS(27), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(29), 1,
+      anon_sym_SQUOTE,
+    ACTIONS(185), 1,
+      anon_sym_LBRACK,
+    ACTIONS(187), 1,
+      anon_sym_Yes,
+    ACTIONS(189), 1,
+      anon_sym_No,
+    ACTIONS(191), 1,
+      sym_number,
+    STATE(123), 1,
+      sym_bool,
+    STATE(124), 1,
+      sym_list,
+    STATE(125), 1,
+      sym_dictionary,
+    STATE(126), 1,
+      sym_string,
+    STATE(392), 1,
+      sym_literal,
+  [5230] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(75), 2,
-      anon_sym_flag,
+    ACTIONS(671), 3,
+      anon_sym_RBRACE,
+      anon_sym_case,
       anon_sym_AT,
-  [3826] = 2,
+  [5239] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(627), 2,
-      anon_sym_COMMA,
-      anon_sym_RBRACK,
-  [3834] = 3,
+    ACTIONS(673), 3,
+      anon_sym_RBRACE,
+      anon_sym_case,
+      anon_sym_AT,
+  [5248] = 3,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(629), 1,
-      anon_sym_LBRACE,
-    ACTIONS(631), 1,
-      anon_sym_COLON,
-  [3844] = 3,
+    ACTIONS(401), 1,
+      anon_sym_COMMA,
+    ACTIONS(675), 1,
+      anon_sym_RPAREN,
+  [5258] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(633), 1,
-      anon_sym_LBRACE,
-    ACTIONS(635), 1,
-      anon_sym_COLON,
-  [3854] = 2,
+    ACTIONS(579), 1,
+      anon_sym_COMMA,
+    ACTIONS(677), 1,
+      anon_sym_RBRACK,
+    STATE(395), 1,
+      aux_sym_dictionary_repeat1,
+  [5271] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(637), 1,
-      anon_sym_LPAREN,
-  [3861] = 2,
+    ACTIONS(583), 2,
+      anon_sym_SEMI,
+      sym__automatic_semicolon,
+  [5279] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(639), 1,
+    ACTIONS(679), 11,
       ts_builtin_sym_end,
-  [3868] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(641), 1,
-      sym_identifier,
-  [3875] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(643), 1,
-      sym_identifier,
-  [3882] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(645), 1,
-      sym_identifier,
-  [3889] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(647), 1,
-      sym_identifier,
-  [3896] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(649), 1,
-      anon_sym_COLON,
-  [3903] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(651), 1,
-      sym_identifier,
-  [3910] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(653), 1,
-      sym_identifier,
-  [3917] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(655), 1,
-      sym_identifier,
-  [3924] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(657), 1,
-      sym_identifier,
-  [3931] = 2,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [5296] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(659), 1,
+    ACTIONS(505), 2,
+      anon_sym_AT,
       sym_identifier,
-  [3938] = 2,
+  [5304] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(661), 1,
-      sym_identifier,
-  [3945] = 2,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    STATE(396), 1,
+      sym__semicolon,
+  [5323] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(663), 1,
+    ACTIONS(169), 1,
+      anon_sym_LBRACK,
+    ACTIONS(171), 1,
       sym_identifier,
-  [3952] = 2,
+    STATE(397), 1,
+      sym_type,
+  [5336] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(665), 1,
-      anon_sym_LPAREN,
-  [3959] = 2,
+    ACTIONS(681), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [5353] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(667), 1,
-      anon_sym_LPAREN,
-  [3966] = 2,
+    ACTIONS(683), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [5370] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(669), 1,
-      anon_sym_LBRACE,
-  [3973] = 2,
+    ACTIONS(685), 2,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+  [5378] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(671), 1,
-      anon_sym_LBRACE,
-  [3980] = 2,
+    ACTIONS(687), 1,
+      anon_sym_COLON,
+  [5385] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(673), 1,
-      anon_sym_LBRACE,
-  [3987] = 2,
+    ACTIONS(689), 1,
+      sym_identifier,
+  [5392] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(675), 1,
-      anon_sym_LBRACE,
-  [3994] = 2,
+    ACTIONS(691), 6,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+      anon_sym_AT,
+  [5404] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(677), 1,
-      anon_sym_LBRACE,
-  [4001] = 2,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    STATE(400), 1,
+      sym__semicolon,
+  [5423] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(679), 1,
-      anon_sym_LBRACE,
-  [4008] = 2,
+    ACTIONS(169), 1,
+      anon_sym_LBRACK,
+    ACTIONS(171), 1,
+      sym_identifier,
+    STATE(401), 1,
+      sym_type,
+  [5436] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(681), 1,
-      anon_sym_COLON,
-  [4015] = 2,
+    ACTIONS(693), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [5453] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(683), 1,
-      anon_sym_as,
-  [4022] = 2,
+    ACTIONS(695), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [5470] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(685), 1,
-      anon_sym_COLON,
-  [4029] = 2,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    ACTIONS(697), 1,
+      anon_sym_DASH_GT,
+    STATE(403), 1,
+      sym__semicolon,
+  [5492] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(687), 1,
-      sym_optional,
-  [4036] = 2,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    STATE(404), 1,
+      sym__semicolon,
+  [5511] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(689), 1,
-      anon_sym_LPAREN,
-  [4043] = 2,
+    ACTIONS(699), 4,
+      anon_sym_RBRACE,
+      anon_sym_event,
+      anon_sym_signal,
+      anon_sym_AT,
+  [5521] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(691), 1,
-      anon_sym_LPAREN,
-  [4050] = 2,
+    ACTIONS(701), 4,
+      anon_sym_RBRACE,
+      anon_sym_event,
+      anon_sym_signal,
+      anon_sym_AT,
+  [5531] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(693), 1,
-      anon_sym_LPAREN,
-  [4057] = 2,
+    ACTIONS(703), 4,
+      anon_sym_RBRACE,
+      anon_sym_event,
+      anon_sym_signal,
+      anon_sym_AT,
+  [5541] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(695), 1,
-      anon_sym_LBRACE,
-  [4064] = 2,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    STATE(405), 1,
+      sym__semicolon,
+  [5554] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(697), 1,
-      anon_sym_COLON,
-  [4071] = 2,
+    ACTIONS(705), 4,
+      anon_sym_RBRACE,
+      anon_sym_event,
+      anon_sym_signal,
+      anon_sym_AT,
+  [5564] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(699), 1,
-      sym_identifier,
-  [4078] = 2,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    STATE(406), 1,
+      sym__semicolon,
+  [5577] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(701), 1,
-      anon_sym_LBRACE,
-  [4085] = 2,
+    ACTIONS(707), 3,
+      anon_sym_RBRACE,
+      anon_sym_let,
+      anon_sym_AT,
+  [5586] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(703), 1,
-      sym_identifier,
-  [4092] = 2,
+    ACTIONS(709), 3,
+      anon_sym_RBRACE,
+      anon_sym_case,
+      anon_sym_AT,
+  [5595] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(705), 1,
-      sym_identifier,
-  [4099] = 2,
+    ACTIONS(639), 2,
+      anon_sym_SEMI,
+      sym__automatic_semicolon,
+  [5603] = 3,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(707), 1,
-      sym_identifier,
-  [4106] = 2,
+    ACTIONS(641), 1,
+      anon_sym_COMMA,
+    ACTIONS(711), 1,
+      anon_sym_RBRACK,
+  [5613] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(709), 1,
-      anon_sym_LPAREN,
-  [4113] = 2,
+    ACTIONS(713), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [5630] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(711), 1,
-      sym_identifier,
-  [4120] = 2,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    STATE(408), 1,
+      sym__semicolon,
+  [5649] = 12,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(713), 1,
-      anon_sym_RBRACK,
-  [4127] = 2,
+    ACTIONS(27), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(29), 1,
+      anon_sym_SQUOTE,
+    ACTIONS(185), 1,
+      anon_sym_LBRACK,
+    ACTIONS(187), 1,
+      anon_sym_Yes,
+    ACTIONS(189), 1,
+      anon_sym_No,
+    ACTIONS(191), 1,
+      sym_number,
+    STATE(123), 1,
+      sym_bool,
+    STATE(124), 1,
+      sym_list,
+    STATE(125), 1,
+      sym_dictionary,
+    STATE(126), 1,
+      sym_string,
+    STATE(409), 1,
+      sym_literal,
+  [5686] = 2,
     ACTIONS(3), 1,
       sym_comment,
     ACTIONS(715), 1,
-      sym_optional,
-  [4134] = 2,
+      anon_sym_COLON,
+  [5693] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(717), 1,
-      sym_identifier,
-  [4141] = 2,
+    ACTIONS(717), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [5710] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(475), 1,
-      sym_identifier,
-  [4148] = 2,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    STATE(411), 1,
+      sym__semicolon,
+  [5729] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(719), 1,
+    ACTIONS(169), 1,
+      anon_sym_LBRACK,
+    ACTIONS(171), 1,
       sym_identifier,
-  [4155] = 2,
+    STATE(412), 1,
+      sym_type,
+  [5742] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(721), 1,
-      anon_sym_COLON,
-  [4162] = 2,
+    ACTIONS(719), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [5759] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(407), 1,
-      sym_identifier,
-  [4169] = 2,
+    ACTIONS(721), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [5776] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(723), 1,
-      sym_identifier,
-  [4176] = 2,
+    ACTIONS(723), 4,
+      anon_sym_RBRACE,
+      anon_sym_event,
+      anon_sym_signal,
+      anon_sym_AT,
+  [5786] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(725), 1,
-      sym_identifier,
-  [4183] = 2,
+    ACTIONS(725), 4,
+      anon_sym_RBRACE,
+      anon_sym_event,
+      anon_sym_signal,
+      anon_sym_AT,
+  [5796] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(727), 1,
-      anon_sym_COLON,
-  [4190] = 2,
+    ACTIONS(691), 2,
+      anon_sym_SEMI,
+      sym__automatic_semicolon,
+  [5804] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(729), 1,
-      sym_identifier,
-  [4197] = 2,
+    ACTIONS(727), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [5821] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(731), 1,
-      anon_sym_LPAREN,
-  [4204] = 2,
+    ACTIONS(729), 2,
+      anon_sym_COMMA,
+      anon_sym_RBRACK,
+  [5829] = 12,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(733), 1,
-      sym_identifier,
-  [4211] = 2,
+    ACTIONS(27), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(29), 1,
+      anon_sym_SQUOTE,
+    ACTIONS(185), 1,
+      anon_sym_LBRACK,
+    ACTIONS(187), 1,
+      anon_sym_Yes,
+    ACTIONS(189), 1,
+      anon_sym_No,
+    ACTIONS(191), 1,
+      sym_number,
+    STATE(123), 1,
+      sym_bool,
+    STATE(124), 1,
+      sym_list,
+    STATE(125), 1,
+      sym_dictionary,
+    STATE(126), 1,
+      sym_string,
+    STATE(413), 1,
+      sym_literal,
+  [5866] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(735), 1,
-      sym_identifier,
-  [4218] = 2,
+    ACTIONS(731), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
+  [5883] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(737), 1,
-      anon_sym_LPAREN,
-  [4225] = 2,
+    ACTIONS(217), 1,
+      anon_sym_SEMI,
+    ACTIONS(219), 1,
+      sym__automatic_semicolon,
+    ACTIONS(269), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 1,
+      anon_sym_QMARK,
+    STATE(414), 1,
+      sym__semicolon,
+  [5902] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(739), 1,
-      sym_identifier,
-  [4232] = 2,
+    ACTIONS(733), 2,
+      anon_sym_COMMA,
+      anon_sym_RBRACK,
+  [5910] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(741), 1,
-      sym_identifier,
+    ACTIONS(735), 11,
+      ts_builtin_sym_end,
+      anon_sym_import,
+      anon_sym_stream,
+      anon_sym_struct,
+      anon_sym_enum,
+      anon_sym_flagset,
+      anon_sym_typealias,
+      anon_sym_newtype,
+      anon_sym_const,
+      anon_sym_func,
+      anon_sym_AT,
 };
 
 static const uint32_t ts_small_parse_table_map[] = {
   [SMALL_STATE(2)] = 0,
-  [SMALL_STATE(3)] = 44,
-  [SMALL_STATE(4)] = 88,
-  [SMALL_STATE(5)] = 126,
-  [SMALL_STATE(6)] = 158,
-  [SMALL_STATE(7)] = 190,
-  [SMALL_STATE(8)] = 222,
-  [SMALL_STATE(9)] = 244,
-  [SMALL_STATE(10)] = 273,
-  [SMALL_STATE(11)] = 302,
-  [SMALL_STATE(12)] = 331,
-  [SMALL_STATE(13)] = 347,
-  [SMALL_STATE(14)] = 363,
-  [SMALL_STATE(15)] = 379,
-  [SMALL_STATE(16)] = 394,
-  [SMALL_STATE(17)] = 419,
-  [SMALL_STATE(18)] = 444,
-  [SMALL_STATE(19)] = 469,
-  [SMALL_STATE(20)] = 484,
-  [SMALL_STATE(21)] = 499,
-  [SMALL_STATE(22)] = 524,
-  [SMALL_STATE(23)] = 539,
-  [SMALL_STATE(24)] = 564,
-  [SMALL_STATE(25)] = 578,
-  [SMALL_STATE(26)] = 592,
-  [SMALL_STATE(27)] = 606,
-  [SMALL_STATE(28)] = 620,
-  [SMALL_STATE(29)] = 634,
-  [SMALL_STATE(30)] = 648,
-  [SMALL_STATE(31)] = 662,
-  [SMALL_STATE(32)] = 676,
-  [SMALL_STATE(33)] = 690,
-  [SMALL_STATE(34)] = 704,
-  [SMALL_STATE(35)] = 718,
-  [SMALL_STATE(36)] = 732,
-  [SMALL_STATE(37)] = 746,
-  [SMALL_STATE(38)] = 772,
-  [SMALL_STATE(39)] = 786,
-  [SMALL_STATE(40)] = 800,
-  [SMALL_STATE(41)] = 814,
-  [SMALL_STATE(42)] = 828,
-  [SMALL_STATE(43)] = 842,
-  [SMALL_STATE(44)] = 856,
-  [SMALL_STATE(45)] = 870,
-  [SMALL_STATE(46)] = 884,
-  [SMALL_STATE(47)] = 898,
-  [SMALL_STATE(48)] = 912,
-  [SMALL_STATE(49)] = 926,
-  [SMALL_STATE(50)] = 940,
-  [SMALL_STATE(51)] = 954,
-  [SMALL_STATE(52)] = 968,
-  [SMALL_STATE(53)] = 982,
-  [SMALL_STATE(54)] = 996,
-  [SMALL_STATE(55)] = 1010,
-  [SMALL_STATE(56)] = 1024,
-  [SMALL_STATE(57)] = 1038,
-  [SMALL_STATE(58)] = 1052,
-  [SMALL_STATE(59)] = 1066,
-  [SMALL_STATE(60)] = 1080,
-  [SMALL_STATE(61)] = 1094,
-  [SMALL_STATE(62)] = 1108,
-  [SMALL_STATE(63)] = 1122,
-  [SMALL_STATE(64)] = 1136,
-  [SMALL_STATE(65)] = 1150,
-  [SMALL_STATE(66)] = 1164,
-  [SMALL_STATE(67)] = 1178,
-  [SMALL_STATE(68)] = 1192,
-  [SMALL_STATE(69)] = 1206,
-  [SMALL_STATE(70)] = 1220,
-  [SMALL_STATE(71)] = 1234,
-  [SMALL_STATE(72)] = 1255,
-  [SMALL_STATE(73)] = 1276,
-  [SMALL_STATE(74)] = 1297,
-  [SMALL_STATE(75)] = 1318,
-  [SMALL_STATE(76)] = 1339,
-  [SMALL_STATE(77)] = 1360,
-  [SMALL_STATE(78)] = 1381,
-  [SMALL_STATE(79)] = 1402,
-  [SMALL_STATE(80)] = 1423,
-  [SMALL_STATE(81)] = 1444,
-  [SMALL_STATE(82)] = 1465,
-  [SMALL_STATE(83)] = 1486,
-  [SMALL_STATE(84)] = 1507,
-  [SMALL_STATE(85)] = 1528,
-  [SMALL_STATE(86)] = 1549,
-  [SMALL_STATE(87)] = 1570,
-  [SMALL_STATE(88)] = 1591,
-  [SMALL_STATE(89)] = 1612,
-  [SMALL_STATE(90)] = 1633,
-  [SMALL_STATE(91)] = 1653,
-  [SMALL_STATE(92)] = 1673,
-  [SMALL_STATE(93)] = 1693,
-  [SMALL_STATE(94)] = 1713,
-  [SMALL_STATE(95)] = 1733,
-  [SMALL_STATE(96)] = 1753,
-  [SMALL_STATE(97)] = 1773,
-  [SMALL_STATE(98)] = 1793,
-  [SMALL_STATE(99)] = 1813,
-  [SMALL_STATE(100)] = 1833,
-  [SMALL_STATE(101)] = 1853,
-  [SMALL_STATE(102)] = 1873,
-  [SMALL_STATE(103)] = 1893,
-  [SMALL_STATE(104)] = 1913,
-  [SMALL_STATE(105)] = 1930,
-  [SMALL_STATE(106)] = 1947,
-  [SMALL_STATE(107)] = 1964,
-  [SMALL_STATE(108)] = 1981,
-  [SMALL_STATE(109)] = 1998,
-  [SMALL_STATE(110)] = 2015,
-  [SMALL_STATE(111)] = 2032,
-  [SMALL_STATE(112)] = 2049,
-  [SMALL_STATE(113)] = 2066,
-  [SMALL_STATE(114)] = 2083,
-  [SMALL_STATE(115)] = 2100,
-  [SMALL_STATE(116)] = 2115,
-  [SMALL_STATE(117)] = 2126,
-  [SMALL_STATE(118)] = 2143,
-  [SMALL_STATE(119)] = 2160,
-  [SMALL_STATE(120)] = 2177,
-  [SMALL_STATE(121)] = 2194,
-  [SMALL_STATE(122)] = 2211,
-  [SMALL_STATE(123)] = 2222,
-  [SMALL_STATE(124)] = 2239,
-  [SMALL_STATE(125)] = 2256,
-  [SMALL_STATE(126)] = 2267,
-  [SMALL_STATE(127)] = 2284,
-  [SMALL_STATE(128)] = 2295,
-  [SMALL_STATE(129)] = 2310,
-  [SMALL_STATE(130)] = 2327,
-  [SMALL_STATE(131)] = 2341,
-  [SMALL_STATE(132)] = 2355,
-  [SMALL_STATE(133)] = 2371,
-  [SMALL_STATE(134)] = 2385,
-  [SMALL_STATE(135)] = 2399,
-  [SMALL_STATE(136)] = 2411,
-  [SMALL_STATE(137)] = 2425,
-  [SMALL_STATE(138)] = 2439,
-  [SMALL_STATE(139)] = 2449,
-  [SMALL_STATE(140)] = 2459,
-  [SMALL_STATE(141)] = 2469,
-  [SMALL_STATE(142)] = 2479,
-  [SMALL_STATE(143)] = 2489,
-  [SMALL_STATE(144)] = 2503,
-  [SMALL_STATE(145)] = 2513,
-  [SMALL_STATE(146)] = 2523,
-  [SMALL_STATE(147)] = 2537,
-  [SMALL_STATE(148)] = 2551,
-  [SMALL_STATE(149)] = 2561,
-  [SMALL_STATE(150)] = 2571,
-  [SMALL_STATE(151)] = 2581,
-  [SMALL_STATE(152)] = 2591,
-  [SMALL_STATE(153)] = 2601,
-  [SMALL_STATE(154)] = 2615,
-  [SMALL_STATE(155)] = 2629,
-  [SMALL_STATE(156)] = 2643,
-  [SMALL_STATE(157)] = 2653,
-  [SMALL_STATE(158)] = 2669,
-  [SMALL_STATE(159)] = 2681,
-  [SMALL_STATE(160)] = 2695,
-  [SMALL_STATE(161)] = 2709,
-  [SMALL_STATE(162)] = 2719,
-  [SMALL_STATE(163)] = 2733,
-  [SMALL_STATE(164)] = 2744,
-  [SMALL_STATE(165)] = 2753,
-  [SMALL_STATE(166)] = 2766,
-  [SMALL_STATE(167)] = 2779,
-  [SMALL_STATE(168)] = 2792,
-  [SMALL_STATE(169)] = 2805,
-  [SMALL_STATE(170)] = 2818,
-  [SMALL_STATE(171)] = 2827,
-  [SMALL_STATE(172)] = 2840,
-  [SMALL_STATE(173)] = 2853,
-  [SMALL_STATE(174)] = 2866,
-  [SMALL_STATE(175)] = 2879,
-  [SMALL_STATE(176)] = 2890,
-  [SMALL_STATE(177)] = 2903,
-  [SMALL_STATE(178)] = 2914,
-  [SMALL_STATE(179)] = 2927,
-  [SMALL_STATE(180)] = 2940,
-  [SMALL_STATE(181)] = 2953,
-  [SMALL_STATE(182)] = 2966,
-  [SMALL_STATE(183)] = 2979,
-  [SMALL_STATE(184)] = 2988,
-  [SMALL_STATE(185)] = 3001,
-  [SMALL_STATE(186)] = 3014,
-  [SMALL_STATE(187)] = 3027,
-  [SMALL_STATE(188)] = 3040,
-  [SMALL_STATE(189)] = 3053,
-  [SMALL_STATE(190)] = 3062,
-  [SMALL_STATE(191)] = 3075,
-  [SMALL_STATE(192)] = 3088,
-  [SMALL_STATE(193)] = 3097,
-  [SMALL_STATE(194)] = 3106,
-  [SMALL_STATE(195)] = 3119,
-  [SMALL_STATE(196)] = 3132,
-  [SMALL_STATE(197)] = 3145,
-  [SMALL_STATE(198)] = 3154,
-  [SMALL_STATE(199)] = 3167,
-  [SMALL_STATE(200)] = 3180,
-  [SMALL_STATE(201)] = 3193,
-  [SMALL_STATE(202)] = 3206,
-  [SMALL_STATE(203)] = 3219,
-  [SMALL_STATE(204)] = 3228,
-  [SMALL_STATE(205)] = 3239,
-  [SMALL_STATE(206)] = 3252,
-  [SMALL_STATE(207)] = 3263,
-  [SMALL_STATE(208)] = 3276,
-  [SMALL_STATE(209)] = 3287,
-  [SMALL_STATE(210)] = 3300,
-  [SMALL_STATE(211)] = 3311,
-  [SMALL_STATE(212)] = 3324,
-  [SMALL_STATE(213)] = 3335,
-  [SMALL_STATE(214)] = 3344,
-  [SMALL_STATE(215)] = 3357,
-  [SMALL_STATE(216)] = 3366,
-  [SMALL_STATE(217)] = 3379,
-  [SMALL_STATE(218)] = 3392,
-  [SMALL_STATE(219)] = 3405,
-  [SMALL_STATE(220)] = 3418,
-  [SMALL_STATE(221)] = 3431,
-  [SMALL_STATE(222)] = 3442,
-  [SMALL_STATE(223)] = 3455,
-  [SMALL_STATE(224)] = 3468,
-  [SMALL_STATE(225)] = 3477,
-  [SMALL_STATE(226)] = 3490,
-  [SMALL_STATE(227)] = 3499,
-  [SMALL_STATE(228)] = 3512,
-  [SMALL_STATE(229)] = 3525,
-  [SMALL_STATE(230)] = 3538,
-  [SMALL_STATE(231)] = 3547,
-  [SMALL_STATE(232)] = 3560,
-  [SMALL_STATE(233)] = 3573,
-  [SMALL_STATE(234)] = 3582,
-  [SMALL_STATE(235)] = 3595,
-  [SMALL_STATE(236)] = 3608,
-  [SMALL_STATE(237)] = 3617,
-  [SMALL_STATE(238)] = 3630,
-  [SMALL_STATE(239)] = 3641,
-  [SMALL_STATE(240)] = 3654,
-  [SMALL_STATE(241)] = 3665,
-  [SMALL_STATE(242)] = 3678,
-  [SMALL_STATE(243)] = 3689,
-  [SMALL_STATE(244)] = 3702,
-  [SMALL_STATE(245)] = 3715,
-  [SMALL_STATE(246)] = 3726,
-  [SMALL_STATE(247)] = 3739,
-  [SMALL_STATE(248)] = 3748,
-  [SMALL_STATE(249)] = 3757,
-  [SMALL_STATE(250)] = 3770,
-  [SMALL_STATE(251)] = 3778,
-  [SMALL_STATE(252)] = 3786,
-  [SMALL_STATE(253)] = 3794,
-  [SMALL_STATE(254)] = 3802,
-  [SMALL_STATE(255)] = 3810,
-  [SMALL_STATE(256)] = 3818,
-  [SMALL_STATE(257)] = 3826,
-  [SMALL_STATE(258)] = 3834,
-  [SMALL_STATE(259)] = 3844,
-  [SMALL_STATE(260)] = 3854,
-  [SMALL_STATE(261)] = 3861,
-  [SMALL_STATE(262)] = 3868,
-  [SMALL_STATE(263)] = 3875,
-  [SMALL_STATE(264)] = 3882,
-  [SMALL_STATE(265)] = 3889,
-  [SMALL_STATE(266)] = 3896,
-  [SMALL_STATE(267)] = 3903,
-  [SMALL_STATE(268)] = 3910,
-  [SMALL_STATE(269)] = 3917,
-  [SMALL_STATE(270)] = 3924,
-  [SMALL_STATE(271)] = 3931,
-  [SMALL_STATE(272)] = 3938,
-  [SMALL_STATE(273)] = 3945,
-  [SMALL_STATE(274)] = 3952,
-  [SMALL_STATE(275)] = 3959,
-  [SMALL_STATE(276)] = 3966,
-  [SMALL_STATE(277)] = 3973,
-  [SMALL_STATE(278)] = 3980,
-  [SMALL_STATE(279)] = 3987,
-  [SMALL_STATE(280)] = 3994,
-  [SMALL_STATE(281)] = 4001,
-  [SMALL_STATE(282)] = 4008,
+  [SMALL_STATE(3)] = 13,
+  [SMALL_STATE(4)] = 20,
+  [SMALL_STATE(5)] = 27,
+  [SMALL_STATE(6)] = 34,
+  [SMALL_STATE(7)] = 41,
+  [SMALL_STATE(8)] = 48,
+  [SMALL_STATE(9)] = 55,
+  [SMALL_STATE(10)] = 62,
+  [SMALL_STATE(11)] = 69,
+  [SMALL_STATE(12)] = 76,
+  [SMALL_STATE(13)] = 83,
+  [SMALL_STATE(14)] = 100,
+  [SMALL_STATE(15)] = 117,
+  [SMALL_STATE(16)] = 134,
+  [SMALL_STATE(17)] = 151,
+  [SMALL_STATE(18)] = 168,
+  [SMALL_STATE(19)] = 185,
+  [SMALL_STATE(20)] = 202,
+  [SMALL_STATE(21)] = 219,
+  [SMALL_STATE(22)] = 236,
+  [SMALL_STATE(23)] = 256,
+  [SMALL_STATE(24)] = 326,
+  [SMALL_STATE(25)] = 360,
+  [SMALL_STATE(26)] = 376,
+  [SMALL_STATE(27)] = 392,
+  [SMALL_STATE(28)] = 399,
+  [SMALL_STATE(29)] = 406,
+  [SMALL_STATE(30)] = 413,
+  [SMALL_STATE(31)] = 420,
+  [SMALL_STATE(32)] = 430,
+  [SMALL_STATE(33)] = 437,
+  [SMALL_STATE(34)] = 444,
+  [SMALL_STATE(35)] = 451,
+  [SMALL_STATE(36)] = 458,
+  [SMALL_STATE(37)] = 465,
+  [SMALL_STATE(38)] = 482,
+  [SMALL_STATE(39)] = 489,
+  [SMALL_STATE(40)] = 496,
+  [SMALL_STATE(41)] = 503,
+  [SMALL_STATE(42)] = 510,
+  [SMALL_STATE(43)] = 517,
+  [SMALL_STATE(44)] = 524,
+  [SMALL_STATE(45)] = 531,
+  [SMALL_STATE(46)] = 538,
+  [SMALL_STATE(47)] = 558,
+  [SMALL_STATE(48)] = 571,
+  [SMALL_STATE(49)] = 580,
+  [SMALL_STATE(50)] = 589,
+  [SMALL_STATE(51)] = 602,
+  [SMALL_STATE(52)] = 615,
+  [SMALL_STATE(53)] = 624,
+  [SMALL_STATE(54)] = 633,
+  [SMALL_STATE(55)] = 646,
+  [SMALL_STATE(56)] = 653,
+  [SMALL_STATE(57)] = 684,
+  [SMALL_STATE(58)] = 709,
+  [SMALL_STATE(59)] = 734,
+  [SMALL_STATE(60)] = 759,
+  [SMALL_STATE(61)] = 766,
+  [SMALL_STATE(62)] = 779,
+  [SMALL_STATE(63)] = 792,
+  [SMALL_STATE(64)] = 805,
+  [SMALL_STATE(65)] = 827,
+  [SMALL_STATE(66)] = 867,
+  [SMALL_STATE(67)] = 874,
+  [SMALL_STATE(68)] = 881,
+  [SMALL_STATE(69)] = 888,
+  [SMALL_STATE(70)] = 898,
+  [SMALL_STATE(71)] = 905,
+  [SMALL_STATE(72)] = 912,
+  [SMALL_STATE(73)] = 919,
+  [SMALL_STATE(74)] = 926,
+  [SMALL_STATE(75)] = 939,
+  [SMALL_STATE(76)] = 948,
+  [SMALL_STATE(77)] = 957,
+  [SMALL_STATE(78)] = 970,
+  [SMALL_STATE(79)] = 979,
+  [SMALL_STATE(80)] = 988,
+  [SMALL_STATE(81)] = 1001,
+  [SMALL_STATE(82)] = 1018,
+  [SMALL_STATE(83)] = 1025,
+  [SMALL_STATE(84)] = 1032,
+  [SMALL_STATE(85)] = 1042,
+  [SMALL_STATE(86)] = 1052,
+  [SMALL_STATE(87)] = 1068,
+  [SMALL_STATE(88)] = 1096,
+  [SMALL_STATE(89)] = 1113,
+  [SMALL_STATE(90)] = 1120,
+  [SMALL_STATE(91)] = 1129,
+  [SMALL_STATE(92)] = 1142,
+  [SMALL_STATE(93)] = 1164,
+  [SMALL_STATE(94)] = 1181,
+  [SMALL_STATE(95)] = 1188,
+  [SMALL_STATE(96)] = 1197,
+  [SMALL_STATE(97)] = 1210,
+  [SMALL_STATE(98)] = 1232,
+  [SMALL_STATE(99)] = 1249,
+  [SMALL_STATE(100)] = 1256,
+  [SMALL_STATE(101)] = 1265,
+  [SMALL_STATE(102)] = 1278,
+  [SMALL_STATE(103)] = 1300,
+  [SMALL_STATE(104)] = 1307,
+  [SMALL_STATE(105)] = 1320,
+  [SMALL_STATE(106)] = 1331,
+  [SMALL_STATE(107)] = 1350,
+  [SMALL_STATE(108)] = 1369,
+  [SMALL_STATE(109)] = 1382,
+  [SMALL_STATE(110)] = 1398,
+  [SMALL_STATE(111)] = 1411,
+  [SMALL_STATE(112)] = 1430,
+  [SMALL_STATE(113)] = 1437,
+  [SMALL_STATE(114)] = 1444,
+  [SMALL_STATE(115)] = 1457,
+  [SMALL_STATE(116)] = 1465,
+  [SMALL_STATE(117)] = 1478,
+  [SMALL_STATE(118)] = 1498,
+  [SMALL_STATE(119)] = 1544,
+  [SMALL_STATE(120)] = 1556,
+  [SMALL_STATE(121)] = 1568,
+  [SMALL_STATE(122)] = 1580,
+  [SMALL_STATE(123)] = 1593,
+  [SMALL_STATE(124)] = 1605,
+  [SMALL_STATE(125)] = 1617,
+  [SMALL_STATE(126)] = 1629,
+  [SMALL_STATE(127)] = 1641,
+  [SMALL_STATE(128)] = 1672,
+  [SMALL_STATE(129)] = 1697,
+  [SMALL_STATE(130)] = 1722,
+  [SMALL_STATE(131)] = 1747,
+  [SMALL_STATE(132)] = 1754,
+  [SMALL_STATE(133)] = 1767,
+  [SMALL_STATE(134)] = 1780,
+  [SMALL_STATE(135)] = 1793,
+  [SMALL_STATE(136)] = 1815,
+  [SMALL_STATE(137)] = 1835,
+  [SMALL_STATE(138)] = 1855,
+  [SMALL_STATE(139)] = 1872,
+  [SMALL_STATE(140)] = 1879,
+  [SMALL_STATE(141)] = 1886,
+  [SMALL_STATE(142)] = 1893,
+  [SMALL_STATE(143)] = 1900,
+  [SMALL_STATE(144)] = 1917,
+  [SMALL_STATE(145)] = 1927,
+  [SMALL_STATE(146)] = 1937,
+  [SMALL_STATE(147)] = 1944,
+  [SMALL_STATE(148)] = 1951,
+  [SMALL_STATE(149)] = 1968,
+  [SMALL_STATE(150)] = 1977,
+  [SMALL_STATE(151)] = 1989,
+  [SMALL_STATE(152)] = 1996,
+  [SMALL_STATE(153)] = 2013,
+  [SMALL_STATE(154)] = 2022,
+  [SMALL_STATE(155)] = 2031,
+  [SMALL_STATE(156)] = 2038,
+  [SMALL_STATE(157)] = 2055,
+  [SMALL_STATE(158)] = 2064,
+  [SMALL_STATE(159)] = 2089,
+  [SMALL_STATE(160)] = 2105,
+  [SMALL_STATE(161)] = 2112,
+  [SMALL_STATE(162)] = 2123,
+  [SMALL_STATE(163)] = 2140,
+  [SMALL_STATE(164)] = 2157,
+  [SMALL_STATE(165)] = 2173,
+  [SMALL_STATE(166)] = 2210,
+  [SMALL_STATE(167)] = 2217,
+  [SMALL_STATE(168)] = 2233,
+  [SMALL_STATE(169)] = 2246,
+  [SMALL_STATE(170)] = 2259,
+  [SMALL_STATE(171)] = 2276,
+  [SMALL_STATE(172)] = 2283,
+  [SMALL_STATE(173)] = 2296,
+  [SMALL_STATE(174)] = 2315,
+  [SMALL_STATE(175)] = 2334,
+  [SMALL_STATE(176)] = 2344,
+  [SMALL_STATE(177)] = 2351,
+  [SMALL_STATE(178)] = 2359,
+  [SMALL_STATE(179)] = 2366,
+  [SMALL_STATE(180)] = 2378,
+  [SMALL_STATE(181)] = 2385,
+  [SMALL_STATE(182)] = 2398,
+  [SMALL_STATE(183)] = 2435,
+  [SMALL_STATE(184)] = 2455,
+  [SMALL_STATE(185)] = 2465,
+  [SMALL_STATE(186)] = 2482,
+  [SMALL_STATE(187)] = 2510,
+  [SMALL_STATE(188)] = 2527,
+  [SMALL_STATE(189)] = 2549,
+  [SMALL_STATE(190)] = 2566,
+  [SMALL_STATE(191)] = 2588,
+  [SMALL_STATE(192)] = 2605,
+  [SMALL_STATE(193)] = 2627,
+  [SMALL_STATE(194)] = 2634,
+  [SMALL_STATE(195)] = 2653,
+  [SMALL_STATE(196)] = 2672,
+  [SMALL_STATE(197)] = 2685,
+  [SMALL_STATE(198)] = 2704,
+  [SMALL_STATE(199)] = 2717,
+  [SMALL_STATE(200)] = 2739,
+  [SMALL_STATE(201)] = 2761,
+  [SMALL_STATE(202)] = 2768,
+  [SMALL_STATE(203)] = 2775,
+  [SMALL_STATE(204)] = 2788,
+  [SMALL_STATE(205)] = 2795,
+  [SMALL_STATE(206)] = 2817,
+  [SMALL_STATE(207)] = 2829,
+  [SMALL_STATE(208)] = 2838,
+  [SMALL_STATE(209)] = 2855,
+  [SMALL_STATE(210)] = 2877,
+  [SMALL_STATE(211)] = 2890,
+  [SMALL_STATE(212)] = 2901,
+  [SMALL_STATE(213)] = 2912,
+  [SMALL_STATE(214)] = 2925,
+  [SMALL_STATE(215)] = 2941,
+  [SMALL_STATE(216)] = 2987,
+  [SMALL_STATE(217)] = 2995,
+  [SMALL_STATE(218)] = 3003,
+  [SMALL_STATE(219)] = 3019,
+  [SMALL_STATE(220)] = 3035,
+  [SMALL_STATE(221)] = 3043,
+  [SMALL_STATE(222)] = 3056,
+  [SMALL_STATE(223)] = 3064,
+  [SMALL_STATE(224)] = 3072,
+  [SMALL_STATE(225)] = 3080,
+  [SMALL_STATE(226)] = 3088,
+  [SMALL_STATE(227)] = 3104,
+  [SMALL_STATE(228)] = 3126,
+  [SMALL_STATE(229)] = 3145,
+  [SMALL_STATE(230)] = 3185,
+  [SMALL_STATE(231)] = 3202,
+  [SMALL_STATE(232)] = 3210,
+  [SMALL_STATE(233)] = 3223,
+  [SMALL_STATE(234)] = 3236,
+  [SMALL_STATE(235)] = 3253,
+  [SMALL_STATE(236)] = 3272,
+  [SMALL_STATE(237)] = 3291,
+  [SMALL_STATE(238)] = 3304,
+  [SMALL_STATE(239)] = 3316,
+  [SMALL_STATE(240)] = 3353,
+  [SMALL_STATE(241)] = 3365,
+  [SMALL_STATE(242)] = 3375,
+  [SMALL_STATE(243)] = 3384,
+  [SMALL_STATE(244)] = 3421,
+  [SMALL_STATE(245)] = 3441,
+  [SMALL_STATE(246)] = 3458,
+  [SMALL_STATE(247)] = 3475,
+  [SMALL_STATE(248)] = 3492,
+  [SMALL_STATE(249)] = 3509,
+  [SMALL_STATE(250)] = 3534,
+  [SMALL_STATE(251)] = 3551,
+  [SMALL_STATE(252)] = 3568,
+  [SMALL_STATE(253)] = 3605,
+  [SMALL_STATE(254)] = 3618,
+  [SMALL_STATE(255)] = 3631,
+  [SMALL_STATE(256)] = 3648,
+  [SMALL_STATE(257)] = 3667,
+  [SMALL_STATE(258)] = 3677,
+  [SMALL_STATE(259)] = 3690,
+  [SMALL_STATE(260)] = 3703,
+  [SMALL_STATE(261)] = 3716,
+  [SMALL_STATE(262)] = 3729,
+  [SMALL_STATE(263)] = 3751,
+  [SMALL_STATE(264)] = 3773,
+  [SMALL_STATE(265)] = 3791,
+  [SMALL_STATE(266)] = 3804,
+  [SMALL_STATE(267)] = 3813,
+  [SMALL_STATE(268)] = 3826,
+  [SMALL_STATE(269)] = 3848,
+  [SMALL_STATE(270)] = 3865,
+  [SMALL_STATE(271)] = 3878,
+  [SMALL_STATE(272)] = 3891,
+  [SMALL_STATE(273)] = 3898,
+  [SMALL_STATE(274)] = 3906,
+  [SMALL_STATE(275)] = 3913,
+  [SMALL_STATE(276)] = 3926,
+  [SMALL_STATE(277)] = 3934,
+  [SMALL_STATE(278)] = 3947,
+  [SMALL_STATE(279)] = 3955,
+  [SMALL_STATE(280)] = 3968,
+  [SMALL_STATE(281)] = 3985,
+  [SMALL_STATE(282)] = 3998,
   [SMALL_STATE(283)] = 4015,
-  [SMALL_STATE(284)] = 4022,
-  [SMALL_STATE(285)] = 4029,
-  [SMALL_STATE(286)] = 4036,
-  [SMALL_STATE(287)] = 4043,
-  [SMALL_STATE(288)] = 4050,
-  [SMALL_STATE(289)] = 4057,
-  [SMALL_STATE(290)] = 4064,
-  [SMALL_STATE(291)] = 4071,
-  [SMALL_STATE(292)] = 4078,
-  [SMALL_STATE(293)] = 4085,
-  [SMALL_STATE(294)] = 4092,
-  [SMALL_STATE(295)] = 4099,
-  [SMALL_STATE(296)] = 4106,
-  [SMALL_STATE(297)] = 4113,
-  [SMALL_STATE(298)] = 4120,
-  [SMALL_STATE(299)] = 4127,
-  [SMALL_STATE(300)] = 4134,
-  [SMALL_STATE(301)] = 4141,
-  [SMALL_STATE(302)] = 4148,
-  [SMALL_STATE(303)] = 4155,
-  [SMALL_STATE(304)] = 4162,
-  [SMALL_STATE(305)] = 4169,
-  [SMALL_STATE(306)] = 4176,
-  [SMALL_STATE(307)] = 4183,
-  [SMALL_STATE(308)] = 4190,
-  [SMALL_STATE(309)] = 4197,
-  [SMALL_STATE(310)] = 4204,
-  [SMALL_STATE(311)] = 4211,
-  [SMALL_STATE(312)] = 4218,
-  [SMALL_STATE(313)] = 4225,
-  [SMALL_STATE(314)] = 4232,
+  [SMALL_STATE(284)] = 4032,
+  [SMALL_STATE(285)] = 4040,
+  [SMALL_STATE(286)] = 4053,
+  [SMALL_STATE(287)] = 4090,
+  [SMALL_STATE(288)] = 4112,
+  [SMALL_STATE(289)] = 4131,
+  [SMALL_STATE(290)] = 4139,
+  [SMALL_STATE(291)] = 4152,
+  [SMALL_STATE(292)] = 4165,
+  [SMALL_STATE(293)] = 4182,
+  [SMALL_STATE(294)] = 4199,
+  [SMALL_STATE(295)] = 4212,
+  [SMALL_STATE(296)] = 4224,
+  [SMALL_STATE(297)] = 4233,
+  [SMALL_STATE(298)] = 4250,
+  [SMALL_STATE(299)] = 4272,
+  [SMALL_STATE(300)] = 4285,
+  [SMALL_STATE(301)] = 4307,
+  [SMALL_STATE(302)] = 4326,
+  [SMALL_STATE(303)] = 4339,
+  [SMALL_STATE(304)] = 4352,
+  [SMALL_STATE(305)] = 4369,
+  [SMALL_STATE(306)] = 4388,
+  [SMALL_STATE(307)] = 4398,
+  [SMALL_STATE(308)] = 4411,
+  [SMALL_STATE(309)] = 4421,
+  [SMALL_STATE(310)] = 4431,
+  [SMALL_STATE(311)] = 4444,
+  [SMALL_STATE(312)] = 4454,
+  [SMALL_STATE(313)] = 4467,
+  [SMALL_STATE(314)] = 4480,
+  [SMALL_STATE(315)] = 4493,
+  [SMALL_STATE(316)] = 4506,
+  [SMALL_STATE(317)] = 4543,
+  [SMALL_STATE(318)] = 4561,
+  [SMALL_STATE(319)] = 4570,
+  [SMALL_STATE(320)] = 4580,
+  [SMALL_STATE(321)] = 4589,
+  [SMALL_STATE(322)] = 4602,
+  [SMALL_STATE(323)] = 4613,
+  [SMALL_STATE(324)] = 4629,
+  [SMALL_STATE(325)] = 4637,
+  [SMALL_STATE(326)] = 4674,
+  [SMALL_STATE(327)] = 4682,
+  [SMALL_STATE(328)] = 4692,
+  [SMALL_STATE(329)] = 4700,
+  [SMALL_STATE(330)] = 4708,
+  [SMALL_STATE(331)] = 4727,
+  [SMALL_STATE(332)] = 4735,
+  [SMALL_STATE(333)] = 4745,
+  [SMALL_STATE(334)] = 4753,
+  [SMALL_STATE(335)] = 4766,
+  [SMALL_STATE(336)] = 4783,
+  [SMALL_STATE(337)] = 4800,
+  [SMALL_STATE(338)] = 4822,
+  [SMALL_STATE(339)] = 4841,
+  [SMALL_STATE(340)] = 4878,
+  [SMALL_STATE(341)] = 4885,
+  [SMALL_STATE(342)] = 4897,
+  [SMALL_STATE(343)] = 4907,
+  [SMALL_STATE(344)] = 4924,
+  [SMALL_STATE(345)] = 4941,
+  [SMALL_STATE(346)] = 4954,
+  [SMALL_STATE(347)] = 4971,
+  [SMALL_STATE(348)] = 4988,
+  [SMALL_STATE(349)] = 5010,
+  [SMALL_STATE(350)] = 5029,
+  [SMALL_STATE(351)] = 5042,
+  [SMALL_STATE(352)] = 5055,
+  [SMALL_STATE(353)] = 5072,
+  [SMALL_STATE(354)] = 5082,
+  [SMALL_STATE(355)] = 5095,
+  [SMALL_STATE(356)] = 5105,
+  [SMALL_STATE(357)] = 5118,
+  [SMALL_STATE(358)] = 5128,
+  [SMALL_STATE(359)] = 5141,
+  [SMALL_STATE(360)] = 5151,
+  [SMALL_STATE(361)] = 5161,
+  [SMALL_STATE(362)] = 5174,
+  [SMALL_STATE(363)] = 5184,
+  [SMALL_STATE(364)] = 5193,
+  [SMALL_STATE(365)] = 5230,
+  [SMALL_STATE(366)] = 5239,
+  [SMALL_STATE(367)] = 5248,
+  [SMALL_STATE(368)] = 5258,
+  [SMALL_STATE(369)] = 5271,
+  [SMALL_STATE(370)] = 5279,
+  [SMALL_STATE(371)] = 5296,
+  [SMALL_STATE(372)] = 5304,
+  [SMALL_STATE(373)] = 5323,
+  [SMALL_STATE(374)] = 5336,
+  [SMALL_STATE(375)] = 5353,
+  [SMALL_STATE(376)] = 5370,
+  [SMALL_STATE(377)] = 5378,
+  [SMALL_STATE(378)] = 5385,
+  [SMALL_STATE(379)] = 5392,
+  [SMALL_STATE(380)] = 5404,
+  [SMALL_STATE(381)] = 5423,
+  [SMALL_STATE(382)] = 5436,
+  [SMALL_STATE(383)] = 5453,
+  [SMALL_STATE(384)] = 5470,
+  [SMALL_STATE(385)] = 5492,
+  [SMALL_STATE(386)] = 5511,
+  [SMALL_STATE(387)] = 5521,
+  [SMALL_STATE(388)] = 5531,
+  [SMALL_STATE(389)] = 5541,
+  [SMALL_STATE(390)] = 5554,
+  [SMALL_STATE(391)] = 5564,
+  [SMALL_STATE(392)] = 5577,
+  [SMALL_STATE(393)] = 5586,
+  [SMALL_STATE(394)] = 5595,
+  [SMALL_STATE(395)] = 5603,
+  [SMALL_STATE(396)] = 5613,
+  [SMALL_STATE(397)] = 5630,
+  [SMALL_STATE(398)] = 5649,
+  [SMALL_STATE(399)] = 5686,
+  [SMALL_STATE(400)] = 5693,
+  [SMALL_STATE(401)] = 5710,
+  [SMALL_STATE(402)] = 5729,
+  [SMALL_STATE(403)] = 5742,
+  [SMALL_STATE(404)] = 5759,
+  [SMALL_STATE(405)] = 5776,
+  [SMALL_STATE(406)] = 5786,
+  [SMALL_STATE(407)] = 5796,
+  [SMALL_STATE(408)] = 5804,
+  [SMALL_STATE(409)] = 5821,
+  [SMALL_STATE(410)] = 5829,
+  [SMALL_STATE(411)] = 5866,
+  [SMALL_STATE(412)] = 5883,
+  [SMALL_STATE(413)] = 5902,
+  [SMALL_STATE(414)] = 5910,
 };
 
 static const TSParseActionEntry ts_parse_actions[] = {
@@ -5818,362 +8559,371 @@ static const TSParseActionEntry ts_parse_actions[] = {
   [1] = {.entry = {.count = 1, .reusable = false}}, RECOVER(),
   [3] = {.entry = {.count = 1, .reusable = true}}, SHIFT_EXTRA(),
   [5] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_file, 0),
-  [7] = {.entry = {.count = 1, .reusable = true}}, SHIFT(186),
-  [9] = {.entry = {.count = 1, .reusable = true}}, SHIFT(306),
-  [11] = {.entry = {.count = 1, .reusable = true}}, SHIFT(300),
-  [13] = {.entry = {.count = 1, .reusable = true}}, SHIFT(295),
-  [15] = {.entry = {.count = 1, .reusable = true}}, SHIFT(294),
-  [17] = {.entry = {.count = 1, .reusable = true}}, SHIFT(293),
-  [19] = {.entry = {.count = 1, .reusable = true}}, SHIFT(291),
-  [21] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_file_repeat1, 2),
-  [23] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_file_repeat1, 2), SHIFT_REPEAT(186),
-  [26] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_file_repeat1, 2), SHIFT_REPEAT(306),
-  [29] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_file_repeat1, 2), SHIFT_REPEAT(300),
-  [32] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_file_repeat1, 2), SHIFT_REPEAT(295),
-  [35] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_file_repeat1, 2), SHIFT_REPEAT(294),
-  [38] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_file_repeat1, 2), SHIFT_REPEAT(293),
-  [41] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_file_repeat1, 2), SHIFT_REPEAT(291),
-  [44] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_file, 1),
-  [46] = {.entry = {.count = 1, .reusable = true}}, SHIFT(298),
-  [48] = {.entry = {.count = 1, .reusable = true}}, SHIFT(4),
-  [50] = {.entry = {.count = 1, .reusable = true}}, SHIFT(236),
-  [52] = {.entry = {.count = 1, .reusable = false}}, SHIFT(193),
-  [54] = {.entry = {.count = 1, .reusable = true}}, SHIFT(136),
-  [56] = {.entry = {.count = 1, .reusable = true}}, SHIFT(137),
-  [58] = {.entry = {.count = 1, .reusable = true}}, SHIFT(192),
-  [60] = {.entry = {.count = 1, .reusable = false}}, SHIFT(284),
-  [62] = {.entry = {.count = 1, .reusable = true}}, SHIFT(253),
-  [64] = {.entry = {.count = 1, .reusable = true}}, SHIFT(193),
-  [66] = {.entry = {.count = 1, .reusable = true}}, SHIFT(256),
-  [68] = {.entry = {.count = 1, .reusable = true}}, SHIFT(12),
-  [70] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_stream_declaration_repeat1, 2),
-  [72] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_stream_declaration_repeat1, 2), SHIFT_REPEAT(291),
-  [75] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_annotation, 4, .production_id = 1),
-  [77] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_annotation, 6, .production_id = 11),
-  [79] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_annotation, 5, .production_id = 6),
-  [81] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type, 5),
-  [83] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_stream_declaration_repeat2, 2),
-  [85] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_stream_declaration_repeat2, 2), SHIFT_REPEAT(267),
-  [88] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_stream_declaration_repeat2, 2), SHIFT_REPEAT(268),
-  [91] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_stream_declaration_repeat2, 2), SHIFT_REPEAT(291),
-  [94] = {.entry = {.count = 1, .reusable = true}}, SHIFT(35),
-  [96] = {.entry = {.count = 1, .reusable = true}}, SHIFT(267),
-  [98] = {.entry = {.count = 1, .reusable = true}}, SHIFT(268),
-  [100] = {.entry = {.count = 1, .reusable = true}}, SHIFT(25),
-  [102] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type, 1),
-  [104] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type, 2),
-  [106] = {.entry = {.count = 1, .reusable = true}}, SHIFT(50),
-  [108] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type, 3),
-  [110] = {.entry = {.count = 1, .reusable = true}}, SHIFT(61),
-  [112] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flagset_declaration, 6, .production_id = 14),
-  [114] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_stream_declaration, 6, .production_id = 7),
-  [116] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 10, .production_id = 39),
-  [118] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flagset_declaration, 6, .production_id = 8),
-  [120] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flagset_declaration, 5, .production_id = 7),
-  [122] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_enum_declaration, 5, .production_id = 7),
-  [124] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 9, .production_id = 37),
-  [126] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 9, .production_id = 36),
-  [128] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_struct_declaration, 5, .production_id = 7),
-  [130] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 9, .production_id = 35),
-  [132] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 9, .production_id = 34),
-  [134] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_stream_declaration, 5, .production_id = 7),
-  [136] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_statement, 1),
-  [138] = {.entry = {.count = 1, .reusable = true}}, SHIFT(273),
-  [140] = {.entry = {.count = 1, .reusable = true}}, SHIFT(272),
-  [142] = {.entry = {.count = 1, .reusable = true}}, SHIFT(265),
-  [144] = {.entry = {.count = 1, .reusable = true}}, SHIFT(264),
-  [146] = {.entry = {.count = 1, .reusable = true}}, SHIFT(263),
-  [148] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 6, .production_id = 10),
-  [150] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 9, .production_id = 33),
-  [152] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 11, .production_id = 44),
-  [154] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 8, .production_id = 30),
-  [156] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 11, .production_id = 43),
-  [158] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 10, .production_id = 42),
-  [160] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 8, .production_id = 29),
-  [162] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 8, .production_id = 28),
-  [164] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flagset_declaration, 8, .production_id = 27),
-  [166] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 5, .production_id = 1),
-  [168] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_struct_declaration, 6, .production_id = 12),
-  [170] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 8, .production_id = 26),
-  [172] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_stream_declaration, 4, .production_id = 1),
-  [174] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 8, .production_id = 25),
-  [176] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flagset_declaration, 5, .production_id = 5),
-  [178] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_enum_declaration, 6, .production_id = 13),
-  [180] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_struct_declaration, 4, .production_id = 1),
-  [182] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_enum_declaration, 5, .production_id = 4),
-  [184] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 6, .production_id = 7),
-  [186] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_struct_declaration, 5, .production_id = 3),
-  [188] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_enum_declaration, 4, .production_id = 1),
-  [190] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 10, .production_id = 41),
-  [192] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 7, .production_id = 22),
-  [194] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_stream_declaration, 5, .production_id = 1),
-  [196] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flagset_declaration, 4, .production_id = 1),
-  [198] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flagset_declaration, 7, .production_id = 16),
-  [200] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import, 5, .production_id = 2),
-  [202] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 7, .production_id = 17),
-  [204] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 7, .production_id = 18),
-  [206] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 10, .production_id = 40),
-  [208] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flagset_declaration, 7, .production_id = 21),
-  [210] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 7, .production_id = 19),
-  [212] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 12, .production_id = 45),
-  [214] = {.entry = {.count = 1, .reusable = true}}, SHIFT(68),
-  [216] = {.entry = {.count = 1, .reusable = true}}, SHIFT(271),
-  [218] = {.entry = {.count = 1, .reusable = true}}, SHIFT(313),
-  [220] = {.entry = {.count = 1, .reusable = true}}, SHIFT(63),
-  [222] = {.entry = {.count = 1, .reusable = true}}, SHIFT(24),
-  [224] = {.entry = {.count = 1, .reusable = true}}, SHIFT(46),
-  [226] = {.entry = {.count = 1, .reusable = true}}, SHIFT(52),
-  [228] = {.entry = {.count = 1, .reusable = true}}, SHIFT(53),
-  [230] = {.entry = {.count = 1, .reusable = true}}, SHIFT(270),
-  [232] = {.entry = {.count = 1, .reusable = true}}, SHIFT(32),
-  [234] = {.entry = {.count = 1, .reusable = true}}, SHIFT(269),
-  [236] = {.entry = {.count = 1, .reusable = true}}, SHIFT(29),
-  [238] = {.entry = {.count = 1, .reusable = true}}, SHIFT(28),
-  [240] = {.entry = {.count = 1, .reusable = true}}, SHIFT(48),
-  [242] = {.entry = {.count = 1, .reusable = true}}, SHIFT(54),
-  [244] = {.entry = {.count = 1, .reusable = true}}, SHIFT(58),
-  [246] = {.entry = {.count = 1, .reusable = true}}, SHIFT(62),
-  [248] = {.entry = {.count = 1, .reusable = true}}, SHIFT(27),
-  [250] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_flagset_declaration_repeat1, 2),
-  [252] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_flagset_declaration_repeat1, 2), SHIFT_REPEAT(271),
-  [255] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_flagset_declaration_repeat1, 2), SHIFT_REPEAT(313),
-  [258] = {.entry = {.count = 1, .reusable = true}}, SHIFT(57),
-  [260] = {.entry = {.count = 1, .reusable = true}}, SHIFT(55),
-  [262] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_struct_declaration_repeat1, 2),
-  [264] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_struct_declaration_repeat1, 2), SHIFT_REPEAT(269),
-  [267] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_struct_declaration_repeat1, 2), SHIFT_REPEAT(291),
-  [270] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_enum_declaration_repeat1, 2),
-  [272] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_enum_declaration_repeat1, 2), SHIFT_REPEAT(270),
-  [275] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_enum_declaration_repeat1, 2), SHIFT_REPEAT(291),
-  [278] = {.entry = {.count = 1, .reusable = true}}, SHIFT(187),
-  [280] = {.entry = {.count = 1, .reusable = true}}, SHIFT(301),
-  [282] = {.entry = {.count = 1, .reusable = true}}, SHIFT(122),
-  [284] = {.entry = {.count = 1, .reusable = true}}, SHIFT(65),
-  [286] = {.entry = {.count = 1, .reusable = true}}, SHIFT(104),
-  [288] = {.entry = {.count = 1, .reusable = true}}, SHIFT(314),
-  [290] = {.entry = {.count = 1, .reusable = true}}, SHIFT(282),
-  [292] = {.entry = {.count = 1, .reusable = true}}, SHIFT(177),
-  [294] = {.entry = {.count = 1, .reusable = true}}, SHIFT(208),
-  [296] = {.entry = {.count = 1, .reusable = true}}, SHIFT(175),
-  [298] = {.entry = {.count = 1, .reusable = true}}, SHIFT(220),
-  [300] = {.entry = {.count = 1, .reusable = true}}, SHIFT(51),
-  [302] = {.entry = {.count = 1, .reusable = true}}, SHIFT(200),
-  [304] = {.entry = {.count = 1, .reusable = true}}, SHIFT(59),
-  [306] = {.entry = {.count = 1, .reusable = true}}, SHIFT(183),
-  [308] = {.entry = {.count = 1, .reusable = true}}, SHIFT(126),
-  [310] = {.entry = {.count = 1, .reusable = true}}, SHIFT(229),
-  [312] = {.entry = {.count = 1, .reusable = true}}, SHIFT(45),
-  [314] = {.entry = {.count = 1, .reusable = true}}, SHIFT(210),
-  [316] = {.entry = {.count = 1, .reusable = true}}, SHIFT(215),
-  [318] = {.entry = {.count = 1, .reusable = true}}, SHIFT(237),
-  [320] = {.entry = {.count = 1, .reusable = true}}, SHIFT(34),
-  [322] = {.entry = {.count = 1, .reusable = true}}, SHIFT(231),
-  [324] = {.entry = {.count = 1, .reusable = true}}, SHIFT(31),
-  [326] = {.entry = {.count = 1, .reusable = true}}, SHIFT(168),
-  [328] = {.entry = {.count = 1, .reusable = true}}, SHIFT(167),
-  [330] = {.entry = {.count = 1, .reusable = true}}, SHIFT(47),
-  [332] = {.entry = {.count = 1, .reusable = true}}, SHIFT(26),
-  [334] = {.entry = {.count = 1, .reusable = true}}, SHIFT(33),
-  [336] = {.entry = {.count = 1, .reusable = true}}, SHIFT(223),
-  [338] = {.entry = {.count = 1, .reusable = true}}, SHIFT(225),
-  [340] = {.entry = {.count = 1, .reusable = true}}, SHIFT(38),
-  [342] = {.entry = {.count = 1, .reusable = true}}, SHIFT(30),
-  [344] = {.entry = {.count = 1, .reusable = true}}, SHIFT(194),
-  [346] = {.entry = {.count = 1, .reusable = true}}, SHIFT(195),
-  [348] = {.entry = {.count = 1, .reusable = true}}, SHIFT(69),
-  [350] = {.entry = {.count = 1, .reusable = true}}, SHIFT(234),
-  [352] = {.entry = {.count = 1, .reusable = true}}, SHIFT(235),
-  [354] = {.entry = {.count = 1, .reusable = true}}, SHIFT(41),
-  [356] = {.entry = {.count = 1, .reusable = true}}, SHIFT(66),
-  [358] = {.entry = {.count = 1, .reusable = true}}, SHIFT(49),
-  [360] = {.entry = {.count = 1, .reusable = true}}, SHIFT(44),
-  [362] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_declaration, 4, .production_id = 15),
-  [364] = {.entry = {.count = 1, .reusable = true}}, SHIFT(304),
-  [366] = {.entry = {.count = 1, .reusable = true}}, SHIFT(20),
-  [368] = {.entry = {.count = 1, .reusable = true}}, SHIFT(42),
-  [370] = {.entry = {.count = 1, .reusable = true}}, SHIFT(70),
-  [372] = {.entry = {.count = 1, .reusable = true}}, SHIFT(67),
-  [374] = {.entry = {.count = 1, .reusable = true}}, SHIFT(39),
-  [376] = {.entry = {.count = 1, .reusable = true}}, SHIFT(302),
-  [378] = {.entry = {.count = 1, .reusable = true}}, SHIFT(305),
-  [380] = {.entry = {.count = 1, .reusable = true}}, SHIFT(43),
-  [382] = {.entry = {.count = 1, .reusable = true}}, SHIFT(40),
-  [384] = {.entry = {.count = 1, .reusable = true}}, SHIFT(171),
-  [386] = {.entry = {.count = 1, .reusable = true}}, SHIFT(172),
-  [388] = {.entry = {.count = 1, .reusable = true}}, SHIFT(56),
-  [390] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_declaration, 5, .production_id = 23),
-  [392] = {.entry = {.count = 1, .reusable = true}}, SHIFT(201),
-  [394] = {.entry = {.count = 1, .reusable = true}}, SHIFT(202),
-  [396] = {.entry = {.count = 1, .reusable = true}}, SHIFT(60),
-  [398] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_annotation_repeat1, 2), SHIFT_REPEAT(11),
-  [401] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_annotation_repeat1, 2),
-  [403] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_arg, 4, .production_id = 20),
-  [405] = {.entry = {.count = 1, .reusable = true}}, SHIFT(169),
-  [407] = {.entry = {.count = 1, .reusable = true}}, SHIFT(22),
-  [409] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_stream_declaration_repeat1, 2), SHIFT_REPEAT(314),
-  [412] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_stream_declaration_repeat1, 2), SHIFT_REPEAT(313),
-  [415] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_case_declaration, 3, .production_id = 7),
-  [417] = {.entry = {.count = 1, .reusable = true}}, SHIFT(101),
-  [419] = {.entry = {.count = 1, .reusable = false}}, SHIFT(145),
-  [421] = {.entry = {.count = 1, .reusable = false}}, SHIFT_EXTRA(),
-  [423] = {.entry = {.count = 1, .reusable = true}}, SHIFT(146),
-  [425] = {.entry = {.count = 1, .reusable = true}}, SHIFT(147),
-  [427] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_signal_declaration, 8, .production_id = 30),
-  [429] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_event_declaration, 8, .production_id = 30),
-  [431] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_signal_declaration, 7, .production_id = 22),
-  [433] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_event_declaration, 7, .production_id = 22),
-  [435] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_signal_declaration, 7, .production_id = 19),
-  [437] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_arg, 3, .production_id = 9),
-  [439] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_event_declaration, 7, .production_id = 19),
-  [441] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_string, 2),
-  [443] = {.entry = {.count = 1, .reusable = false}}, SHIFT(152),
-  [445] = {.entry = {.count = 1, .reusable = true}}, SHIFT(153),
-  [447] = {.entry = {.count = 1, .reusable = true}}, SHIFT(154),
-  [449] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_signal_declaration, 6, .production_id = 7),
-  [451] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_event_declaration, 6, .production_id = 7),
-  [453] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_signal_declaration, 6, .production_id = 10),
-  [455] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_event_declaration, 6, .production_id = 10),
-  [457] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_string, 3),
-  [459] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_string_repeat1, 2),
-  [461] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_string_repeat1, 2), SHIFT_REPEAT(153),
-  [464] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_string_repeat2, 2),
-  [466] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_string_repeat2, 2), SHIFT_REPEAT(154),
-  [469] = {.entry = {.count = 1, .reusable = true}}, SHIFT(308),
-  [471] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_signal_declaration, 5, .production_id = 1),
-  [473] = {.entry = {.count = 1, .reusable = true}}, SHIFT(217),
-  [475] = {.entry = {.count = 1, .reusable = true}}, SHIFT(125),
-  [477] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_case_declaration, 2, .production_id = 1),
-  [479] = {.entry = {.count = 1, .reusable = true}}, SHIFT(97),
-  [481] = {.entry = {.count = 1, .reusable = true}}, SHIFT(311),
-  [483] = {.entry = {.count = 1, .reusable = true}}, SHIFT(310),
-  [485] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_event_declaration, 5, .production_id = 1),
-  [487] = {.entry = {.count = 1, .reusable = true}}, SHIFT(266),
-  [489] = {.entry = {.count = 1, .reusable = true}}, SHIFT(64),
-  [491] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_dictionary, 3),
-  [493] = {.entry = {.count = 1, .reusable = true}}, SHIFT(107),
-  [495] = {.entry = {.count = 1, .reusable = true}}, SHIFT(110),
-  [497] = {.entry = {.count = 1, .reusable = true}}, SHIFT(173),
-  [499] = {.entry = {.count = 1, .reusable = true}}, SHIFT(19),
-  [501] = {.entry = {.count = 1, .reusable = true}}, SHIFT(199),
-  [503] = {.entry = {.count = 1, .reusable = true}}, SHIFT(116),
-  [505] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flag_declaration, 2, .production_id = 1),
-  [507] = {.entry = {.count = 1, .reusable = true}}, SHIFT(111),
-  [509] = {.entry = {.count = 1, .reusable = true}}, SHIFT(161),
-  [511] = {.entry = {.count = 1, .reusable = true}}, SHIFT(204),
-  [513] = {.entry = {.count = 1, .reusable = true}}, SHIFT(156),
-  [515] = {.entry = {.count = 1, .reusable = true}}, SHIFT(206),
-  [517] = {.entry = {.count = 1, .reusable = true}}, SHIFT(11),
-  [519] = {.entry = {.count = 1, .reusable = true}}, SHIFT(251),
-  [521] = {.entry = {.count = 1, .reusable = true}}, SHIFT(252),
-  [523] = {.entry = {.count = 1, .reusable = true}}, SHIFT(15),
-  [525] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_case_declaration, 4, .production_id = 1),
-  [527] = {.entry = {.count = 1, .reusable = true}}, SHIFT(213),
-  [529] = {.entry = {.count = 1, .reusable = true}}, SHIFT(254),
-  [531] = {.entry = {.count = 1, .reusable = true}}, SHIFT(255),
-  [533] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flag_declaration, 3, .production_id = 7),
-  [535] = {.entry = {.count = 1, .reusable = true}}, SHIFT(129),
-  [537] = {.entry = {.count = 1, .reusable = true}}, SHIFT(14),
-  [539] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_literal, 1),
-  [541] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_bool, 1),
-  [543] = {.entry = {.count = 1, .reusable = true}}, SHIFT(262),
-  [545] = {.entry = {.count = 1, .reusable = true}}, SHIFT(226),
-  [547] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_list, 4),
-  [549] = {.entry = {.count = 1, .reusable = true}}, SHIFT(108),
-  [551] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_case_declaration, 7, .production_id = 38),
-  [553] = {.entry = {.count = 1, .reusable = true}}, SHIFT(151),
-  [555] = {.entry = {.count = 1, .reusable = true}}, SHIFT(238),
-  [557] = {.entry = {.count = 1, .reusable = true}}, SHIFT(150),
-  [559] = {.entry = {.count = 1, .reusable = true}}, SHIFT(240),
-  [561] = {.entry = {.count = 1, .reusable = true}}, SHIFT(149),
-  [563] = {.entry = {.count = 1, .reusable = true}}, SHIFT(242),
-  [565] = {.entry = {.count = 1, .reusable = true}}, SHIFT(148),
-  [567] = {.entry = {.count = 1, .reusable = true}}, SHIFT(245),
-  [569] = {.entry = {.count = 1, .reusable = true}}, SHIFT(138),
-  [571] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_case_declaration, 5, .production_id = 24),
-  [573] = {.entry = {.count = 1, .reusable = true}}, SHIFT(247),
-  [575] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_case_declaration, 5, .production_id = 7),
-  [577] = {.entry = {.count = 1, .reusable = true}}, SHIFT(248),
-  [579] = {.entry = {.count = 1, .reusable = true}}, SHIFT(13),
-  [581] = {.entry = {.count = 1, .reusable = true}}, SHIFT(139),
-  [583] = {.entry = {.count = 1, .reusable = true}}, SHIFT(230),
-  [585] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_dictionary, 5),
-  [587] = {.entry = {.count = 1, .reusable = true}}, SHIFT(233),
-  [589] = {.entry = {.count = 1, .reusable = true}}, SHIFT(197),
-  [591] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_list, 3),
-  [593] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_dictionary_repeat1, 2), SHIFT_REPEAT(262),
-  [596] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_dictionary_repeat1, 2),
-  [598] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_dictionary, 6),
-  [600] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_list, 2),
-  [602] = {.entry = {.count = 1, .reusable = true}}, SHIFT(144),
-  [604] = {.entry = {.count = 1, .reusable = true}}, SHIFT(127),
-  [606] = {.entry = {.count = 1, .reusable = true}}, SHIFT(142),
-  [608] = {.entry = {.count = 1, .reusable = true}}, SHIFT(141),
-  [610] = {.entry = {.count = 1, .reusable = true}}, SHIFT(221),
-  [612] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_case_declaration_repeat1, 2), SHIFT_REPEAT(107),
-  [615] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_case_declaration_repeat1, 2),
-  [617] = {.entry = {.count = 1, .reusable = true}}, SHIFT(140),
-  [619] = {.entry = {.count = 1, .reusable = true}}, SHIFT(212),
-  [621] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_case_declaration, 6, .production_id = 31),
-  [623] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_case_declaration, 6, .production_id = 32),
-  [625] = {.entry = {.count = 1, .reusable = true}}, SHIFT(203),
-  [627] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_dictionary_repeat1, 4),
-  [629] = {.entry = {.count = 1, .reusable = true}}, SHIFT(79),
-  [631] = {.entry = {.count = 1, .reusable = true}}, SHIFT(285),
-  [633] = {.entry = {.count = 1, .reusable = true}}, SHIFT(83),
-  [635] = {.entry = {.count = 1, .reusable = true}}, SHIFT(299),
-  [637] = {.entry = {.count = 1, .reusable = true}}, SHIFT(100),
-  [639] = {.entry = {.count = 1, .reusable = true}},  ACCEPT_INPUT(),
-  [641] = {.entry = {.count = 1, .reusable = true}}, SHIFT(290),
-  [643] = {.entry = {.count = 1, .reusable = true}}, SHIFT(287),
-  [645] = {.entry = {.count = 1, .reusable = true}}, SHIFT(258),
-  [647] = {.entry = {.count = 1, .reusable = true}}, SHIFT(289),
-  [649] = {.entry = {.count = 1, .reusable = true}}, SHIFT(241),
-  [651] = {.entry = {.count = 1, .reusable = true}}, SHIFT(288),
-  [653] = {.entry = {.count = 1, .reusable = true}}, SHIFT(286),
-  [655] = {.entry = {.count = 1, .reusable = true}}, SHIFT(307),
-  [657] = {.entry = {.count = 1, .reusable = true}}, SHIFT(158),
-  [659] = {.entry = {.count = 1, .reusable = true}}, SHIFT(170),
-  [661] = {.entry = {.count = 1, .reusable = true}}, SHIFT(292),
-  [663] = {.entry = {.count = 1, .reusable = true}}, SHIFT(276),
-  [665] = {.entry = {.count = 1, .reusable = true}}, SHIFT(7),
-  [667] = {.entry = {.count = 1, .reusable = true}}, SHIFT(91),
-  [669] = {.entry = {.count = 1, .reusable = true}}, SHIFT(17),
-  [671] = {.entry = {.count = 1, .reusable = true}}, SHIFT(82),
-  [673] = {.entry = {.count = 1, .reusable = true}}, SHIFT(84),
-  [675] = {.entry = {.count = 1, .reusable = true}}, SHIFT(81),
-  [677] = {.entry = {.count = 1, .reusable = true}}, SHIFT(21),
-  [679] = {.entry = {.count = 1, .reusable = true}}, SHIFT(71),
-  [681] = {.entry = {.count = 1, .reusable = true}}, SHIFT(166),
-  [683] = {.entry = {.count = 1, .reusable = true}}, SHIFT(297),
-  [685] = {.entry = {.count = 1, .reusable = true}}, SHIFT(9),
-  [687] = {.entry = {.count = 1, .reusable = true}}, SHIFT(281),
-  [689] = {.entry = {.count = 1, .reusable = true}}, SHIFT(92),
-  [691] = {.entry = {.count = 1, .reusable = true}}, SHIFT(98),
-  [693] = {.entry = {.count = 1, .reusable = true}}, SHIFT(94),
-  [695] = {.entry = {.count = 1, .reusable = true}}, SHIFT(78),
-  [697] = {.entry = {.count = 1, .reusable = true}}, SHIFT(10),
-  [699] = {.entry = {.count = 1, .reusable = true}}, SHIFT(274),
-  [701] = {.entry = {.count = 1, .reusable = true}}, SHIFT(77),
-  [703] = {.entry = {.count = 1, .reusable = true}}, SHIFT(275),
-  [705] = {.entry = {.count = 1, .reusable = true}}, SHIFT(259),
-  [707] = {.entry = {.count = 1, .reusable = true}}, SHIFT(277),
-  [709] = {.entry = {.count = 1, .reusable = true}}, SHIFT(93),
-  [711] = {.entry = {.count = 1, .reusable = true}}, SHIFT(163),
-  [713] = {.entry = {.count = 1, .reusable = true}}, SHIFT(164),
-  [715] = {.entry = {.count = 1, .reusable = true}}, SHIFT(278),
-  [717] = {.entry = {.count = 1, .reusable = true}}, SHIFT(279),
-  [719] = {.entry = {.count = 1, .reusable = true}}, SHIFT(296),
-  [721] = {.entry = {.count = 1, .reusable = true}}, SHIFT(182),
-  [723] = {.entry = {.count = 1, .reusable = true}}, SHIFT(260),
-  [725] = {.entry = {.count = 1, .reusable = true}}, SHIFT(280),
-  [727] = {.entry = {.count = 1, .reusable = true}}, SHIFT(218),
-  [729] = {.entry = {.count = 1, .reusable = true}}, SHIFT(303),
-  [731] = {.entry = {.count = 1, .reusable = true}}, SHIFT(6),
-  [733] = {.entry = {.count = 1, .reusable = true}}, SHIFT(135),
-  [735] = {.entry = {.count = 1, .reusable = true}}, SHIFT(189),
-  [737] = {.entry = {.count = 1, .reusable = true}}, SHIFT(5),
-  [739] = {.entry = {.count = 1, .reusable = true}}, SHIFT(309),
-  [741] = {.entry = {.count = 1, .reusable = true}}, SHIFT(312),
+  [7] = {.entry = {.count = 1, .reusable = true}}, SHIFT(2),
+  [9] = {.entry = {.count = 1, .reusable = true}}, SHIFT(3),
+  [11] = {.entry = {.count = 1, .reusable = true}}, SHIFT(4),
+  [13] = {.entry = {.count = 1, .reusable = true}}, SHIFT(5),
+  [15] = {.entry = {.count = 1, .reusable = true}}, SHIFT(6),
+  [17] = {.entry = {.count = 1, .reusable = true}}, SHIFT(7),
+  [19] = {.entry = {.count = 1, .reusable = true}}, SHIFT(8),
+  [21] = {.entry = {.count = 1, .reusable = true}}, SHIFT(9),
+  [23] = {.entry = {.count = 1, .reusable = true}}, SHIFT(10),
+  [25] = {.entry = {.count = 1, .reusable = true}}, SHIFT(11),
+  [27] = {.entry = {.count = 1, .reusable = true}}, SHIFT(25),
+  [29] = {.entry = {.count = 1, .reusable = true}}, SHIFT(26),
+  [31] = {.entry = {.count = 1, .reusable = true}}, SHIFT(28),
+  [33] = {.entry = {.count = 1, .reusable = true}}, SHIFT(29),
+  [35] = {.entry = {.count = 1, .reusable = true}}, SHIFT(30),
+  [37] = {.entry = {.count = 1, .reusable = true}}, SHIFT(31),
+  [39] = {.entry = {.count = 1, .reusable = true}}, SHIFT(32),
+  [41] = {.entry = {.count = 1, .reusable = true}}, SHIFT(33),
+  [43] = {.entry = {.count = 1, .reusable = true}}, SHIFT(34),
+  [45] = {.entry = {.count = 1, .reusable = true}}, SHIFT(35),
+  [47] = {.entry = {.count = 1, .reusable = true}}, SHIFT(36),
+  [49] = {.entry = {.count = 1, .reusable = true}}, ACCEPT_INPUT(),
+  [51] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_file_repeat1, 1),
+  [53] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_statement, 1),
+  [55] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_stream_declaration_repeat1, 1),
+  [57] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_file, 1),
+  [59] = {.entry = {.count = 1, .reusable = true}}, SHIFT(38),
+  [61] = {.entry = {.count = 1, .reusable = true}}, SHIFT(39),
+  [63] = {.entry = {.count = 1, .reusable = true}}, SHIFT(40),
+  [65] = {.entry = {.count = 1, .reusable = true}}, SHIFT(41),
+  [67] = {.entry = {.count = 1, .reusable = true}}, SHIFT(42),
+  [69] = {.entry = {.count = 1, .reusable = true}}, SHIFT(43),
+  [71] = {.entry = {.count = 1, .reusable = true}}, SHIFT(44),
+  [73] = {.entry = {.count = 1, .reusable = true}}, SHIFT(45),
+  [75] = {.entry = {.count = 1, .reusable = true}}, SHIFT(47),
+  [77] = {.entry = {.count = 1, .reusable = true}}, SHIFT(48),
+  [79] = {.entry = {.count = 1, .reusable = true}}, SHIFT(49),
+  [81] = {.entry = {.count = 1, .reusable = true}}, SHIFT(51),
+  [83] = {.entry = {.count = 1, .reusable = true}}, SHIFT(52),
+  [85] = {.entry = {.count = 1, .reusable = true}}, SHIFT(53),
+  [87] = {.entry = {.count = 1, .reusable = true}}, SHIFT(55),
+  [89] = {.entry = {.count = 1, .reusable = true}}, SHIFT(56),
+  [91] = {.entry = {.count = 1, .reusable = true}}, SHIFT(57),
+  [93] = {.entry = {.count = 1, .reusable = true}}, SHIFT(58),
+  [95] = {.entry = {.count = 1, .reusable = true}}, SHIFT(59),
+  [97] = {.entry = {.count = 1, .reusable = true}}, SHIFT(60),
+  [99] = {.entry = {.count = 1, .reusable = true}}, SHIFT(61),
+  [101] = {.entry = {.count = 1, .reusable = true}}, SHIFT(62),
+  [103] = {.entry = {.count = 1, .reusable = true}}, SHIFT(63),
+  [105] = {.entry = {.count = 1, .reusable = true}}, SHIFT(64),
+  [107] = {.entry = {.count = 1, .reusable = true}}, SHIFT(65),
+  [109] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_file_repeat1, 2),
+  [111] = {.entry = {.count = 1, .reusable = true}}, SHIFT(66),
+  [113] = {.entry = {.count = 1, .reusable = true}}, SHIFT(67),
+  [115] = {.entry = {.count = 1, .reusable = true}}, SHIFT(68),
+  [117] = {.entry = {.count = 1, .reusable = true}}, SHIFT(69),
+  [119] = {.entry = {.count = 1, .reusable = true}}, SHIFT(70),
+  [121] = {.entry = {.count = 1, .reusable = true}}, SHIFT(71),
+  [123] = {.entry = {.count = 1, .reusable = true}}, SHIFT(72),
+  [125] = {.entry = {.count = 1, .reusable = true}}, SHIFT(73),
+  [127] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_stream_declaration_repeat1, 2),
+  [129] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_string, 2),
+  [131] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_string_repeat1, 1),
+  [133] = {.entry = {.count = 1, .reusable = true}}, SHIFT(74),
+  [135] = {.entry = {.count = 1, .reusable = true}}, SHIFT(75),
+  [137] = {.entry = {.count = 1, .reusable = true}}, SHIFT(76),
+  [139] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_string_repeat2, 1),
+  [141] = {.entry = {.count = 1, .reusable = true}}, SHIFT(77),
+  [143] = {.entry = {.count = 1, .reusable = true}}, SHIFT(78),
+  [145] = {.entry = {.count = 1, .reusable = true}}, SHIFT(79),
+  [147] = {.entry = {.count = 1, .reusable = true}}, SHIFT(80),
+  [149] = {.entry = {.count = 1, .reusable = true}}, SHIFT(81),
+  [151] = {.entry = {.count = 1, .reusable = true}}, SHIFT(82),
+  [153] = {.entry = {.count = 1, .reusable = true}}, SHIFT(83),
+  [155] = {.entry = {.count = 1, .reusable = true}}, SHIFT(88),
+  [157] = {.entry = {.count = 1, .reusable = true}}, SHIFT(89),
+  [159] = {.entry = {.count = 1, .reusable = true}}, SHIFT(93),
+  [161] = {.entry = {.count = 1, .reusable = true}}, SHIFT(94),
+  [163] = {.entry = {.count = 1, .reusable = true}}, SHIFT(98),
+  [165] = {.entry = {.count = 1, .reusable = true}}, SHIFT(99),
+  [167] = {.entry = {.count = 1, .reusable = true}}, SHIFT(103),
+  [169] = {.entry = {.count = 1, .reusable = true}}, SHIFT(104),
+  [171] = {.entry = {.count = 1, .reusable = true}}, SHIFT(105),
+  [173] = {.entry = {.count = 1, .reusable = true}}, SHIFT(108),
+  [175] = {.entry = {.count = 1, .reusable = true}}, SHIFT(109),
+  [177] = {.entry = {.count = 1, .reusable = true}}, SHIFT(111),
+  [179] = {.entry = {.count = 1, .reusable = true}}, SHIFT(112),
+  [181] = {.entry = {.count = 1, .reusable = true}}, SHIFT(113),
+  [183] = {.entry = {.count = 1, .reusable = true}}, SHIFT(117),
+  [185] = {.entry = {.count = 1, .reusable = true}}, SHIFT(118),
+  [187] = {.entry = {.count = 1, .reusable = true}}, SHIFT(119),
+  [189] = {.entry = {.count = 1, .reusable = true}}, SHIFT(120),
+  [191] = {.entry = {.count = 1, .reusable = true}}, SHIFT(121),
+  [193] = {.entry = {.count = 1, .reusable = true}}, SHIFT(127),
+  [195] = {.entry = {.count = 1, .reusable = true}}, SHIFT(128),
+  [197] = {.entry = {.count = 1, .reusable = true}}, SHIFT(129),
+  [199] = {.entry = {.count = 1, .reusable = true}}, SHIFT(130),
+  [201] = {.entry = {.count = 1, .reusable = true}}, SHIFT(131),
+  [203] = {.entry = {.count = 1, .reusable = true}}, SHIFT(132),
+  [205] = {.entry = {.count = 1, .reusable = true}}, SHIFT(133),
+  [207] = {.entry = {.count = 1, .reusable = true}}, SHIFT(134),
+  [209] = {.entry = {.count = 1, .reusable = true}}, SHIFT(135),
+  [211] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_string, 3),
+  [213] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_string_repeat1, 2),
+  [215] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_string_repeat2, 2),
+  [217] = {.entry = {.count = 1, .reusable = true}}, SHIFT(136),
+  [219] = {.entry = {.count = 1, .reusable = true}}, SHIFT(137),
+  [221] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_stream_declaration, 4, .production_id = 3),
+  [223] = {.entry = {.count = 1, .reusable = true}}, SHIFT(139),
+  [225] = {.entry = {.count = 1, .reusable = true}}, SHIFT(140),
+  [227] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_stream_declaration_repeat2, 1),
+  [229] = {.entry = {.count = 1, .reusable = true}}, SHIFT(141),
+  [231] = {.entry = {.count = 1, .reusable = true}}, SHIFT(142),
+  [233] = {.entry = {.count = 1, .reusable = true}}, SHIFT(143),
+  [235] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_struct_declaration, 4, .production_id = 3),
+  [237] = {.entry = {.count = 1, .reusable = true}}, SHIFT(146),
+  [239] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_struct_declaration_repeat1, 1),
+  [241] = {.entry = {.count = 1, .reusable = true}}, SHIFT(147),
+  [243] = {.entry = {.count = 1, .reusable = true}}, SHIFT(148),
+  [245] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_enum_declaration, 4, .production_id = 3),
+  [247] = {.entry = {.count = 1, .reusable = true}}, SHIFT(150),
+  [249] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_enum_declaration_repeat1, 1),
+  [251] = {.entry = {.count = 1, .reusable = true}}, SHIFT(151),
+  [253] = {.entry = {.count = 1, .reusable = true}}, SHIFT(152),
+  [255] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flagset_declaration, 4, .production_id = 3),
+  [257] = {.entry = {.count = 1, .reusable = true}}, SHIFT(154),
+  [259] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_flagset_declaration_repeat1, 1),
+  [261] = {.entry = {.count = 1, .reusable = true}}, SHIFT(155),
+  [263] = {.entry = {.count = 1, .reusable = true}}, SHIFT(156),
+  [265] = {.entry = {.count = 1, .reusable = true}}, SHIFT(158),
+  [267] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type, 1),
+  [269] = {.entry = {.count = 1, .reusable = true}}, SHIFT(160),
+  [271] = {.entry = {.count = 1, .reusable = true}}, SHIFT(161),
+  [273] = {.entry = {.count = 1, .reusable = true}}, SHIFT(165),
+  [275] = {.entry = {.count = 1, .reusable = true}}, SHIFT(166),
+  [277] = {.entry = {.count = 1, .reusable = true}}, SHIFT(167),
+  [279] = {.entry = {.count = 1, .reusable = true}}, SHIFT(168),
+  [281] = {.entry = {.count = 1, .reusable = true}}, SHIFT(169),
+  [283] = {.entry = {.count = 1, .reusable = true}}, SHIFT(171),
+  [285] = {.entry = {.count = 1, .reusable = true}}, SHIFT(172),
+  [287] = {.entry = {.count = 1, .reusable = true}}, SHIFT(173),
+  [289] = {.entry = {.count = 1, .reusable = true}}, SHIFT(174),
+  [291] = {.entry = {.count = 1, .reusable = true}}, SHIFT(176),
+  [293] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_annotation, 4, .production_id = 3),
+  [295] = {.entry = {.count = 1, .reusable = true}}, SHIFT(178),
+  [297] = {.entry = {.count = 1, .reusable = true}}, SHIFT(179),
+  [299] = {.entry = {.count = 1, .reusable = true}}, SHIFT(180),
+  [301] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_bool, 1),
+  [303] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_literal, 1),
+  [305] = {.entry = {.count = 1, .reusable = true}}, SHIFT(182),
+  [307] = {.entry = {.count = 1, .reusable = true}}, SHIFT(183),
+  [309] = {.entry = {.count = 1, .reusable = true}}, SHIFT(185),
+  [311] = {.entry = {.count = 1, .reusable = true}}, SHIFT(187),
+  [313] = {.entry = {.count = 1, .reusable = true}}, SHIFT(189),
+  [315] = {.entry = {.count = 1, .reusable = true}}, SHIFT(191),
+  [317] = {.entry = {.count = 1, .reusable = true}}, SHIFT(193),
+  [319] = {.entry = {.count = 1, .reusable = true}}, SHIFT(197),
+  [321] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__semicolon, 1),
+  [323] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import, 5, .production_id = 1),
+  [325] = {.entry = {.count = 1, .reusable = true}}, SHIFT(199),
+  [327] = {.entry = {.count = 1, .reusable = true}}, SHIFT(200),
+  [329] = {.entry = {.count = 1, .reusable = true}}, SHIFT(201),
+  [331] = {.entry = {.count = 1, .reusable = true}}, SHIFT(202),
+  [333] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_stream_declaration, 5, .production_id = 3),
+  [335] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_stream_declaration_repeat2, 2),
+  [337] = {.entry = {.count = 1, .reusable = true}}, SHIFT(203),
+  [339] = {.entry = {.count = 1, .reusable = true}}, SHIFT(204),
+  [341] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_struct_declaration, 5, .production_id = 5),
+  [343] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_struct_declaration_repeat1, 2),
+  [345] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_case_declaration, 2, .production_id = 3),
+  [347] = {.entry = {.count = 1, .reusable = true}}, SHIFT(205),
+  [349] = {.entry = {.count = 1, .reusable = true}}, SHIFT(206),
+  [351] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_enum_declaration, 5, .production_id = 11),
+  [353] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_enum_declaration_repeat1, 2),
+  [355] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flag_declaration, 2, .production_id = 3),
+  [357] = {.entry = {.count = 1, .reusable = true}}, SHIFT(207),
+  [359] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flagset_declaration, 5, .production_id = 15),
+  [361] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_flagset_declaration_repeat1, 2),
+  [363] = {.entry = {.count = 1, .reusable = true}}, SHIFT(208),
+  [365] = {.entry = {.count = 1, .reusable = true}}, SHIFT(210),
+  [367] = {.entry = {.count = 1, .reusable = true}}, SHIFT(211),
+  [369] = {.entry = {.count = 1, .reusable = true}}, SHIFT(212),
+  [371] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type, 2),
+  [373] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_typealias_declaration, 5, .production_id = 23),
+  [375] = {.entry = {.count = 1, .reusable = true}}, SHIFT(213),
+  [377] = {.entry = {.count = 1, .reusable = true}}, SHIFT(214),
+  [379] = {.entry = {.count = 1, .reusable = true}}, SHIFT(215),
+  [381] = {.entry = {.count = 1, .reusable = true}}, SHIFT(216),
+  [383] = {.entry = {.count = 1, .reusable = true}}, SHIFT(217),
+  [385] = {.entry = {.count = 1, .reusable = true}}, SHIFT(218),
+  [387] = {.entry = {.count = 1, .reusable = true}}, SHIFT(219),
+  [389] = {.entry = {.count = 1, .reusable = true}}, SHIFT(220),
+  [391] = {.entry = {.count = 1, .reusable = true}}, SHIFT(226),
+  [393] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 5, .production_id = 3),
+  [395] = {.entry = {.count = 1, .reusable = true}}, SHIFT(229),
+  [397] = {.entry = {.count = 1, .reusable = true}}, SHIFT(232),
+  [399] = {.entry = {.count = 1, .reusable = true}}, SHIFT(233),
+  [401] = {.entry = {.count = 1, .reusable = true}}, SHIFT(235),
+  [403] = {.entry = {.count = 1, .reusable = true}}, SHIFT(236),
+  [405] = {.entry = {.count = 1, .reusable = true}}, SHIFT(237),
+  [407] = {.entry = {.count = 1, .reusable = true}}, SHIFT(238),
+  [409] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_list, 2),
+  [411] = {.entry = {.count = 1, .reusable = true}}, SHIFT(239),
+  [413] = {.entry = {.count = 1, .reusable = true}}, SHIFT(240),
+  [415] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_annotation, 5, .production_id = 53),
+  [417] = {.entry = {.count = 1, .reusable = true}}, SHIFT(243),
+  [419] = {.entry = {.count = 1, .reusable = true}}, SHIFT(244),
+  [421] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_stream_declaration, 5, .production_id = 2),
+  [423] = {.entry = {.count = 1, .reusable = true}}, SHIFT(245),
+  [425] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_struct_declaration, 5, .production_id = 2),
+  [427] = {.entry = {.count = 1, .reusable = true}}, SHIFT(246),
+  [429] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_enum_declaration, 5, .production_id = 2),
+  [431] = {.entry = {.count = 1, .reusable = true}}, SHIFT(247),
+  [433] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flagset_declaration, 5, .production_id = 2),
+  [435] = {.entry = {.count = 1, .reusable = true}}, SHIFT(248),
+  [437] = {.entry = {.count = 1, .reusable = true}}, SHIFT(249),
+  [439] = {.entry = {.count = 1, .reusable = true}}, SHIFT(252),
+  [441] = {.entry = {.count = 1, .reusable = true}}, SHIFT(253),
+  [443] = {.entry = {.count = 1, .reusable = true}}, SHIFT(254),
+  [445] = {.entry = {.count = 1, .reusable = true}}, SHIFT(256),
+  [447] = {.entry = {.count = 1, .reusable = true}}, SHIFT(258),
+  [449] = {.entry = {.count = 1, .reusable = true}}, SHIFT(260),
+  [451] = {.entry = {.count = 1, .reusable = true}}, SHIFT(262),
+  [453] = {.entry = {.count = 1, .reusable = true}}, SHIFT(263),
+  [455] = {.entry = {.count = 1, .reusable = true}}, SHIFT(265),
+  [457] = {.entry = {.count = 1, .reusable = true}}, SHIFT(266),
+  [459] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_case_declaration, 3, .production_id = 2),
+  [461] = {.entry = {.count = 1, .reusable = true}}, SHIFT(268),
+  [463] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flag_declaration, 3, .production_id = 2),
+  [465] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flagset_declaration, 6, .production_id = 17),
+  [467] = {.entry = {.count = 1, .reusable = true}}, SHIFT(269),
+  [469] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type, 3),
+  [471] = {.entry = {.count = 1, .reusable = true}}, SHIFT(272),
+  [473] = {.entry = {.count = 1, .reusable = true}}, SHIFT(273),
+  [475] = {.entry = {.count = 1, .reusable = true}}, SHIFT(274),
+  [477] = {.entry = {.count = 1, .reusable = true}}, SHIFT(276),
+  [479] = {.entry = {.count = 1, .reusable = true}}, SHIFT(278),
+  [481] = {.entry = {.count = 1, .reusable = true}}, SHIFT(281),
+  [483] = {.entry = {.count = 1, .reusable = true}}, SHIFT(284),
+  [485] = {.entry = {.count = 1, .reusable = true}}, SHIFT(286),
+  [487] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_arg, 3, .production_id = 29),
+  [489] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_case_declaration_repeat1, 2),
+  [491] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 6, .production_id = 51),
+  [493] = {.entry = {.count = 1, .reusable = true}}, SHIFT(290),
+  [495] = {.entry = {.count = 1, .reusable = true}}, SHIFT(291),
+  [497] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_dictionary, 3),
+  [499] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_list, 3),
+  [501] = {.entry = {.count = 1, .reusable = true}}, SHIFT(295),
+  [503] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_annotation_repeat1, 2),
+  [505] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_annotation, 6, .production_id = 52),
+  [507] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_stream_declaration, 6, .production_id = 2),
+  [509] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_struct_declaration, 6, .production_id = 4),
+  [511] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_enum_declaration, 6, .production_id = 10),
+  [513] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flagset_declaration, 6, .production_id = 14),
+  [515] = {.entry = {.count = 1, .reusable = true}}, SHIFT(297),
+  [517] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_typealias_declaration, 6, .production_id = 22),
+  [519] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 6, .production_id = 2),
+  [521] = {.entry = {.count = 1, .reusable = true}}, SHIFT(302),
+  [523] = {.entry = {.count = 1, .reusable = true}}, SHIFT(303),
+  [525] = {.entry = {.count = 1, .reusable = true}}, SHIFT(305),
+  [527] = {.entry = {.count = 1, .reusable = true}}, SHIFT(307),
+  [529] = {.entry = {.count = 1, .reusable = true}}, SHIFT(310),
+  [531] = {.entry = {.count = 1, .reusable = true}}, SHIFT(312),
+  [533] = {.entry = {.count = 1, .reusable = true}}, SHIFT(314),
+  [535] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_declaration, 4, .production_id = 9),
+  [537] = {.entry = {.count = 1, .reusable = true}}, SHIFT(316),
+  [539] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_case_declaration, 4, .production_id = 3),
+  [541] = {.entry = {.count = 1, .reusable = true}}, SHIFT(318),
+  [543] = {.entry = {.count = 1, .reusable = true}}, SHIFT(320),
+  [545] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flagset_declaration, 7, .production_id = 13),
+  [547] = {.entry = {.count = 1, .reusable = true}}, SHIFT(322),
+  [549] = {.entry = {.count = 1, .reusable = true}}, SHIFT(323),
+  [551] = {.entry = {.count = 1, .reusable = true}}, SHIFT(324),
+  [553] = {.entry = {.count = 1, .reusable = true}}, SHIFT(325),
+  [555] = {.entry = {.count = 1, .reusable = true}}, SHIFT(326),
+  [557] = {.entry = {.count = 1, .reusable = true}}, SHIFT(328),
+  [559] = {.entry = {.count = 1, .reusable = true}}, SHIFT(329),
+  [561] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_const_declaration, 7, .production_id = 25),
+  [563] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 7, .production_id = 47),
+  [565] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 7, .production_id = 41),
+  [567] = {.entry = {.count = 1, .reusable = true}}, SHIFT(331),
+  [569] = {.entry = {.count = 1, .reusable = true}}, SHIFT(334),
+  [571] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_case_declaration_repeat1, 3),
+  [573] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 7, .production_id = 49),
+  [575] = {.entry = {.count = 1, .reusable = true}}, SHIFT(339),
+  [577] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_arg, 4, .production_id = 28),
+  [579] = {.entry = {.count = 1, .reusable = true}}, SHIFT(340),
+  [581] = {.entry = {.count = 1, .reusable = true}}, SHIFT(341),
+  [583] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_list, 4),
+  [585] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_annotation_repeat1, 3),
+  [587] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flagset_declaration, 7, .production_id = 16),
+  [589] = {.entry = {.count = 1, .reusable = true}}, SHIFT(343),
+  [591] = {.entry = {.count = 1, .reusable = true}}, SHIFT(345),
+  [593] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 7, .production_id = 50),
+  [595] = {.entry = {.count = 1, .reusable = true}}, SHIFT(350),
+  [597] = {.entry = {.count = 1, .reusable = true}}, SHIFT(351),
+  [599] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_event_declaration, 5, .production_id = 3),
+  [601] = {.entry = {.count = 1, .reusable = true}}, SHIFT(354),
+  [603] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_signal_declaration, 5, .production_id = 3),
+  [605] = {.entry = {.count = 1, .reusable = true}}, SHIFT(356),
+  [607] = {.entry = {.count = 1, .reusable = true}}, SHIFT(358),
+  [609] = {.entry = {.count = 1, .reusable = true}}, SHIFT(361),
+  [611] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_declaration, 5, .production_id = 8),
+  [613] = {.entry = {.count = 1, .reusable = true}}, SHIFT(364),
+  [615] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_case_declaration, 5, .production_id = 21),
+  [617] = {.entry = {.count = 1, .reusable = true}}, SHIFT(365),
+  [619] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_case_declaration, 5, .production_id = 2),
+  [621] = {.entry = {.count = 1, .reusable = true}}, SHIFT(366),
+  [623] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type, 5),
+  [625] = {.entry = {.count = 1, .reusable = true}}, SHIFT(369),
+  [627] = {.entry = {.count = 1, .reusable = true}}, SHIFT(371),
+  [629] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_arg, 5, .production_id = 27),
+  [631] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 8, .production_id = 45),
+  [633] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 8, .production_id = 39),
+  [635] = {.entry = {.count = 1, .reusable = true}}, SHIFT(373),
+  [637] = {.entry = {.count = 1, .reusable = true}}, SHIFT(377),
+  [639] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_dictionary, 5),
+  [641] = {.entry = {.count = 1, .reusable = true}}, SHIFT(378),
+  [643] = {.entry = {.count = 1, .reusable = true}}, SHIFT(379),
+  [645] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_flagset_declaration, 8, .production_id = 12),
+  [647] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_const_declaration, 8, .production_id = 24),
+  [649] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 8, .production_id = 46),
+  [651] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 8, .production_id = 40),
+  [653] = {.entry = {.count = 1, .reusable = true}}, SHIFT(381),
+  [655] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 8, .production_id = 48),
+  [657] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_event_declaration, 6, .production_id = 51),
+  [659] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_signal_declaration, 6, .production_id = 51),
+  [661] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_event_declaration, 6, .production_id = 2),
+  [663] = {.entry = {.count = 1, .reusable = true}}, SHIFT(389),
+  [665] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_signal_declaration, 6, .production_id = 2),
+  [667] = {.entry = {.count = 1, .reusable = true}}, SHIFT(391),
+  [669] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_declaration, 6, .production_id = 7),
+  [671] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_case_declaration, 6, .production_id = 19),
+  [673] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_case_declaration, 6, .production_id = 20),
+  [675] = {.entry = {.count = 1, .reusable = true}}, SHIFT(393),
+  [677] = {.entry = {.count = 1, .reusable = true}}, SHIFT(394),
+  [679] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 9, .production_id = 35),
+  [681] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 9, .production_id = 43),
+  [683] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 9, .production_id = 37),
+  [685] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_arg, 6, .production_id = 26),
+  [687] = {.entry = {.count = 1, .reusable = true}}, SHIFT(398),
+  [689] = {.entry = {.count = 1, .reusable = true}}, SHIFT(399),
+  [691] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_dictionary, 6),
+  [693] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 9, .production_id = 44),
+  [695] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 9, .production_id = 38),
+  [697] = {.entry = {.count = 1, .reusable = true}}, SHIFT(402),
+  [699] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_event_declaration, 7, .production_id = 49),
+  [701] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_signal_declaration, 7, .production_id = 49),
+  [703] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_event_declaration, 7, .production_id = 50),
+  [705] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_signal_declaration, 7, .production_id = 50),
+  [707] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_declaration, 7, .production_id = 6),
+  [709] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_case_declaration, 7, .production_id = 18),
+  [711] = {.entry = {.count = 1, .reusable = true}}, SHIFT(407),
+  [713] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 10, .production_id = 33),
+  [715] = {.entry = {.count = 1, .reusable = true}}, SHIFT(410),
+  [717] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 10, .production_id = 34),
+  [719] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 10, .production_id = 42),
+  [721] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 10, .production_id = 36),
+  [723] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_event_declaration, 8, .production_id = 48),
+  [725] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_signal_declaration, 8, .production_id = 48),
+  [727] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 11, .production_id = 31),
+  [729] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_dictionary_repeat1, 4),
+  [731] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 11, .production_id = 32),
+  [733] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_dictionary_repeat1, 5),
+  [735] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_func_declaration, 12, .production_id = 30),
 };
 
 #ifdef __cplusplus
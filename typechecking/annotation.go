@@ -0,0 +1,393 @@
+package typechecking
+
+import (
+	"lugmac/ast"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Annotation is a validated annotation attached to an object.
+type Annotation struct {
+	Name      string
+	Arguments []Value
+
+	Span ast.Span
+}
+
+// Argument returns the i-th argument of the annotation, or nil if it wasn't
+// given.
+func (a *Annotation) Argument(i int) Value {
+	if a == nil || i >= len(a.Arguments) {
+		return nil
+	}
+	return a.Arguments[i]
+}
+
+func (a *Annotation) String() string {
+	var args []string
+	for _, arg := range a.Arguments {
+		args = append(args, arg.String())
+	}
+	return "@" + a.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+type Annotations []*Annotation
+
+// Get returns the first annotation called name, or nil if there is none.
+func (a Annotations) Get(name string) *Annotation {
+	for _, annotation := range a {
+		if annotation.Name == name {
+			return annotation
+		}
+	}
+	return nil
+}
+
+// Has reports whether an annotation called name is present.
+func (a Annotations) Has(name string) bool {
+	return a.Get(name) != nil
+}
+
+type Value interface {
+	isValue()
+	Kind() ValueKind
+	String() string
+}
+
+type ValueKind int
+
+const (
+	AnyValue ValueKind = iota
+	StringValueKind
+	NumberValueKind
+	BoolValueKind
+	ListValueKind
+	DictionaryValueKind
+)
+
+func (k ValueKind) String() string {
+	switch k {
+	case StringValueKind:
+		return "string"
+	case NumberValueKind:
+		return "number"
+	case BoolValueKind:
+		return "bool"
+	case ListValueKind:
+		return "list"
+	case DictionaryValueKind:
+		return "dictionary"
+	default:
+		return "value"
+	}
+}
+
+type StringValue string
+
+func (StringValue) isValue()         {}
+func (StringValue) Kind() ValueKind  { return StringValueKind }
+func (s StringValue) String() string { return strconv.Quote(string(s)) }
+
+type NumberValue float64
+
+func (NumberValue) isValue()         {}
+func (NumberValue) Kind() ValueKind  { return NumberValueKind }
+func (n NumberValue) String() string { return strconv.FormatFloat(float64(n), 'g', -1, 64) }
+
+// IntegerValue is a number checked against an integer type, which it's kept
+// exactly for, even if it's too large for a float64.
+type IntegerValue struct {
+	Int *big.Int
+}
+
+func (IntegerValue) isValue()         {}
+func (IntegerValue) Kind() ValueKind  { return NumberValueKind }
+func (i IntegerValue) String() string { return i.Int.String() }
+
+type BoolValue bool
+
+func (BoolValue) isValue()        {}
+func (BoolValue) Kind() ValueKind { return BoolValueKind }
+func (b BoolValue) String() string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+type ListValue []Value
+
+func (ListValue) isValue()        {}
+func (ListValue) Kind() ValueKind { return ListValueKind }
+func (l ListValue) String() string {
+	var items []string
+	for _, item := range l {
+		items = append(items, item.String())
+	}
+	return "[" + strings.Join(items, ", ") + "]"
+}
+
+type DictionaryEntry struct {
+	Key   string
+	Value Value
+}
+
+// DictionaryValue keeps its entries in the order they were written in.
+type DictionaryValue []DictionaryEntry
+
+func (DictionaryValue) isValue()        {}
+func (DictionaryValue) Kind() ValueKind { return DictionaryValueKind }
+func (d DictionaryValue) String() string {
+	if len(d) == 0 {
+		return "[:]"
+	}
+	var items []string
+	for _, entry := range d {
+		items = append(items, entry.Key+": "+entry.Value.String())
+	}
+	return "[" + strings.Join(items, ", ") + "]"
+}
+
+// Get returns the value for key, or nil if there is none.
+func (d DictionaryValue) Get(key string) Value {
+	for _, entry := range d {
+		if entry.Key == key {
+			return entry.Value
+		}
+	}
+	return nil
+}
+
+func valueOf(lit ast.Literal, in *Context) (Value, error) {
+	switch lit := lit.(type) {
+	case ast.LiteralString:
+		return StringValue(lit.Value), nil
+	case ast.LiteralNumber:
+		n, err := strconv.ParseFloat(lit.Value, 64)
+		if err != nil {
+			return nil, in.errorAt(lit.Span, "%s is not a valid number", lit.Value)
+		}
+		return NumberValue(n), nil
+	case ast.LiteralBool:
+		return BoolValue(lit.Value), nil
+	case ast.LiteralList:
+		l := ListValue{}
+		for _, item := range lit.Values {
+			v, err := valueOf(item, in)
+			if err != nil {
+				return nil, err
+			}
+			l = append(l, v)
+		}
+		return l, nil
+	case ast.LiteralDictionary:
+		d := DictionaryValue{}
+		for i, entry := range lit.Entries {
+			for _, previous := range lit.Entries[:i] {
+				if previous.Key == entry.Key {
+					diag := in.errorAt(entry.Value.GetSpan(), "key %s appears more than once in dictionary", entry.Key)
+					diag.Notes = append(diag.Notes, in.noteAt(previous.Value.GetSpan(), "%s was first given here", entry.Key))
+					return nil, diag
+				}
+			}
+			v, err := valueOf(entry.Value, in)
+			if err != nil {
+				return nil, err
+			}
+			d = append(d, DictionaryEntry{entry.Key, v})
+		}
+		return d, nil
+	default:
+		panic("Unhandled literal kind")
+	}
+}
+
+// AnnotationTarget is the set of declarations an annotation may be attached
+// to.
+type AnnotationTarget uint
+
+const (
+	OnStruct AnnotationTarget = 1 << iota
+	OnField
+	OnEnum
+	OnCase
+	OnFlagset
+	OnFlag
+	OnFunc
+	OnArgument
+	OnStream
+	OnEvent
+	OnSignal
+	OnTypeAlias
+	OnConstant
+
+	OnAnything = OnStruct | OnField | OnEnum | OnCase | OnFlagset | OnFlag | OnFunc | OnArgument | OnStream | OnEvent | OnSignal | OnTypeAlias | OnConstant
+)
+
+func (t AnnotationTarget) String() string {
+	switch t {
+	case OnStruct:
+		return "struct"
+	case OnField:
+		return "field"
+	case OnEnum:
+		return "enum"
+	case OnCase:
+		return "case"
+	case OnFlagset:
+		return "flagset"
+	case OnFlag:
+		return "flag"
+	case OnFunc:
+		return "func"
+	case OnArgument:
+		return "argument"
+	case OnStream:
+		return "stream"
+	case OnEvent:
+		return "event"
+	case OnSignal:
+		return "signal"
+	case OnTypeAlias:
+		return "type alias"
+	case OnConstant:
+		return "constant"
+	default:
+		return "declaration"
+	}
+}
+
+type AnnotationParameter struct {
+	Name     string
+	Kind     ValueKind
+	Optional bool
+}
+
+// AnnotationSignature describes an annotation that lugmac knows about.
+type AnnotationSignature struct {
+	Name          string
+	Documentation string
+
+	Parameters []AnnotationParameter
+	On         AnnotationTarget
+	Repeatable bool
+}
+
+// KnownAnnotations holds every annotation that may be used. Backends with
+// annotations of their own add them with RegisterAnnotation.
+var KnownAnnotations = map[string]AnnotationSignature{}
+
+func RegisterAnnotation(sig AnnotationSignature) {
+	KnownAnnotations[sig.Name] = sig
+}
+
+func init() {
+	RegisterAnnotation(AnnotationSignature{
+		Name:          "deprecated",
+		Documentation: "Marks a declaration as deprecated, optionally explaining what to use instead.",
+		Parameters:    []AnnotationParameter{{"reason", StringValueKind, true}},
+		On:            OnAnything,
+	})
+	RegisterAnnotation(AnnotationSignature{
+		Name:          "since",
+		Documentation: "Records the version a declaration was introduced in.",
+		Parameters:    []AnnotationParameter{{"version", StringValueKind, false}},
+		On:            OnAnything,
+	})
+	RegisterAnnotation(AnnotationSignature{
+		Name:          "http",
+		Documentation: "Customises how a function is exposed over HTTP, e.g. `@http([method: \"GET\", path: \"/items\"])`.",
+		Parameters:    []AnnotationParameter{{"options", DictionaryValueKind, false}},
+		On:            OnFunc,
+	})
+}
+
+// annotationList checks annotations against the signatures of the known
+// annotations, reporting any that don't match and leaving them out.
+func annotationList(annotations []ast.Annotation, on AnnotationTarget, in *Context) Annotations {
+	var ret Annotations
+
+outer:
+	for _, annotation := range annotations {
+		sig, ok := KnownAnnotations[annotation.Name]
+		if !ok {
+			in.report(in.errorAt(annotation.Span, "unknown annotation @%s", annotation.Name), annotation.Span)
+			continue
+		}
+		if sig.On&on == 0 {
+			in.report(in.errorAt(annotation.Span, "annotation @%s cannot be used on a %s", annotation.Name, on), annotation.Span)
+			continue
+		}
+		if previous := ret.Get(annotation.Name); previous != nil && !sig.Repeatable {
+			diag := in.errorAt(annotation.Span, "annotation @%s cannot be used more than once on the same %s", annotation.Name, on)
+			diag.Notes = append(diag.Notes, in.noteAt(previous.Span, "@%s was first used here", annotation.Name))
+			in.report(diag, annotation.Span)
+			continue
+		}
+		if len(annotation.Arguments) > len(sig.Parameters) {
+			in.report(in.errorAt(annotation.Span, "annotation @%s takes at most %d arguments, but was given %d", annotation.Name, len(sig.Parameters), len(annotation.Arguments)), annotation.Span)
+			continue
+		}
+
+		a := &Annotation{Name: annotation.Name, Span: annotation.Span}
+		for i, param := range sig.Parameters {
+			if i >= len(annotation.Arguments) {
+				if !param.Optional {
+					in.report(in.errorAt(annotation.Span, "annotation @%s is missing its %s argument", annotation.Name, param.Name), annotation.Span)
+					continue outer
+				}
+				continue
+			}
+
+			arg := annotation.Arguments[i]
+			v, err := valueOf(arg, in)
+			if err != nil {
+				in.report(err, arg.GetSpan())
+				continue outer
+			}
+			if param.Kind != AnyValue && v.Kind() != param.Kind {
+				in.report(in.errorAt(arg.GetSpan(), "argument %s of annotation @%s should be a %s, not a %s", param.Name, annotation.Name, param.Kind, v.Kind()), arg.GetSpan())
+				continue outer
+			}
+			a.Arguments = append(a.Arguments, v)
+		}
+
+		ret = append(ret, a)
+	}
+
+	return ret
+}
+
+// AnnotationsOf returns the annotations attached to object, if it is a kind
+// of object that can have any.
+func AnnotationsOf(object Object) Annotations {
+	switch t := object.(type) {
+	case *Struct:
+		return t.Annotations
+	case *Field:
+		return t.Annotations
+	case *Enum:
+		return t.Annotations
+	case *Case:
+		return t.Annotations
+	case *Flagset:
+		return t.Annotations
+	case *Flag:
+		return t.Annotations
+	case *Func:
+		return t.Annotations
+	case *Stream:
+		return t.Annotations
+	case *Event:
+		return t.Annotations
+	case *Signal:
+		return t.Annotations
+	case *TypeAlias:
+		return t.Annotations
+	case *Constant:
+		return t.Annotations
+	default:
+		return nil
+	}
+}
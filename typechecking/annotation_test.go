@@ -0,0 +1,147 @@
+package typechecking
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAnnotations(t *testing.T) {
+	m, diags := check(t, `
+@deprecated()
+@since("1.2")
+struct User {
+    @deprecated("use names")
+    let name: String
+}
+@reserved([2, "old"])
+@reserved([3])
+enum Colour {
+    @tag(1)
+    case red
+}
+@http([method: "GET", path: "/users"])
+func users() -> [User]
+`)
+	if len(diags) > 0 {
+		t.Fatalf("expected no diagnostics, got:\n%s", diags)
+	}
+
+	user := m.Child("User").(*Struct)
+	cases := []struct {
+		name        string
+		annotations Annotations
+		want        []string
+	}{
+		{"User", user.Annotations, []string{"@deprecated()", `@since("1.2")`}},
+		{"User.name", user.Fields[0].Annotations, []string{`@deprecated("use names")`}},
+		{"Colour", m.Child("Colour").(*Enum).Annotations, []string{`@reserved([2, "old"])`, "@reserved([3])"}},
+		{"Colour.red", m.Child("Colour").(*Enum).Cases[0].Annotations, []string{"@tag(1)"}},
+		{"users", m.Child("users").(*Func).Annotations, []string{`@http([method: "GET", path: "/users"])`}},
+	}
+	for _, c := range cases {
+		var got []string
+		for _, annotation := range c.annotations {
+			got = append(got, annotation.String())
+		}
+		if strings.Join(got, " ") != strings.Join(c.want, " ") {
+			t.Errorf("expected %s to have %v, got %v", c.name, c.want, got)
+		}
+	}
+
+	http := m.Child("users").(*Func).Annotations.Get("http")
+	if path := http.Argument(0).(DictionaryValue).Get("path"); path != StringValue("/users") {
+		t.Errorf("expected the path /users, got %v", path)
+	}
+	if user.Annotations.Get("deprecated").Argument(0) != nil {
+		t.Error("expected @deprecated without a reason not to have one")
+	}
+	if user.Annotations.Has("http") {
+		t.Error("expected User not to have @http")
+	}
+}
+
+func TestAnnotationErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		source  string
+		message string
+		// kept is how many annotations are kept.
+		kept int
+	}{
+		{
+			name:    "unknown",
+			source:  "@frobnicate()\nstruct S {\n let a: String\n}",
+			message: "unknown annotation @frobnicate",
+		},
+		{
+			name:    "wrong declaration",
+			source:  "@http([method: \"GET\"])\nstruct S {\n let a: String\n}",
+			message: "annotation @http cannot be used on a struct",
+		},
+		{
+			name:    "used twice",
+			source:  "@since(\"1\")\n@since(\"2\")\nstruct S {\n let a: String\n}",
+			message: "annotation @since cannot be used more than once on the same struct",
+			kept:    1,
+		},
+		{
+			name:    "too many arguments",
+			source:  "@deprecated(\"a\", \"b\")\nstruct S {\n let a: String\n}",
+			message: "annotation @deprecated takes at most 1 arguments, but was given 2",
+		},
+		{
+			name:    "missing argument",
+			source:  "@since()\nstruct S {\n let a: String\n}",
+			message: "annotation @since is missing its version argument",
+		},
+		{
+			name:    "wrong kind of argument",
+			source:  "@since(2)\nstruct S {\n let a: String\n}",
+			message: "argument version of annotation @since should be a string, not a number",
+		},
+		{
+			name:    "repeated dictionary key",
+			source:  "@http([method: \"GET\", method: \"POST\"])\nfunc f()",
+			message: "key method appears more than once in dictionary",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m, diags := check(t, c.source)
+			if len(diags) != 1 {
+				t.Fatalf("expected one diagnostic, got:\n%s", diags)
+			}
+			if !strings.Contains(diags[0].Message, c.message) {
+				t.Errorf("expected %q, got %q", c.message, diags[0].Message)
+			}
+			for _, item := range []Object{m.Child("S"), m.Child("f")} {
+				if item != nil && len(AnnotationsOf(item)) != c.kept {
+					t.Errorf("expected %d annotations to be kept, got %v", c.kept, AnnotationsOf(item))
+				}
+			}
+		})
+	}
+}
+
+// TestAnnotationSignatures checks the signatures of the known annotations,
+// which annotationList relies on being well formed.
+func TestAnnotationSignatures(t *testing.T) {
+	for name, sig := range KnownAnnotations {
+		if sig.Name != name {
+			t.Errorf("@%s is registered as @%s", sig.Name, name)
+		}
+		if sig.Documentation == "" {
+			t.Errorf("@%s isn't documented", name)
+		}
+		if sig.On == 0 || sig.On&^OnAnything != 0 {
+			t.Errorf("@%s can be used on %b, which isn't a set of declarations", name, sig.On)
+		}
+		optional := false
+		for _, param := range sig.Parameters {
+			if optional && !param.Optional {
+				t.Errorf("@%s has its %s argument after optional ones", name, param.Name)
+			}
+			optional = optional || param.Optional
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package typechecking
+
+import (
+	"encoding/base64"
+	"lugmac/ast"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Constant is a named value shared between clients and servers. It isn't
+// sent on the wire, but backends emit it for both sides to use.
+type Constant struct {
+	object
+	Documentation *ast.ItemDocumentation
+	Annotations   Annotations
+
+	Type  Type
+	Value Value
+}
+
+var _ Object = &Constant{}
+
+func (c Constant) Child(name string) Object { return nil }
+
+var (
+	durationPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]{1,9})?s$`)
+	uuidPattern     = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+)
+
+// integerRanges holds the smallest and largest values of each integer type.
+var integerRanges = map[PrimitiveType][2]*big.Int{
+	UInt8:  {big.NewInt(0), big.NewInt(math.MaxUint8)},
+	UInt16: {big.NewInt(0), big.NewInt(math.MaxUint16)},
+	UInt32: {big.NewInt(0), big.NewInt(math.MaxUint32)},
+	UInt64: {big.NewInt(0), new(big.Int).SetUint64(math.MaxUint64)},
+	Int8:   {big.NewInt(math.MinInt8), big.NewInt(math.MaxInt8)},
+	Int16:  {big.NewInt(math.MinInt16), big.NewInt(math.MaxInt16)},
+	Int32:  {big.NewInt(math.MinInt32), big.NewInt(math.MaxInt32)},
+	Int64:  {big.NewInt(math.MinInt64), big.NewInt(math.MaxInt64)},
+}
+
+// parseInteger parses a number literal that's written as a whole number.
+func parseInteger(text string) (*big.Int, bool) {
+	text = strings.TrimSuffix(strings.ReplaceAll(text, "_", ""), "n")
+	negative := strings.HasPrefix(text, "-")
+	text = strings.TrimPrefix(text, "-")
+
+	base := 10
+	if len(text) > 2 && text[0] == '0' {
+		switch text[1] {
+		case 'x', 'X':
+			base = 16
+		case 'o', 'O':
+			base = 8
+		case 'b', 'B':
+			base = 2
+		}
+		if base != 10 {
+			text = text[2:]
+		}
+	}
+
+	n, ok := new(big.Int).SetString(text, base)
+	if !ok {
+		return nil, false
+	}
+	if negative {
+		n.Neg(n)
+	}
+	return n, true
+}
+
+// primitiveValueOf checks that lit is a valid value of the primitive type
+// typ. Types that are sent as strings are written as the strings they're
+// sent as.
+func primitiveValueOf(lit ast.Literal, typ PrimitiveType, in *Context) (Value, error) {
+	switch typ {
+	case UInt8, UInt16, UInt32, UInt64, Int8, Int16, Int32, Int64:
+		num, ok := lit.(ast.LiteralNumber)
+		if !ok {
+			return nil, in.errorAt(lit.GetSpan(), "expected a number for %s", typ)
+		}
+		n, ok := parseInteger(num.Value)
+		if !ok {
+			return nil, in.errorAt(lit.GetSpan(), "%s is not a whole number", num.Value)
+		}
+		bounds := integerRanges[typ]
+		if n.Cmp(bounds[0]) < 0 || n.Cmp(bounds[1]) > 0 {
+			return nil, in.errorAt(lit.GetSpan(), "%s is out of range for %s, which holds %s to %s", num.Value, typ, bounds[0], bounds[1])
+		}
+		return IntegerValue{n}, nil
+	case Float32, Float64:
+		num, ok := lit.(ast.LiteralNumber)
+		if !ok {
+			return nil, in.errorAt(lit.GetSpan(), "expected a number for %s", typ)
+		}
+		if n, ok := parseInteger(num.Value); ok {
+			f, _ := new(big.Float).SetInt(n).Float64()
+			return NumberValue(f), nil
+		}
+		bits := 64
+		if typ == Float32 {
+			bits = 32
+		}
+		f, err := strconv.ParseFloat(strings.ReplaceAll(num.Value, "_", ""), bits)
+		if err != nil {
+			return nil, in.errorAt(lit.GetSpan(), "%s is not a valid %s", num.Value, typ)
+		}
+		return NumberValue(f), nil
+	case Bool:
+		b, ok := lit.(ast.LiteralBool)
+		if !ok {
+			return nil, in.errorAt(lit.GetSpan(), "expected Yes or No for %s", typ)
+		}
+		return BoolValue(b.Value), nil
+	}
+
+	str, ok := lit.(ast.LiteralString)
+	if !ok {
+		return nil, in.errorAt(lit.GetSpan(), "expected a string for %s", typ)
+	}
+	valid := true
+	switch typ {
+	case Bytes:
+		_, err := base64.StdEncoding.DecodeString(str.Value)
+		valid = err == nil
+	case Timestamp:
+		_, err := time.Parse(time.RFC3339Nano, str.Value)
+		valid = err == nil
+	case Duration:
+		valid = durationPattern.MatchString(str.Value)
+	case UUID:
+		valid = uuidPattern.MatchString(str.Value)
+	}
+	if !valid {
+		return nil, in.errorAt(lit.GetSpan(), "%s is not a valid %s", strconv.Quote(str.Value), typ)
+	}
+	return StringValue(str.Value), nil
+}
+
+// constantValueOf checks that lit is a valid value of typ, which must be a
+// primitive type or an alias of one.
+func constantValueOf(lit ast.Literal, typ Type, span ast.Span, in *Context) (Value, error) {
+	primitive, ok := Resolve(typ).(PrimitiveType)
+	if !ok {
+		return nil, in.errorAt(span, "constants must have a primitive type, not %s", typ)
+	}
+	return primitiveValueOf(lit, primitive, in)
+}
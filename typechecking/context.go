@@ -5,10 +5,7 @@ import (
 	"io/ioutil"
 	"lugmac/ast"
 	"path"
-
-	lugma "lugmac/parser"
-
-	sitter "github.com/smacker/go-tree-sitter"
+	"strings"
 )
 
 type ImportResolver interface {
@@ -18,29 +15,37 @@ type ImportResolver interface {
 type Context struct {
 	Environment    *Environment
 	ImportResolver ImportResolver
+
+	// file is the path of the file currently being checked, and diagnostics
+	// what's been found in the module being checked so far.
+	file        string
+	diagnostics Diagnostics
+
+	// pending holds checks that wait until the declarations they depend on
+	// have been filled in.
+	pending []func()
 }
 
 func NewContext(i ImportResolver) *Context {
-	return &Context{World, i}
+	return &Context{Environment: World, ImportResolver: i}
 }
 
 type fileImportResolver struct {
 }
 
 func (f *fileImportResolver) ModuleFor(ctx *Context, path string, from string) (*Module, error) {
-	parser := sitter.NewParser()
-	parser.SetLanguage(lugma.GetLanguage())
-
 	file, err := ioutil.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load module at %s: %w", path, err)
 	}
 
-	tree := parser.Parse(nil, file)
-
-	fileAST := ast.FileFromNode(tree.RootNode(), file)
+	fileAST, err := ast.Parse(file)
+	if err != nil {
+		return nil, DiagnosticsFor(path, err)
+	}
+	fileAST.Path = path
 
-	module, err := ctx.Module(&fileAST, path)
+	module, err := ctx.Module(fileAST, path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load module at %s: %w", path, err)
 	}
@@ -61,16 +66,34 @@ func (c *Context) PopEnvironment() *Environment {
 	return a
 }
 
+// later runs check once the declarations being filled in are done, in the
+// file currently being checked.
+func (c *Context) later(check func()) {
+	file := c.file
+	c.pending = append(c.pending, func() {
+		c.file = file
+		check()
+	})
+}
+
+func (c *Context) runPending() {
+	pending := c.pending
+	c.pending = nil
+	for _, check := range pending {
+		check()
+	}
+}
+
 func lookupType(typ ast.Type, in *Context) (Type, error) {
 	switch typ := typ.(type) {
 	case ast.TypeIdent:
 		object, found := in.Environment.Search(typ.Name)
 		if !found {
-			return nil, fmt.Errorf("Type %s not found", typ.Name)
+			return nil, in.errorAt(typ.Span, "type %s not found", typ.Name)
 		}
 		kind, ok := object.(Type)
 		if !ok {
-			return nil, fmt.Errorf("%s is not a type", typ.Name)
+			return nil, in.errorAt(typ.Span, "%s is not a type", typ.Name)
 		}
 		return kind, nil
 	case ast.TypeArray:
@@ -85,7 +108,7 @@ func lookupType(typ ast.Type, in *Context) (Type, error) {
 			return nil, err
 		}
 		if !key.Keyable() {
-			return nil, fmt.Errorf("%s is not a valid type to use as a dictionary key", key)
+			return nil, in.errorAt(typ.Key.GetSpan(), "%s is not a valid type to use as a dictionary key", key)
 		}
 
 		val, err := lookupType(typ.Value, in)
@@ -106,7 +129,7 @@ func lookupType(typ ast.Type, in *Context) (Type, error) {
 		if v, ok := typ.Inner.(ast.TypeIdent); ok {
 			element, ok = in.Environment.Search(v.Name)
 			if !ok {
-				return nil, fmt.Errorf("Object %s not found", v.Name)
+				return nil, in.errorAt(v.Span, "object %s not found", v.Name)
 			}
 		} else {
 			var err error
@@ -117,11 +140,11 @@ func lookupType(typ ast.Type, in *Context) (Type, error) {
 		}
 		child := element.Child(typ.Field)
 		if child == nil {
-			return nil, fmt.Errorf("Object %s has no field %s", element, typ.Field)
+			return nil, in.errorAt(typ.Span, "object %s has no field %s", element.ObjectName(), typ.Field)
 		}
 		kind, ok := child.(Type)
 		if !ok {
-			return nil, fmt.Errorf("Field %s on %s is not a type", typ.Field, kind)
+			return nil, in.errorAt(typ.Span, "field %s on %s is not a type", typ.Field, element.ObjectName())
 		}
 		return kind, nil
 	case nil:
@@ -131,7 +154,7 @@ func lookupType(typ ast.Type, in *Context) (Type, error) {
 	}
 }
 
-func fieldList(fields []ast.Field, parentPath Path, parent Object, in *Context) ([]*Field, error) {
+func fieldList(fields []ast.Field, parentPath Path, parent Object, in *Context) []*Field {
 	var fs []*Field
 
 	for _, field := range fields {
@@ -140,20 +163,35 @@ func fieldList(fields []ast.Field, parentPath Path, parent Object, in *Context)
 		f.Name = field.Name
 		f.DefinedAt = parentPath.Appended(f.Name)
 		f.InParent = parent
+		f.Source = in.locate(field.Span)
+		f.Annotations = annotationList(field.Annotations, OnField, in)
+		f.Tag = tagOf(f.Annotations, in)
 
 		typ, err := lookupType(field.Type, in)
 		if err != nil {
-			return nil, err
+			in.report(err, field.Span)
 		}
 		f.Type = typ
 
+		if field.Default != nil {
+			field := field
+			in.later(func() {
+				var err error
+				f.Default, err = defaultValueOf(field.Default, typ, field.Span, in)
+				if err != nil {
+					in.report(err, field.Span)
+				}
+			})
+		}
+
 		fs = append(fs, f)
 	}
+	checkTags(taggedFields(fs), AnnotationsOf(parent), in)
 
-	return fs, nil
+	return fs
 }
 
-func argList(fields []ast.Argument, parentPath Path, parent Object, in *Context) ([]*Field, error) {
+func argList(fields []ast.Argument, parentPath Path, parent Object, in *Context) []*Field {
 	var fs []*Field
 
 	for _, field := range fields {
@@ -161,26 +199,38 @@ func argList(fields []ast.Argument, parentPath Path, parent Object, in *Context)
 		f.Name = field.Name
 		f.DefinedAt = parentPath.Appended(f.Name)
 		f.InParent = parent
+		f.Source = in.locate(field.Span)
+		f.Annotations = annotationList(field.Annotations, OnArgument, in)
+		f.Tag = tagOf(f.Annotations, in)
 
 		typ, err := lookupType(field.Type, in)
 		if err != nil {
-			return nil, err
+			in.report(err, field.Span)
 		}
 		f.Type = typ
 
+		if field.Default != nil {
+			field := field
+			in.later(func() {
+				var err error
+				f.Default, err = defaultValueOf(field.Default, typ, field.Span, in)
+				if err != nil {
+					in.report(err, field.Span)
+				}
+			})
+		}
+
 		fs = append(fs, f)
 	}
+	checkTags(taggedFields(fs), AnnotationsOf(parent), in)
 
-	return fs, nil
+	return fs
 }
 
+// ModuleFor resolves an import. The resolver may return a module along with
+// an error, if the import is wrong but still has a module to refer to.
 func (ctx *Context) ModuleFor(path, from string) (*Module, error) {
-	module, err := ctx.ImportResolver.ModuleFor(ctx, path, from)
-	if err != nil {
-		return nil, err
-	}
-
-	return module, nil
+	return ctx.ImportResolver.ModuleFor(ctx, path, from)
 }
 
 func (ctx *Context) MultiFileModule(trees []*ast.File, w *Workspace, modpath string) (*Module, error) {
@@ -188,139 +238,292 @@ func (ctx *Context) MultiFileModule(trees []*ast.File, w *Workspace, modpath str
 	m.DefinedAt = Path{modpath, ""}
 	m.Name = path.Base(modpath)
 
-	ctx.PushEnvironment()
+	m.InEnv = ctx.PushEnvironment()
 	defer ctx.PopEnvironment()
 
-	megaFile := ast.CombineFiles(trees...)
-
-	err := ctx.doSingleModule(m, w, megaFile)
-	if err != nil {
-		return nil, err
+	diags := ctx.collect(func() {
+		ctx.doSingleModule(m, w, trees)
+	})
+	if diags.HasErrors() {
+		// the module is still returned, so that tools can make use of
+		// whatever could be understood of it
+		return m, diags
 	}
 
 	return m, nil
 }
 
-func (ctx *Context) doSingleModule(m *Module, w *Workspace, tree *ast.File) error {
+// doSingleModule checks the files making up m, reporting any problems with
+// them to ctx.
+func (ctx *Context) doSingleModule(m *Module, w *Workspace, trees []*ast.File) {
 	m.InWorkspace = w
-	for _, imports := range tree.Imports {
-		module, err := ctx.ModuleFor(imports.Path, m.DefinedAt.ModulePath)
-		if err != nil {
-			return err
+	m.Files = trees
+	m.Imports = map[string]*Module{}
+	for _, tree := range trees {
+		ctx.file = tree.Path
+		for _, imports := range tree.Imports {
+			module, err := ctx.ModuleFor(imports.Path, m.DefinedAt.ModulePath)
+			if err != nil {
+				ctx.report(err, imports.Span)
+			}
+			if module != nil {
+				ctx.Environment.Items[imports.As] = module
+				m.Imports[imports.As] = module
+			}
 		}
-		ctx.Environment.Items[imports.As] = module
 	}
-	for _, item := range tree.Structs {
-		s := &Struct{}
-		s.object = newObject(item.Name, m.DefinedAt.Appended(item.Name), m, ctx.Environment)
-		s.Documentation = item.Documentation
-
-		fields, err := fieldList(item.Fields, s.Path(), s, ctx)
-		if err != nil {
-			return err
+	// every declaration is named before any of them are checked, so that
+	// they can refer to each other whatever order they're declared in
+	aliases := &aliasResolver{ctx: ctx, decls: map[*TypeAlias]aliasDecl{}, state: map[*TypeAlias]resolution{}}
+	for _, tree := range trees {
+		ctx.file = tree.Path
+		for _, item := range tree.TypeAliases {
+			t := &TypeAlias{}
+			t.object = newObject(item.Name, m.DefinedAt.Appended(item.Name), m, ctx.Environment, ctx.locate(item.Span))
+			t.Documentation = item.Documentation
+			t.Annotations = annotationList(item.Annotations, OnTypeAlias, ctx)
+			t.Newtype = item.Newtype
+
+			aliases.decls[t] = aliasDecl{item, tree.Path}
+			m.TypeAliases = append(m.TypeAliases, t)
+			ctx.Environment.Items[item.Name] = t
 		}
-		s.Fields = fields
-
-		m.Structs = append(m.Structs, s)
-		ctx.Environment.Items[item.Name] = s
 	}
-	for _, item := range tree.Enums {
-		e := &Enum{}
-		e.object = newObject(item.Name, m.DefinedAt.Appended(item.Name), m, ctx.Environment)
-		e.Documentation = item.Documentation
-
-		for _, cas := range item.Cases {
-			c := &Case{}
-			c.object = newObject(cas.Name, e.Path().Appended(cas.Name), e, ctx.Environment)
-			c.Documentation = cas.Documentation
-
-			args, err := argList(cas.Values, c.Path(), c, ctx)
-			if err != nil {
-				return err
+	for _, tree := range trees {
+		ctx.file = tree.Path
+		for _, item := range tree.Structs {
+			s := &Struct{}
+			s.object = newObject(item.Name, m.DefinedAt.Appended(item.Name), m, ctx.Environment, ctx.locate(item.Span))
+			s.Documentation = item.Documentation
+			s.Annotations = annotationList(item.Annotations, OnStruct, ctx)
+
+			m.Structs = append(m.Structs, s)
+			ctx.Environment.Items[item.Name] = s
+		}
+	}
+	for _, tree := range trees {
+		ctx.file = tree.Path
+		for _, item := range tree.Enums {
+			e := &Enum{}
+			e.object = newObject(item.Name, m.DefinedAt.Appended(item.Name), m, ctx.Environment, ctx.locate(item.Span))
+			e.Documentation = item.Documentation
+			e.Annotations = annotationList(item.Annotations, OnEnum, ctx)
+
+			for _, cas := range item.Cases {
+				c := &Case{}
+				c.object = newObject(cas.Name, e.Path().Appended(cas.Name), e, ctx.Environment, ctx.locate(cas.Span))
+				c.Documentation = cas.Documentation
+				c.Annotations = annotationList(cas.Annotations, OnCase, ctx)
+				c.Tag = tagOf(c.Annotations, ctx)
+
+				e.Cases = append(e.Cases, c)
 			}
+			checkTags(taggedCases(e.Cases), e.Annotations, ctx)
 
-			c.Fields = args
-			e.Cases = append(e.Cases, c)
+			m.Enums = append(m.Enums, e)
+			ctx.Environment.Items[item.Name] = e
 		}
-
-		m.Enums = append(m.Enums, e)
-		ctx.Environment.Items[item.Name] = e
 	}
-	for _, item := range tree.Flagsets {
-		fs := &Flagset{}
-		fs.object = newObject(item.Name, m.DefinedAt.Appended(item.Name), m, ctx.Environment)
-		fs.Documentation = item.Documentation
-
-		for _, flag := range item.Flags {
-			f := &Flag{}
-			f.object = newObject(flag.Name, f.Path().Appended(flag.Name), f, ctx.Environment)
-			f.Documentation = flag.Documentation
+	for _, tree := range trees {
+		ctx.file = tree.Path
+		for _, item := range tree.Flagsets {
+			fs := &Flagset{}
+			fs.object = newObject(item.Name, m.DefinedAt.Appended(item.Name), m, ctx.Environment, ctx.locate(item.Span))
+			fs.Documentation = item.Documentation
+			fs.Annotations = annotationList(item.Annotations, OnFlagset, ctx)
+			fs.Optional = item.Optional
+
+			for _, flag := range item.Flags {
+				f := &Flag{}
+				f.object = newObject(flag.Name, fs.Path().Appended(flag.Name), fs, ctx.Environment, ctx.locate(flag.Span))
+				f.Documentation = flag.Documentation
+				f.Annotations = annotationList(flag.Annotations, OnFlag, ctx)
+
+				fs.Flags = append(fs.Flags, f)
+			}
 
-			fs.Flags = append(fs.Flags, f)
+			m.Flagsets = append(m.Flagsets, fs)
+			ctx.Environment.Items[item.Name] = fs
 		}
+	}
 
-		m.Flagsets = append(m.Flagsets, fs)
-		ctx.Environment.Items[item.Name] = fs
+	for _, t := range m.TypeAliases {
+		aliases.resolve(t)
 	}
-	for _, item := range tree.Funcs {
-		f := &Func{}
-		f.object = newObject(item.Name, m.DefinedAt.Appended(item.Name), m, ctx.Environment)
-		f.Documentation = item.Documentation
 
-		args, err := argList(item.Arguments, f.Path(), f, ctx)
-		if err != nil {
-			return err
-		}
-		f.Arguments = args
-		f.Returns, err = lookupType(item.Returns, ctx)
-		if err != nil {
-			return err
+	// defaults are checked once every type has been filled in, as whether an
+	// enum can be given as a value depends on its cases' fields
+	structs, enums := m.Structs, m.Enums
+	for _, tree := range trees {
+		ctx.file = tree.Path
+		for _, item := range tree.Structs {
+			s := structs[0]
+			structs = structs[1:]
+			s.Fields = fieldList(item.Fields, s.Path(), s, ctx)
 		}
-		f.Throws, err = lookupType(item.Throws, ctx)
-		if err != nil {
-			return err
+		for _, item := range tree.Enums {
+			e := enums[0]
+			enums = enums[1:]
+			for i, cas := range item.Cases {
+				c := e.Cases[i]
+				c.Fields = argList(cas.Values, c.Path(), c, ctx)
+			}
 		}
-
-		m.Funcs = append(m.Funcs, f)
-		ctx.Environment.Items[item.Name] = f
 	}
-	for _, item := range tree.Streams {
-		stream := &Stream{}
-		stream.object = newObject(item.Name, m.DefinedAt.Appended(item.Name), m, ctx.Environment)
-		stream.Documentation = item.Documentation
+	ctx.runPending()
+	checkRecursion(m, ctx)
 
-		for _, ev := range item.Events {
-			e := &Event{}
-			e.object = newObject(ev.Name, stream.Path().Appended(ev.Name), stream, ctx.Environment)
-			e.Documentation = ev.Documentation
+	for _, tree := range trees {
+		ctx.file = tree.Path
+		for _, item := range tree.Constants {
+			c := &Constant{}
+			c.object = newObject(item.Name, m.DefinedAt.Appended(item.Name), m, ctx.Environment, ctx.locate(item.Span))
+			c.Documentation = item.Documentation
+			c.Annotations = annotationList(item.Annotations, OnConstant, ctx)
 
-			args, err := argList(ev.Arguments, e.Path(), e, ctx)
+			var err error
+			c.Type, err = lookupType(item.Type, ctx)
 			if err != nil {
-				return err
+				ctx.report(err, item.Span)
+			} else {
+				c.Value, err = constantValueOf(item.Value, c.Type, item.Type.GetSpan(), ctx)
+				if err != nil {
+					ctx.report(err, item.Span)
+				}
 			}
-			e.Arguments = args
 
-			stream.Events = append(stream.Events, e)
+			m.Constants = append(m.Constants, c)
+			ctx.Environment.Items[item.Name] = c
 		}
-		for _, sig := range item.Signals {
-			s := &Signal{}
-			s.object = newObject(sig.Name, stream.Path().Appended(sig.Name), stream, ctx.Environment)
-			s.Documentation = sig.Documentation
+	}
+	for _, tree := range trees {
+		ctx.file = tree.Path
+		for _, item := range tree.Funcs {
+			f := &Func{}
+			f.object = newObject(item.Name, m.DefinedAt.Appended(item.Name), m, ctx.Environment, ctx.locate(item.Span))
+			f.Documentation = item.Documentation
+			f.Annotations = annotationList(item.Annotations, OnFunc, ctx)
+			f.Arguments = argList(item.Arguments, f.Path(), f, ctx)
 
-			args, err := argList(sig.Arguments, s.Path(), s, ctx)
+			var err error
+			f.Returns, err = lookupType(item.Returns, ctx)
 			if err != nil {
-				return err
+				ctx.report(err, item.Span)
+			}
+			f.Throws, err = lookupType(item.Throws, ctx)
+			if err != nil {
+				ctx.report(err, item.Span)
 			}
-			s.Arguments = args
 
-			stream.Signals = append(stream.Signals, s)
+			m.Funcs = append(m.Funcs, f)
+			ctx.Environment.Items[item.Name] = f
 		}
+	}
+	for _, tree := range trees {
+		ctx.file = tree.Path
+		for _, item := range tree.Streams {
+			stream := &Stream{}
+			stream.object = newObject(item.Name, m.DefinedAt.Appended(item.Name), m, ctx.Environment, ctx.locate(item.Span))
+			stream.Documentation = item.Documentation
+			stream.Annotations = annotationList(item.Annotations, OnStream, ctx)
+
+			for _, ev := range item.Events {
+				e := &Event{}
+				e.object = newObject(ev.Name, stream.Path().Appended(ev.Name), stream, ctx.Environment, ctx.locate(ev.Span))
+				e.Documentation = ev.Documentation
+				e.Annotations = annotationList(ev.Annotations, OnEvent, ctx)
+				e.Arguments = argList(ev.Arguments, e.Path(), e, ctx)
+
+				stream.Events = append(stream.Events, e)
+			}
+			for _, sig := range item.Signals {
+				s := &Signal{}
+				s.object = newObject(sig.Name, stream.Path().Appended(sig.Name), stream, ctx.Environment, ctx.locate(sig.Span))
+				s.Documentation = sig.Documentation
+				s.Annotations = annotationList(sig.Annotations, OnSignal, ctx)
+				s.Arguments = argList(sig.Arguments, s.Path(), s, ctx)
+
+				stream.Signals = append(stream.Signals, s)
+			}
 
-		m.Streams = append(m.Streams, stream)
-		ctx.Environment.Items[item.Name] = stream
+			m.Streams = append(m.Streams, stream)
+			ctx.Environment.Items[item.Name] = stream
+		}
 	}
+	ctx.runPending()
+}
 
-	return nil
+// checkRecursion reports the structs and enums of m that contain themselves,
+// which backends can't lay out, as they'd have no end.
+func checkRecursion(m *Module, ctx *Context) {
+	reported, done := map[Type]bool{}, map[Type]bool{}
+	var path []Type
+	var visit func(typ Type)
+	visit = func(typ Type) {
+		switch t := typ.(type) {
+		case ArrayType:
+			visit(t.Element)
+			return
+		case DictionaryType:
+			visit(t.Element)
+			return
+		case OptionalType:
+			visit(t.Element)
+			return
+		case *TypeAlias:
+			if t.Underlying != nil && t.Parent() == Object(m) {
+				visit(t.Underlying)
+			}
+			return
+		case *Struct, *Enum:
+		default:
+			return
+		}
+		if typ.Parent() != Object(m) || done[typ] {
+			return
+		}
+		for i, seen := range path {
+			if seen != typ {
+				continue
+			}
+			if reported[typ] {
+				return
+			}
+			var names []string
+			for _, in := range path[i:] {
+				reported[in] = true
+				names = append(names, in.ObjectName())
+			}
+			names = append(names, typ.ObjectName())
+			loc, _ := LocationOf(typ)
+			ctx.file = loc.File
+			ctx.report(ctx.errorAt(loc.Span, "%s contains itself through %s, and recursive types aren't supported", typ.ObjectName(), strings.Join(names, " -> ")), loc.Span)
+			return
+		}
+		path = append(path, typ)
+		defer func() {
+			path = path[:len(path)-1]
+			done[typ] = true
+		}()
+		switch t := typ.(type) {
+		case *Struct:
+			for _, field := range t.Fields {
+				visit(field.Type)
+			}
+		case *Enum:
+			for _, esac := range t.Cases {
+				for _, field := range esac.Fields {
+					visit(field.Type)
+				}
+			}
+		}
+	}
+	for _, s := range m.Structs {
+		visit(s)
+	}
+	for _, e := range m.Enums {
+		visit(e)
+	}
 }
 
 func (ctx *Context) Module(tree *ast.File, modpath string) (*Module, error) {
@@ -328,12 +531,14 @@ func (ctx *Context) Module(tree *ast.File, modpath string) (*Module, error) {
 	m.DefinedAt = Path{modpath, ""}
 	m.Name = path.Base(modpath)
 
-	ctx.PushEnvironment()
+	m.InEnv = ctx.PushEnvironment()
 	defer ctx.PopEnvironment()
 
-	err := ctx.doSingleModule(m, nil, tree)
-	if err != nil {
-		return nil, err
+	diags := ctx.collect(func() {
+		ctx.doSingleModule(m, nil, []*ast.File{tree})
+	})
+	if diags.HasErrors() {
+		return m, diags
 	}
 
 	return m, nil
@@ -0,0 +1,182 @@
+package typechecking
+
+import (
+	"errors"
+	"lugmac/ast"
+	"strings"
+	"testing"
+)
+
+// check typechecks source as a module on its own, returning what was
+// reported about it.
+func check(t *testing.T, source string) (*Module, Diagnostics) {
+	t.Helper()
+	tree, err := ast.Parse([]byte(source))
+	if err != nil {
+		t.Fatalf("failed to parse:\n%s", err)
+	}
+	tree.Path = "Test.lugma"
+	m, err := NewContext(FileImportResolver).Module(tree, "Test/Test")
+	var diags Diagnostics
+	if err != nil && !errors.As(err, &diags) {
+		t.Fatalf("failed to check: %s", err)
+	}
+	return m, diags
+}
+
+func TestDeclarationOrder(t *testing.T) {
+	cases := []struct {
+		name   string
+		source string
+		// alias is an alias whose underlying type should be underlying.
+		alias, underlying string
+	}{
+		{
+			name: "alias of a struct declared before it",
+			source: `
+struct Point {
+    let x: Int32
+}
+typealias P = Point
+`,
+			alias:      "P",
+			underlying: "Point",
+		},
+		{
+			name: "alias of an alias declared after it",
+			source: `
+typealias A = B
+typealias B = UInt32
+`,
+			alias:      "A",
+			underlying: "B",
+		},
+		{
+			name: "alias of an enum",
+			source: `
+typealias C = Colour
+enum Colour {
+    case red
+}
+`,
+			alias:      "C",
+			underlying: "Colour",
+		},
+		{
+			name: "struct with a field of an enum declared after it",
+			source: `
+struct Paint {
+    let colour: Colour = "red"
+}
+enum Colour {
+    case red
+}
+`,
+		},
+		{
+			name: "dictionary keyed by an alias declared after it",
+			source: `
+typealias Names = [ID: String]
+newtype ID = String
+`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m, diags := check(t, c.source)
+			if len(diags) > 0 {
+				t.Fatalf("expected no diagnostics, got:\n%s", diags)
+			}
+			if c.alias == "" {
+				return
+			}
+			alias, ok := m.Child(c.alias).(*TypeAlias)
+			if !ok {
+				t.Fatalf("%s isn't a type alias", c.alias)
+			}
+			if alias.Underlying == nil || alias.Underlying.ObjectName() != c.underlying {
+				t.Errorf("expected %s to stand for %s, but it stands for %v", c.alias, c.underlying, alias.Underlying)
+			}
+		})
+	}
+}
+
+func TestRecursiveDeclarations(t *testing.T) {
+	cases := []struct {
+		name    string
+		source  string
+		message string
+	}{
+		{
+			name: "alias of itself",
+			source: `
+typealias A = [A]
+`,
+			message: "type alias A refers to itself through A -> A",
+		},
+		{
+			name: "aliases of each other",
+			source: `
+typealias A = B
+typealias B = A
+`,
+			message: "type alias A refers to itself through A -> B -> A",
+		},
+		{
+			name: "struct containing itself",
+			source: `
+struct Node {
+    let next: Node?
+}
+`,
+			message: "Node contains itself through Node -> Node",
+		},
+		{
+			name: "enum and struct containing each other",
+			source: `
+enum Tree {
+    case leaf
+    case branch(node: Branch)
+}
+struct Branch {
+    let children: [Tree]
+}
+`,
+			message: "Branch contains itself through Branch -> Tree -> Branch",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, diags := check(t, c.source)
+			if len(diags) != 1 {
+				t.Fatalf("expected one diagnostic, got:\n%s", diags)
+			}
+			if !strings.Contains(diags[0].Message, c.message) {
+				t.Errorf("expected %q, got %q", c.message, diags[0].Message)
+			}
+		})
+	}
+}
+
+func TestFlagsetOptional(t *testing.T) {
+	m, diags := check(t, `
+flagset Permissions: optional {
+    flag read
+}
+flagset Modes {
+    flag fast
+}
+`)
+	if len(diags) > 0 {
+		t.Fatalf("expected no diagnostics, got:\n%s", diags)
+	}
+	for name, optional := range map[string]bool{"Permissions": true, "Modes": false} {
+		fs, ok := m.Child(name).(*Flagset)
+		if !ok {
+			t.Fatalf("%s isn't a flagset", name)
+		}
+		if fs.Optional != optional {
+			t.Errorf("expected %s to be optional: %t, but it's %t", name, optional, fs.Optional)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package typechecking
+
+import (
+	"errors"
+	"fmt"
+	"lugmac/ast"
+	"strings"
+)
+
+type Severity int
+
+const (
+	Error Severity = iota
+	Warning
+	Note
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Error:
+		return "error"
+	case Warning:
+		return "warning"
+	case Note:
+		return "note"
+	default:
+		panic("Unhandled severity")
+	}
+}
+
+// Diagnostic is a problem found in a file, along with where it was found.
+type Diagnostic struct {
+	File     string
+	Span     ast.Span
+	Severity Severity
+	Message  string
+
+	// Notes point at other places relevant to the diagnostic, such as an
+	// earlier declaration that conflicts with this one.
+	Notes []Diagnostic
+}
+
+// Position returns the 1-based line and column the diagnostic starts at.
+func (d Diagnostic) Position() (line, column int) {
+	return int(d.Span.Start.Row) + 1, int(d.Span.Start.Column) + 1
+}
+
+func (d Diagnostic) header() string {
+	line, column := d.Position()
+	return fmt.Sprintf("%s:%d:%d: %s: %s", d.File, line, column, d.Severity, d.Message)
+}
+
+func (d Diagnostic) Error() string {
+	lines := []string{d.header()}
+	for _, note := range d.Notes {
+		lines = append(lines, note.header())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Render formats the diagnostic and its notes with an excerpt of the line they
+// point at, taking the text of each file from source.
+func (d Diagnostic) Render(source func(file string) []byte) string {
+	var sb strings.Builder
+
+	d.renderTo(&sb, source)
+	for _, note := range d.Notes {
+		note.renderTo(&sb, source)
+	}
+
+	return sb.String()
+}
+
+func (d Diagnostic) renderTo(sb *strings.Builder, source func(file string) []byte) {
+	sb.WriteString(d.header())
+	sb.WriteString("\n")
+
+	lines := strings.Split(string(source(d.File)), "\n")
+	row := int(d.Span.Start.Row)
+	if row >= len(lines) {
+		return
+	}
+	line := strings.TrimSuffix(lines[row], "\r")
+
+	start := int(d.Span.Start.Column)
+	if start > len(line) {
+		start = len(line)
+	}
+	width := 1
+	if d.Span.End.Row == d.Span.Start.Row && int(d.Span.End.Column) > start+1 {
+		width = int(d.Span.End.Column) - start
+	}
+	if start+width > len(line) && len(line) > start {
+		width = len(line) - start
+	}
+
+	// keep tabs in the excerpt lined up with the caret below it
+	var pad strings.Builder
+	for _, r := range line[:start] {
+		if r == '\t' {
+			pad.WriteRune('\t')
+		} else {
+			pad.WriteRune(' ')
+		}
+	}
+
+	sb.WriteString("    " + line + "\n")
+	sb.WriteString("    " + pad.String() + "^" + strings.Repeat("~", width-1) + "\n")
+}
+
+// Diagnostics is every diagnostic found while checking a module. It's
+// returned as an error when any of them are errors.
+type Diagnostics []Diagnostic
+
+func (d Diagnostics) Error() string {
+	var lines []string
+	for _, diag := range d {
+		lines = append(lines, diag.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (d Diagnostics) HasErrors() bool {
+	for _, diag := range d {
+		if diag.Severity == Error {
+			return true
+		}
+	}
+	return false
+}
+
+// errorAt makes a diagnostic pointing at span in the file currently being
+// checked.
+func (ctx *Context) errorAt(span ast.Span, format string, args ...interface{}) Diagnostic {
+	return Diagnostic{
+		File:     ctx.file,
+		Span:     span,
+		Severity: Error,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// locate returns the location of span in the file currently being checked.
+func (ctx *Context) locate(span ast.Span) Location {
+	return Location{ctx.file, span}
+}
+
+// noteAt makes a note pointing at span in the file currently being checked.
+func (ctx *Context) noteAt(span ast.Span, format string, args ...interface{}) Diagnostic {
+	d := ctx.errorAt(span, format, args...)
+	d.Severity = Note
+	return d
+}
+
+// DiagnosticsFor turns err into diagnostics. Syntax errors are attributed to
+// file, and any other errors that aren't already diagnostics to its start.
+func DiagnosticsFor(file string, err error) Diagnostics {
+	return diagnosticsFor(file, ast.Span{}, err)
+}
+
+func diagnosticsFor(file string, span ast.Span, err error) Diagnostics {
+	var diags Diagnostics
+	var diag Diagnostic
+	var syntaxErrors ast.SyntaxErrors
+	var syntaxError ast.SyntaxError
+
+	switch {
+	case errors.As(err, &diags):
+		return diags
+	case errors.As(err, &diag):
+		return Diagnostics{diag}
+	case errors.As(err, &syntaxErrors):
+		var ret Diagnostics
+		for _, syntaxError := range syntaxErrors {
+			ret = append(ret, Diagnostic{File: file, Span: syntaxError.Span, Severity: Error, Message: syntaxError.Message})
+		}
+		return ret
+	case errors.As(err, &syntaxError):
+		return Diagnostics{{File: file, Span: syntaxError.Span, Severity: Error, Message: syntaxError.Message}}
+	default:
+		return Diagnostics{{File: file, Span: span, Severity: Error, Message: err.Error()}}
+	}
+}
+
+// report records err. Errors that aren't already diagnostics are attributed
+// to span.
+func (ctx *Context) report(err error, span ast.Span) {
+	ctx.diagnostics = append(ctx.diagnostics, diagnosticsFor(ctx.file, span, err)...)
+}
+
+// collect runs check, returning the diagnostics it reported. Diagnostics
+// reported by modules loaded in the meantime stay with those modules.
+func (ctx *Context) collect(check func()) Diagnostics {
+	outer, outerFile, outerPending := ctx.diagnostics, ctx.file, ctx.pending
+	ctx.diagnostics, ctx.pending = nil, nil
+
+	check()
+
+	ret := ctx.diagnostics
+	ctx.diagnostics, ctx.file, ctx.pending = outer, outerFile, outerPending
+	return ret
+}
@@ -8,6 +8,7 @@ import (
 type Enum struct {
 	object
 	Documentation *ast.ItemDocumentation
+	Annotations   Annotations
 
 	Cases []*Case
 }
@@ -42,7 +43,11 @@ func (e Enum) Simple() bool {
 type Case struct {
 	object
 	Documentation *ast.ItemDocumentation
+	Annotations   Annotations
 
+	// Tag is the number given to the case with @tag, or 0 if it wasn't
+	// given one.
+	Tag    int
 	Fields []*Field
 }
 
@@ -28,9 +28,16 @@ var World = &Environment{
 		"Int32": Int32,
 		"Int64": Int64,
 
+		"Float32": Float32,
+		"Float64": Float64,
+
 		"String": String,
 		"Bytes":  Bytes,
 
 		"Bool": Bool,
+
+		"Timestamp": Timestamp,
+		"Duration":  Duration,
+		"UUID":      UUID,
 	},
 }
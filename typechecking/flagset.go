@@ -8,6 +8,7 @@ import (
 type Flagset struct {
 	object
 	Documentation *ast.ItemDocumentation
+	Annotations   Annotations
 
 	Optional bool
 	Flags    []*Flag
@@ -38,6 +39,7 @@ type Flag struct {
 	object
 
 	Documentation *ast.ItemDocumentation
+	Annotations   Annotations
 }
 
 var _ Object = &Flag{}
@@ -37,11 +37,16 @@ type Module struct {
 
 	Imports map[string]*Module
 
-	Structs  []*Struct
-	Enums    []*Enum
-	Funcs    []*Func
-	Streams  []*Stream
-	Flagsets []*Flagset
+	// Files are the files the module was checked from.
+	Files []*ast.File
+
+	Structs     []*Struct
+	Enums       []*Enum
+	Funcs       []*Func
+	Streams     []*Stream
+	Flagsets    []*Flagset
+	TypeAliases []*TypeAlias
+	Constants   []*Constant
 }
 
 var _ Object = Module{}
@@ -85,6 +90,16 @@ func (m Module) Child(name string) Object {
 			return stream
 		}
 	}
+	for _, alias := range m.TypeAliases {
+		if alias.ObjectName() == name {
+			return alias
+		}
+	}
+	for _, constant := range m.Constants {
+		if constant.ObjectName() == name {
+			return constant
+		}
+	}
 	if v, ok := m.Imports[name]; ok {
 		return v
 	}
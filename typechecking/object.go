@@ -1,6 +1,9 @@
 package typechecking
 
-import "fmt"
+import (
+	"fmt"
+	"lugmac/ast"
+)
 
 type Object interface {
 	isObject()
@@ -16,14 +19,16 @@ type object struct {
 	definedAt Path
 	inParent  Object
 	inEnv     *Environment
+	location  Location
 }
 
-func newObject(name string, definedAt Path, inparent Object, inEnv *Environment) object {
+func newObject(name string, definedAt Path, inparent Object, inEnv *Environment, location Location) object {
 	return object{
 		name:      name,
 		definedAt: definedAt,
 		inParent:  inparent,
 		inEnv:     inEnv,
+		location:  location,
 	}
 }
 
@@ -40,6 +45,24 @@ func (o *object) Path() Path {
 func (o *object) Env() *Environment {
 	return o.inEnv
 }
+func (o *object) Location() Location {
+	return o.location
+}
+
+// Location is where an object was declared.
+type Location struct {
+	File string
+	Span ast.Span
+}
+
+// LocationOf returns where object was declared, if it was declared in a file.
+func LocationOf(object Object) (Location, bool) {
+	located, ok := object.(interface{ Location() Location })
+	if !ok || located.Location().File == "" {
+		return Location{}, false
+	}
+	return located.Location(), true
+}
 
 func IsParentOf(par Object, child Object) bool {
 	for child != nil {
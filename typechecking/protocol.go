@@ -5,6 +5,7 @@ import "lugmac/ast"
 type Func struct {
 	object
 	Documentation *ast.ItemDocumentation
+	Annotations   Annotations
 
 	Arguments []*Field
 
@@ -17,6 +18,7 @@ func (f Func) Child(name string) Object { return nil }
 type Event struct {
 	object
 	Documentation *ast.ItemDocumentation
+	Annotations   Annotations
 
 	Arguments []*Field
 }
@@ -26,6 +28,7 @@ func (f Event) Child(name string) Object { return nil }
 type Signal struct {
 	object
 	Documentation *ast.ItemDocumentation
+	Annotations   Annotations
 
 	Arguments []*Field
 }
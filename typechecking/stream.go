@@ -5,6 +5,7 @@ import "lugmac/ast"
 type Stream struct {
 	object
 	Documentation *ast.ItemDocumentation
+	Annotations   Annotations
 
 	Events  []*Event
 	Signals []*Signal
@@ -8,6 +8,7 @@ import (
 type Struct struct {
 	object
 	Documentation *ast.ItemDocumentation
+	Annotations   Annotations
 
 	Fields []*Field
 }
@@ -34,11 +35,19 @@ func (s Struct) String() string {
 type Field struct {
 	Name          string
 	Documentation *ast.ItemDocumentation
+	Annotations   Annotations
 	DefinedAt     Path
 	InParent      Object
 	InEnv         *Environment
+	Source        Location
 
 	Type Type
+	// Default is the value the field takes when it's missing, or nil if it
+	// has to be present.
+	Default Value
+	// Tag is the number given to the field with @tag, or 0 if it wasn't
+	// given one.
+	Tag int
 }
 
 var _ Object = Field{}
@@ -59,3 +68,6 @@ func (f Field) Parent() Object {
 func (f Field) Child(name string) Object {
 	return nil
 }
+func (f Field) Location() Location {
+	return f.Source
+}
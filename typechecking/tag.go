@@ -0,0 +1,153 @@
+package typechecking
+
+import (
+	"lugmac/ast"
+	"math"
+	"strconv"
+)
+
+// MaxTag is the largest tag that can be given with @tag, which is the
+// largest field number that every binary encoding can hold.
+const MaxTag = 1<<29 - 1
+
+func init() {
+	RegisterAnnotation(AnnotationSignature{
+		Name:          "tag",
+		Documentation: "Gives a field, argument or case a number that identifies it in binary encodings, so that it can be renamed safely.",
+		Parameters:    []AnnotationParameter{{"number", NumberValueKind, false}},
+		On:            OnField | OnArgument | OnCase,
+	})
+	RegisterAnnotation(AnnotationSignature{
+		Name:          "reserved",
+		Documentation: "Stops tags and names that were used by removed fields, arguments or cases from being used again, e.g. `@reserved([2, 5, \"oldName\"])`.",
+		Parameters:    []AnnotationParameter{{"tags", ListValueKind, false}},
+		On:            OnStruct | OnEnum | OnCase | OnFunc | OnEvent | OnSignal,
+		Repeatable:    true,
+	})
+}
+
+// isTag reports whether n is a whole number that can be used as a tag.
+func isTag(n float64) bool {
+	return n == math.Trunc(n) && n >= 1 && n <= MaxTag
+}
+
+// tagOf returns the tag given by the @tag annotation in annotations, or 0 if
+// there isn't one.
+func tagOf(annotations Annotations, in *Context) int {
+	annotation := annotations.Get("tag")
+	if annotation == nil {
+		return 0
+	}
+	n, ok := annotation.Argument(0).(NumberValue)
+	if !ok || !isTag(float64(n)) {
+		in.report(in.errorAt(annotation.Span, "tags must be whole numbers from 1 to %d", MaxTag), annotation.Span)
+		return 0
+	}
+	return int(n)
+}
+
+// tagged is a field, argument or case whose tag and name are checked
+// against its siblings'.
+type tagged struct {
+	name string
+	tag  int
+	// at is where the item was declared, and tagAt where it was tagged.
+	at, tagAt ast.Span
+}
+
+func taggedFields(fields []*Field) []tagged {
+	var ret []tagged
+	for _, field := range fields {
+		t := tagged{name: field.Name, tag: field.Tag, at: field.Source.Span}
+		if annotation := field.Annotations.Get("tag"); annotation != nil {
+			t.tagAt = annotation.Span
+		}
+		ret = append(ret, t)
+	}
+	return ret
+}
+
+func taggedCases(cases []*Case) []tagged {
+	var ret []tagged
+	for _, esac := range cases {
+		t := tagged{name: esac.ObjectName(), tag: esac.Tag, at: esac.Location().Span}
+		if annotation := esac.Annotations.Get("tag"); annotation != nil {
+			t.tagAt = annotation.Span
+		}
+		ret = append(ret, t)
+	}
+	return ret
+}
+
+// checkTags reports items that share a tag, and items whose tag or name is
+// reserved by a @reserved annotation in reserved.
+func checkTags(items []tagged, reserved Annotations, in *Context) {
+	reservedTags := map[int]ast.Span{}
+	reservedNames := map[string]ast.Span{}
+	for _, annotation := range reserved {
+		if annotation.Name != "reserved" {
+			continue
+		}
+		list, _ := annotation.Argument(0).(ListValue)
+		for _, item := range list {
+			switch item := item.(type) {
+			case NumberValue:
+				if !isTag(float64(item)) {
+					in.report(in.errorAt(annotation.Span, "reserved tags must be whole numbers from 1 to %d, not %s", MaxTag, item), annotation.Span)
+					continue
+				}
+				reservedTags[int(item)] = annotation.Span
+			case StringValue:
+				reservedNames[string(item)] = annotation.Span
+			default:
+				in.report(in.errorAt(annotation.Span, "only tags and names can be reserved, not %s", item), annotation.Span)
+			}
+		}
+	}
+
+	seen := map[int]tagged{}
+	for _, item := range items {
+		if span, ok := reservedNames[item.name]; ok {
+			diag := in.errorAt(item.at, "the name %s is reserved", strconv.Quote(item.name))
+			diag.Notes = append(diag.Notes, in.noteAt(span, "%s was reserved here", strconv.Quote(item.name)))
+			in.report(diag, item.at)
+		}
+		if item.tag == 0 {
+			continue
+		}
+		if span, ok := reservedTags[item.tag]; ok {
+			diag := in.errorAt(item.tagAt, "tag %d is reserved", item.tag)
+			diag.Notes = append(diag.Notes, in.noteAt(span, "%d was reserved here", item.tag))
+			in.report(diag, item.tagAt)
+		}
+		if previous, ok := seen[item.tag]; ok {
+			diag := in.errorAt(item.tagAt, "tag %d is already used by %s", item.tag, previous.name)
+			diag.Notes = append(diag.Notes, in.noteAt(previous.tagAt, "%s was tagged %d here", previous.name, item.tag))
+			in.report(diag, item.tagAt)
+			continue
+		}
+		seen[item.tag] = item
+	}
+}
+
+// Reserved returns the tags and names reserved by the @reserved annotations
+// in annotations, leaving out any that aren't valid.
+func Reserved(annotations Annotations) (tags []int, names []string) {
+	for _, annotation := range annotations {
+		if annotation.Name != "reserved" {
+			continue
+		}
+		list, _ := annotation.Argument(0).(ListValue)
+		for _, item := range list {
+			switch item := item.(type) {
+			case NumberValue:
+				if isTag(float64(item)) {
+					tags = append(tags, int(item))
+				}
+			case StringValue:
+				names = append(names, string(item))
+			}
+		}
+	}
+	return tags, names
+}
@@ -32,6 +32,24 @@ const (
 	Bytes
 
 	Bool
+
+	// primitives added later go after the others, so that the values of the
+	// existing ones stay the same
+
+	// Float32 and Float64 are sent as JSON numbers, so they can't be NaN or
+	// infinite.
+	Float32
+	Float64
+
+	// Timestamp is sent as an RFC 3339 string with up to nanosecond
+	// precision, such as "2022-07-01T12:30:00.5Z".
+	Timestamp
+	// Duration is sent as a string of a decimal number of seconds with up to
+	// nine digits after the point, followed by "s", such as "-1.5s".
+	Duration
+	// UUID is sent as a string of its hyphenated hex digits, such as
+	// "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", in lowercase.
+	UUID
 )
 
 func (p PrimitiveType) isObject() {}
@@ -40,7 +58,14 @@ func (p PrimitiveType) Child(name string) Object {
 	return nil
 }
 func (p PrimitiveType) Keyable() bool {
-	return true
+	switch p {
+	case Float32, Float64, Timestamp, Duration:
+		// these can be written more than one way, so the same key could be
+		// sent as different strings
+		return false
+	default:
+		return true
+	}
 }
 func (p PrimitiveType) String() string {
 	switch p {
@@ -60,12 +85,22 @@ func (p PrimitiveType) String() string {
 		return "Int32"
 	case Int64:
 		return "Int64"
+	case Float32:
+		return "Float32"
+	case Float64:
+		return "Float64"
 	case String:
 		return "String"
 	case Bytes:
 		return "Bytes"
 	case Bool:
 		return "Bool"
+	case Timestamp:
+		return "Timestamp"
+	case Duration:
+		return "Duration"
+	case UUID:
+		return "UUID"
 	default:
 		panic("Bad primitive type")
 	}
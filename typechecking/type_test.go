@@ -0,0 +1,14 @@
+package typechecking
+
+import "testing"
+
+// TestPrimitiveValues pins the values of the primitives, which mustn't change
+// when new ones are added.
+func TestPrimitiveValues(t *testing.T) {
+	order := []PrimitiveType{UInt8, UInt16, UInt32, UInt64, Int8, Int16, Int32, Int64, String, Bytes, Bool, Float32, Float64, Timestamp, Duration, UUID}
+	for want, p := range order {
+		if int(p) != want {
+			t.Errorf("%s is %d, want %d", p, p, want)
+		}
+	}
+}
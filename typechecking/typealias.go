@@ -0,0 +1,142 @@
+package typechecking
+
+import (
+	"fmt"
+	"lugmac/ast"
+	"strings"
+)
+
+// TypeAlias is a name for another type, which is sent on the wire the same
+// way as the type it stands for.
+type TypeAlias struct {
+	object
+	Documentation *ast.ItemDocumentation
+	Annotations   Annotations
+
+	// Newtype is whether the alias makes a distinct type, which backends
+	// keep apart from its underlying type where their language allows it.
+	Newtype    bool
+	Underlying Type
+}
+
+var _ Type = &TypeAlias{}
+
+func (t TypeAlias) isType()   {}
+func (t TypeAlias) isObject() {}
+func (t TypeAlias) Child(name string) Object {
+	if t.Underlying == nil {
+		return nil
+	}
+	return t.Underlying.Child(name)
+}
+func (t TypeAlias) Keyable() bool {
+	return t.Underlying != nil && t.Underlying.Keyable()
+}
+func (t TypeAlias) String() string {
+	return fmt.Sprintf("%s", t.ObjectName())
+}
+
+// Resolve returns the type that typ stands for, looking through any type
+// aliases.
+func Resolve(typ Type) Type {
+	for {
+		alias, ok := typ.(*TypeAlias)
+		if !ok || alias.Underlying == nil {
+			return typ
+		}
+		typ = alias.Underlying
+	}
+}
+
+// aliasDecl is the declaration of a type alias, and the file it's in.
+type aliasDecl struct {
+	ast.TypeAlias
+	file string
+}
+
+type resolution int
+
+const (
+	unresolved resolution = iota
+	resolving
+	resolved
+)
+
+// aliasResolver fills in the types that type aliases stand for, filling in
+// those of the aliases each one refers to first, so that aliases can be
+// declared in any order.
+type aliasResolver struct {
+	ctx   *Context
+	decls map[*TypeAlias]aliasDecl
+	state map[*TypeAlias]resolution
+	// stack holds the aliases being resolved, and cyclic those found to
+	// refer to themselves, which are left without an underlying type.
+	stack  []*TypeAlias
+	cyclic map[*TypeAlias]bool
+}
+
+func (r *aliasResolver) resolve(t *TypeAlias) {
+	switch r.state[t] {
+	case resolved:
+		return
+	case resolving:
+		var cycle []string
+		for i := len(r.stack) - 1; i >= 0; i-- {
+			if r.cyclic == nil {
+				r.cyclic = map[*TypeAlias]bool{}
+			}
+			r.cyclic[r.stack[i]] = true
+			cycle = append([]string{r.stack[i].ObjectName()}, cycle...)
+			if r.stack[i] == t {
+				break
+			}
+		}
+		cycle = append(cycle, t.ObjectName())
+		decl := r.decls[t]
+		r.ctx.file = decl.file
+		r.ctx.report(r.ctx.errorAt(decl.Span, "type alias %s refers to itself through %s", t.ObjectName(), strings.Join(cycle, " -> ")), decl.Span)
+		return
+	}
+
+	r.state[t] = resolving
+	r.stack = append(r.stack, t)
+	decl := r.decls[t]
+	for _, name := range referencedNames(decl.Type) {
+		object, _ := r.ctx.Environment.Search(name)
+		if alias, ok := object.(*TypeAlias); ok {
+			if _, declared := r.decls[alias]; declared {
+				r.resolve(alias)
+			}
+		}
+	}
+	r.stack = r.stack[:len(r.stack)-1]
+	r.state[t] = resolved
+
+	if r.cyclic[t] {
+		return
+	}
+	r.ctx.file = decl.file
+	var err error
+	t.Underlying, err = lookupType(decl.Type, r.ctx)
+	if err != nil {
+		r.ctx.report(err, decl.Span)
+	}
+}
+
+// referencedNames returns the names typ refers to declarations by.
+func referencedNames(typ ast.Type) []string {
+	switch typ := typ.(type) {
+	case ast.TypeIdent:
+		return []string{typ.Name}
+	case ast.TypeArray:
+		return referencedNames(typ.Inner)
+	case ast.TypeOptional:
+		return referencedNames(typ.Inner)
+	case ast.TypeDictionary:
+		return append(referencedNames(typ.Key), referencedNames(typ.Value)...)
+	case ast.TypeSubscript:
+		return referencedNames(typ.Inner)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,87 @@
+package typechecking
+
+import (
+	"lugmac/ast"
+	"strconv"
+)
+
+// typedValueOf checks that lit is a valid value of typ, written the way the
+// value is sent on the wire. Values can be given for primitives, arrays and
+// dictionaries of them, optionals and simple enums, whose cases are written
+// as strings of their names.
+func typedValueOf(lit ast.Literal, typ Type, in *Context) (Value, error) {
+	switch typ := Resolve(typ).(type) {
+	case PrimitiveType:
+		return primitiveValueOf(lit, typ, in)
+	case OptionalType:
+		return typedValueOf(lit, typ.Element, in)
+	case ArrayType:
+		list, ok := lit.(ast.LiteralList)
+		if !ok {
+			return nil, in.errorAt(lit.GetSpan(), "expected a list for %s", typ)
+		}
+		l := ListValue{}
+		for _, item := range list.Values {
+			v, err := typedValueOf(item, typ.Element, in)
+			if err != nil {
+				return nil, err
+			}
+			l = append(l, v)
+		}
+		return l, nil
+	case DictionaryType:
+		// an empty dictionary is written as an empty list
+		if list, ok := lit.(ast.LiteralList); ok && len(list.Values) == 0 {
+			return DictionaryValue{}, nil
+		}
+		dict, ok := lit.(ast.LiteralDictionary)
+		if !ok {
+			return nil, in.errorAt(lit.GetSpan(), "expected a dictionary for %s", typ)
+		}
+		if len(dict.Entries) > 0 && Resolve(typ.Key) != String {
+			return nil, in.errorAt(lit.GetSpan(), "only dictionaries with String keys can have entries in a value, not %s", typ)
+		}
+		d := DictionaryValue{}
+		for i, entry := range dict.Entries {
+			for _, previous := range dict.Entries[:i] {
+				if previous.Key == entry.Key {
+					diag := in.errorAt(entry.Value.GetSpan(), "key %s appears more than once in dictionary", entry.Key)
+					diag.Notes = append(diag.Notes, in.noteAt(previous.Value.GetSpan(), "%s was first given here", entry.Key))
+					return nil, diag
+				}
+			}
+			v, err := typedValueOf(entry.Value, typ.Element, in)
+			if err != nil {
+				return nil, err
+			}
+			d = append(d, DictionaryEntry{entry.Key, v})
+		}
+		return d, nil
+	case *Enum:
+		if !typ.Simple() {
+			return nil, in.errorAt(lit.GetSpan(), "%s has cases with fields, so it can't be given as a value", typ)
+		}
+		str, ok := lit.(ast.LiteralString)
+		if !ok {
+			return nil, in.errorAt(lit.GetSpan(), "expected the name of a case of %s as a string", typ)
+		}
+		if _, ok := typ.Child(str.Value).(*Case); !ok {
+			return nil, in.errorAt(lit.GetSpan(), "%s has no case %s", typ, strconv.Quote(str.Value))
+		}
+		return StringValue(str.Value), nil
+	case nil:
+		// the type couldn't be found, which has already been reported
+		return nil, nil
+	default:
+		return nil, in.errorAt(lit.GetSpan(), "%s can't be given as a value", typ)
+	}
+}
+
+// defaultValueOf checks that lit is a valid default for a field or argument
+// of typ. Optionals are left out when they're empty, so they can't have one.
+func defaultValueOf(lit ast.Literal, typ Type, span ast.Span, in *Context) (Value, error) {
+	if _, ok := Resolve(typ).(OptionalType); ok {
+		return nil, in.errorAt(span, "%s is optional, so it can't have a default", typ)
+	}
+	return typedValueOf(lit, typ, in)
+}